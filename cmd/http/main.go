@@ -27,8 +27,12 @@ func main() {
 	router := gin.Default()
 	router.Use(middleware.GinLogger())
 
+	// Background workers of the modules run until the server shuts down
+	appCtx, stopApp := context.WithCancel(context.Background())
+	defer stopApp()
+
 	// Register modules
-	modules.RegisterModules(dbConn, router)
+	modules.RegisterModules(appCtx, dbConn, router)
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.App.Port),
@@ -47,6 +51,7 @@ func main() {
 	<-quit
 
 	logger.Get().With().Info("Shutting down server...")
+	stopApp()
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
@@ -1,7 +1,11 @@
 package modules
 
 import (
+	"context"
+	"time"
+
 	authApp "github.com/ZyoGo/ayo-indonesia-footbal/internal/auth/app"
+	authDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/auth/domain"
 	authHandler "github.com/ZyoGo/ayo-indonesia-footbal/internal/auth/infra/handler"
 	authPostgres "github.com/ZyoGo/ayo-indonesia-footbal/internal/auth/infra/postgres"
 
@@ -9,13 +13,20 @@ import (
 	clubHandler "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/handler"
 	clubPostgres "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/postgres"
 
+	competitionApp "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/app"
+	competitionHandler "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/infra/handler"
+	competitionPostgres "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/infra/postgres"
+
 	matchApp "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/app"
+	matchDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
 	matchHandler "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/handler"
+	matchLive "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/live"
 	matchPostgres "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/postgres"
 
 	reportingApp "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/app"
 	reportingHandler "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/infra/handler"
 	reportingPostgres "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/infra/postgres"
+	reportingScoreboard "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/infra/scoreboard"
 
 	uploadHandler "github.com/ZyoGo/ayo-indonesia-footbal/internal/upload/handler"
 
@@ -27,7 +38,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func RegisterModules(db *pgxpool.Pool, router *gin.Engine) {
+// RegisterModules wires every module into the router. Background workers stop when ctx is cancelled.
+func RegisterModules(ctx context.Context, db *pgxpool.Pool, router *gin.Engine) {
 	cfg := config.GetConfig()
 	jwtKeys := config.GetJWTKeys()
 
@@ -35,6 +47,7 @@ func RegisterModules(db *pgxpool.Pool, router *gin.Engine) {
 
 	guard := authguard.NewAuthGuard(jwtService)
 	authMW := guard.Guard()
+	adminMW := guard.RequireRole(authDomain.RoleAdmin)
 
 	uploader := upload.NewUploader(
 		cfg.Upload.BasePath,
@@ -49,9 +62,10 @@ func RegisterModules(db *pgxpool.Pool, router *gin.Engine) {
 
 	registerAuthModule(db, api, jwtService)
 	registerUploadModule(api, uploader, authMW)
-	registerClubModule(db, api, authMW)
-	registerMatchModule(db, api, authMW)
-	registerReportingModule(db, api)
+	registerClubModule(db, api, authMW, adminMW)
+	registerCompetitionModule(db, api, authMW)
+	registerMatchModule(db, api, cfg, jwtService, authMW, adminMW, guard.GuardScope(matchHandler.ResultScope))
+	registerReportingModule(ctx, db, api)
 }
 
 func registerAuthModule(db *pgxpool.Pool, rg *gin.RouterGroup, jwtService *jwt.Service) {
@@ -66,34 +80,76 @@ func registerUploadModule(rg *gin.RouterGroup, uploader *upload.Uploader, authMW
 	rg.POST("/uploads", authMW, uploadH.Upload)
 }
 
-func registerClubModule(db *pgxpool.Pool, rg *gin.RouterGroup, authMW gin.HandlerFunc) {
+func registerClubModule(db *pgxpool.Pool, rg *gin.RouterGroup, authMW, adminMW gin.HandlerFunc) {
 	teamRepo := clubPostgres.NewTeamRepository(db)
 	playerRepo := clubPostgres.NewPlayerRepository(db)
+	venueRepo := clubPostgres.NewVenueRepository(db)
+	registrationRepo := clubPostgres.NewRegistrationRepository(db)
+	staffRepo := clubPostgres.NewStaffRepository(db)
 
-	teamService := clubApp.NewTeamService(teamRepo)
-	playerService := clubApp.NewPlayerService(playerRepo, teamRepo)
+	teamService := clubApp.NewTeamService(teamRepo, venueRepo)
+	playerService := clubApp.NewPlayerService(playerRepo, teamRepo, registrationRepo)
+	venueService := clubApp.NewVenueService(venueRepo, matchPostgres.NewVenueRepository(db))
+	staffService := clubApp.NewStaffService(staffRepo, teamRepo)
 
 	teamH := clubHandler.NewTeamHandler(teamService)
 	playerH := clubHandler.NewPlayerHandler(playerService)
+	venueH := clubHandler.NewVenueHandler(venueService)
+	staffH := clubHandler.NewStaffHandler(staffService)
+
+	clubHandler.RegisterRoutes(rg, teamH, playerH, venueH, staffH, adminMW, authMW)
+}
+
+func registerCompetitionModule(db *pgxpool.Pool, rg *gin.RouterGroup, authMW gin.HandlerFunc) {
+	competitionRepo := competitionPostgres.NewCompetitionRepository(db)
+	seasonRepo := competitionPostgres.NewSeasonRepository(db)
+	teamRepo := competitionPostgres.NewTeamRepository(db)
 
-	clubHandler.RegisterRoutes(rg, teamH, playerH, authMW)
+	competitionService := competitionApp.NewCompetitionService(competitionRepo)
+	seasonService := competitionApp.NewSeasonService(seasonRepo, competitionRepo, teamRepo)
+
+	competitionH := competitionHandler.NewCompetitionHandler(competitionService)
+	seasonH := competitionHandler.NewSeasonHandler(seasonService)
+
+	competitionHandler.RegisterRoutes(rg, competitionH, seasonH, authMW)
 }
 
-func registerMatchModule(db *pgxpool.Pool, rg *gin.RouterGroup, authMW gin.HandlerFunc) {
+func registerMatchModule(db *pgxpool.Pool, rg *gin.RouterGroup, cfg *config.AppConfig, jwtService *jwt.Service, authMW, adminMW, resultMW gin.HandlerFunc) {
 	matchRepo := matchPostgres.NewMatchRepository(db)
 	resultRepo := matchPostgres.NewMatchResultRepository(db)
 	reportRepo := matchPostgres.NewReportRepository(db)
-
-	matchService := matchApp.NewMatchService(matchRepo, resultRepo, reportRepo)
+	seasonRepo := matchPostgres.NewSeasonRepository(db)
+	bracketRepo := matchPostgres.NewBracketRepository(db)
+	squadRepo := matchPostgres.NewSquadRepository(db)
+	disciplineRepo := matchPostgres.NewDisciplineRepository(db)
+	lineupRepo := matchPostgres.NewLineupRepository(db)
+	liveRepo := matchPostgres.NewLiveEventRepository(db)
+	matchVenueRepo := matchPostgres.NewVenueRepository(db)
+	officialRepo := matchPostgres.NewOfficialRepository(db)
+	liveBroker := matchLive.NewBroker()
+
+	rules := matchDomain.SchedulingRules{
+		MatchDuration: time.Duration(cfg.Schedule.MatchDurationMinutes) * time.Minute,
+		MinRestDays:   cfg.Schedule.MinRestDays,
+	}
+
+	matchService := matchApp.NewMatchService(matchRepo, resultRepo, reportRepo, seasonRepo, bracketRepo, squadRepo, disciplineRepo, lineupRepo, liveRepo, liveBroker, matchVenueRepo, officialRepo, rules)
+	officialService := matchApp.NewOfficialService(officialRepo, matchRepo, jwtService)
 
 	matchH := matchHandler.NewMatchHandler(matchService)
+	officialH := matchHandler.NewOfficialHandler(officialService)
 
-	matchHandler.RegisterRoutes(rg, matchH, authMW)
+	matchHandler.RegisterRoutes(rg, matchH, officialH, adminMW, resultMW, authMW)
 }
 
-func registerReportingModule(db *pgxpool.Pool, rg *gin.RouterGroup) {
+func registerReportingModule(ctx context.Context, db *pgxpool.Pool, rg *gin.RouterGroup) {
 	repo := reportingPostgres.NewReportingRepository(db)
 	service := reportingApp.NewReportingService(repo)
+
+	hub := reportingScoreboard.NewHub(service)
+	go hub.Run(ctx)
+
 	h := reportingHandler.NewReportingHandler(service)
-	reportingHandler.RegisterRoutes(rg, h)
+	scoreboardH := reportingHandler.NewScoreboardHandler(hub)
+	reportingHandler.RegisterRoutes(rg, h, scoreboardH)
 }
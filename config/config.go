@@ -43,6 +43,10 @@ type AppConfig struct {
 		Issuer         string `toml:"issuer"`
 		Subject        string `toml:"subject"`
 	} `toml:"jwt"`
+	Schedule struct {
+		MatchDurationMinutes int `toml:"match_duration_minutes"`
+		MinRestDays          int `toml:"min_rest_days"`
+	} `toml:"schedule"`
 }
 
 type JWTKeys struct {
@@ -87,6 +91,9 @@ func loadConfig() (*AppConfig, error) {
 	viper.SetConfigType("toml")
 	viper.SetConfigName("app")
 
+	viper.SetDefault("schedule.match_duration_minutes", 120)
+	viper.SetDefault("schedule.min_rest_days", 2)
+
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
 			return nil, fmt.Errorf("config file not found: %w", err)
@@ -116,6 +123,9 @@ func loadConfig() (*AppConfig, error) {
 	config.Upload.MaxSize = viper.GetInt64("upload.max_size")
 	config.Upload.AllowedTypes = viper.GetStringSlice("upload.allowed_types")
 
+	config.Schedule.MatchDurationMinutes = viper.GetInt("schedule.match_duration_minutes")
+	config.Schedule.MinRestDays = viper.GetInt("schedule.min_rest_days")
+
 	logger.Get().Info("Configuration successfully loaded")
 
 	return &config, nil
@@ -53,7 +53,7 @@ func (s *AuthService) Login(ctx context.Context, username, password string) (str
 		return "", derrors.NewErrorf(derrors.ErrorCodeUnauthorized, "invalid credentials")
 	}
 
-	token, err := s.jwtService.GenerateToken(jwt.JwtAttr{Email: user.Username}, tokenExpiry)
+	token, err := s.jwtService.GenerateToken(jwt.JwtAttr{Email: user.Username, Role: user.Role}, tokenExpiry)
 	if err != nil {
 		return "", derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to generate token")
 	}
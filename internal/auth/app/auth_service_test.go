@@ -79,6 +79,34 @@ func TestAuthService_Login_Success(t *testing.T) {
 	}
 }
 
+func TestAuthService_Login_TokenCarriesRole(t *testing.T) {
+	// Given
+	svc, mockRepo := setupAuthService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByUsername(ctx, "editor").Return(&domain.User{
+		ID:           "user-2",
+		Username:     "editor",
+		PasswordHash: hashPassword(t, "editor123"),
+		Role:         domain.RoleEditor,
+	}, nil)
+
+	// When
+	token, err := svc.Login(ctx, "editor", "editor123")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	attr, err := svc.jwtService.ParseAndVerify(token)
+	if err != nil {
+		t.Fatalf("expected a valid token, got: %v", err)
+	}
+	if attr.Email != "editor" || attr.Role != domain.RoleEditor {
+		t.Fatalf("expected editor claims, got %+v", attr)
+	}
+}
+
 func TestAuthService_Login_WrongPassword(t *testing.T) {
 	// Given
 	svc, mockRepo := setupAuthService(t)
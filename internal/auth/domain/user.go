@@ -9,11 +9,19 @@ import (
 
 var ErrUserNotFound = derrors.NewErrorf(derrors.ErrorCodeNotFound, "user not found")
 
-// User represents an authenticated user (admin).
+// User roles. Editors manage clubs, competitions and matches; admins can also
+// perform destructive operations such as restoring deleted matches.
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+)
+
+// User represents an authenticated user.
 type User struct {
 	ID           string
 	Username     string
 	PasswordHash string
+	Role         string
 	CreatedAt    time.Time
 }
 
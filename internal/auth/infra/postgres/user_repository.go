@@ -19,13 +19,14 @@ func NewUserRepository(db *pgxpool.Pool) *UserRepository {
 }
 
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
-	query := `SELECT id, username, password_hash, created_at FROM users WHERE username = $1`
+	query := `SELECT id, username, password_hash, role, created_at FROM users WHERE username = $1`
 
 	var user domain.User
 	err := r.db.QueryRow(ctx, query, username).Scan(
 		&user.ID,
 		&user.Username,
 		&user.PasswordHash,
+		&user.Role,
 		&user.CreatedAt,
 	)
 	if err != nil {
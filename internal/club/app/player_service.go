@@ -2,24 +2,30 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
 	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
 )
 
 type PlayerService struct {
-	playerRepo domain.PlayerRepository
-	teamRepo   domain.TeamRepository
+	playerRepo       domain.PlayerRepository
+	teamRepo         domain.TeamRepository
+	registrationRepo domain.RegistrationRepository
 }
 
-func NewPlayerService(playerRepo domain.PlayerRepository, teamRepo domain.TeamRepository) PlayerServicePort {
+func NewPlayerService(playerRepo domain.PlayerRepository, teamRepo domain.TeamRepository, registrationRepo domain.RegistrationRepository) PlayerServicePort {
 	return &PlayerService{
-		playerRepo: playerRepo,
-		teamRepo:   teamRepo,
+		playerRepo:       playerRepo,
+		teamRepo:         teamRepo,
+		registrationRepo: registrationRepo,
 	}
 }
 
-func (s *PlayerService) Create(ctx context.Context, player *domain.Player) (string, error) {
+// Create registers a player with their team. Registrations that break the rules of the team's seasons
+// are refused unless forced, in which case every rule broken is recorded against overriddenBy.
+func (s *PlayerService) Create(ctx context.Context, player *domain.Player, force bool, overriddenBy string) (string, error) {
 	// Verify team exists
 	_, err := s.teamRepo.FindByID(ctx, player.TeamID)
 	if err != nil {
@@ -36,12 +42,17 @@ func (s *PlayerService) Create(ctx context.Context, player *domain.Player) (stri
 	}
 
 	// Construct valid entity via domain factory
-	newPlayer, err := domain.NewPlayer(player.TeamID, player.Name, player.Height, player.Weight, player.Position, player.JerseyNumber)
+	newPlayer, err := domain.NewPlayer(player.TeamID, player.Name, player.Height, player.Weight, player.Position, player.JerseyNumber, player.Profile)
 	if err != nil {
 		return "", err
 	}
 
-	if err := s.playerRepo.Create(ctx, newPlayer); err != nil {
+	overrides, err := s.checkRegistration(ctx, newPlayer, newPlayer.CreatedAt, domain.ActionRegistration, force, overriddenBy)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.playerRepo.Create(ctx, newPlayer, domain.NewSpell(newPlayer), overrides); err != nil {
 		return "", err
 	}
 
@@ -56,17 +67,23 @@ func (s *PlayerService) GetByID(ctx context.Context, id string) (*domain.Player,
 	return player, nil
 }
 
-func (s *PlayerService) GetByTeamID(ctx context.Context, teamID string) ([]domain.Player, error) {
-	_, err := s.teamRepo.FindByID(ctx, teamID)
-	if err != nil {
+// GetAll lists the players matching the filter, across teams unless it names one.
+func (s *PlayerService) GetAll(ctx context.Context, filter domain.PlayerFilter) ([]domain.Player, error) {
+	if err := filter.Validate(); err != nil {
 		return nil, err
 	}
 
-	players, err := s.playerRepo.FindByTeamID(ctx, teamID)
+	return s.playerRepo.FindAll(ctx, filter)
+}
+
+func (s *PlayerService) GetByTeamID(ctx context.Context, teamID string, filter domain.PlayerFilter) ([]domain.Player, error) {
+	_, err := s.teamRepo.FindByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
-	return players, nil
+
+	filter.TeamID = teamID
+	return s.GetAll(ctx, filter)
 }
 
 func (s *PlayerService) Update(ctx context.Context, id string, player *domain.Player) error {
@@ -84,7 +101,7 @@ func (s *PlayerService) Update(ctx context.Context, id string, player *domain.Pl
 		return derrors.WrapErrorf(domain.ErrJerseyNumberTaken, derrors.ErrorCodeDuplicate, "jersey number %d is already taken", player.JerseyNumber)
 	}
 
-	if err := existing.Update(player.Name, player.Height, player.Weight, player.Position, player.JerseyNumber); err != nil {
+	if err := existing.Update(player.Name, player.Height, player.Weight, player.Position, player.JerseyNumber, player.Profile); err != nil {
 		return err
 	}
 
@@ -107,3 +124,100 @@ func (s *PlayerService) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// Transfer moves a player to another team, keeping their spell at the old team in their career.
+// Like registrations, transfers that break the rules of the destination team's seasons need forcing.
+func (s *PlayerService) Transfer(ctx context.Context, id string, transfer domain.Transfer, force bool, overriddenBy string) (*domain.Player, error) {
+	player, err := s.playerRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.teamRepo.FindByID(ctx, transfer.ToTeamID); err != nil {
+		return nil, err
+	}
+
+	current, err := s.playerRepo.FindCurrentSpell(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	opened, err := player.Transfer(current, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	taken, err := s.playerRepo.IsJerseyNumberTaken(ctx, player.TeamID, player.JerseyNumber, player.ID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, derrors.WithDetails(domain.ErrJerseyNumberTaken, derrors.ErrorCodeDuplicate, []derrors.Detail{{
+			Field:   "jersey_number",
+			Message: fmt.Sprintf("jersey number %d is already taken at the destination team, choose another one", player.JerseyNumber),
+		}}, "%s", domain.ErrJerseyNumberTaken.Error())
+	}
+
+	overrides, err := s.checkRegistration(ctx, player, opened.JoinedOn, domain.ActionTransfer, force, overriddenBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.playerRepo.Transfer(ctx, player, current, opened, overrides); err != nil {
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// GetCareer returns every spell of a player, oldest first.
+func (s *PlayerService) GetCareer(ctx context.Context, id string) ([]domain.Spell, error) {
+	if _, err := s.playerRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.playerRepo.FindSpells(ctx, id)
+}
+
+// GetRegistrationOverrides returns the registration rules admins overrode for the team, newest first.
+func (s *PlayerService) GetRegistrationOverrides(ctx context.Context, teamID string) ([]domain.RegistrationOverride, error) {
+	if _, err := s.teamRepo.FindByID(ctx, teamID); err != nil {
+		return nil, err
+	}
+
+	return s.registrationRepo.FindOverrides(ctx, teamID)
+}
+
+// checkRegistration ensures the player may join their team on the given day under the rules of every
+// season the team plays in. When forced, the rules broken are returned as overrides to record instead,
+// unless one of them cannot be overridden.
+func (s *PlayerService) checkRegistration(ctx context.Context, player *domain.Player, on time.Time, action domain.RegistrationAction, force bool, overriddenBy string) ([]domain.RegistrationOverride, error) {
+	seasons, err := s.registrationRepo.FindSeasonRules(ctx, player.TeamID, on)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []domain.Violation
+	for _, rules := range seasons {
+		squad, err := s.registrationRepo.CountSquad(ctx, player.TeamID, rules.HomeNationality)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, rules.Check(player, on, squad)...)
+	}
+	if len(violations) == 0 {
+		return nil, nil
+	}
+
+	details := make([]derrors.Detail, len(violations))
+	overridable := true
+	for i, v := range violations {
+		details[i] = v.Detail()
+		overridable = overridable && v.Overridable()
+	}
+	if force && overridable {
+		return domain.NewRegistrationOverrides(player, action, violations, overriddenBy), nil
+	}
+
+	return nil, derrors.WithDetails(domain.ErrRegistrationRules, derrors.ErrorCodeBadRequest, details, "%s", domain.ErrRegistrationRules.Error())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
 	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/mock"
@@ -12,15 +13,27 @@ import (
 )
 
 func setupPlayerService(t *testing.T) (*PlayerService, *mockDomain.MockPlayerRepository, *mockDomain.MockTeamRepository) {
+	svc, mockPlayerRepo, mockTeamRepo, _ := setupPlayerServiceWithRules(t)
+	return svc, mockPlayerRepo, mockTeamRepo
+}
+
+func setupPlayerServiceWithRules(t *testing.T) (
+	*PlayerService,
+	*mockDomain.MockPlayerRepository,
+	*mockDomain.MockTeamRepository,
+	*mockDomain.MockRegistrationRepository,
+) {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	mockPlayerRepo := mockDomain.NewMockPlayerRepository(ctrl)
 	mockTeamRepo := mockDomain.NewMockTeamRepository(ctrl)
+	mockRegistrationRepo := mockDomain.NewMockRegistrationRepository(ctrl)
 	svc := &PlayerService{
-		playerRepo: mockPlayerRepo,
-		teamRepo:   mockTeamRepo,
+		playerRepo:       mockPlayerRepo,
+		teamRepo:         mockTeamRepo,
+		registrationRepo: mockRegistrationRepo,
 	}
-	return svc, mockPlayerRepo, mockTeamRepo
+	return svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo
 }
 
 // assertPlayerErrorCode verifies the error is a *derrors.Error with the expected code.
@@ -41,7 +54,7 @@ func assertPlayerErrorCode(t *testing.T, err error, expectedCode derrors.ErrorCo
 
 func TestPlayerService_Create_Success(t *testing.T) {
 	// Given
-	svc, mockPlayerRepo, mockTeamRepo := setupPlayerService(t)
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
 	ctx := context.Background()
 	input := &domain.Player{
 		TeamID:       "team-1",
@@ -54,10 +67,11 @@ func TestPlayerService_Create_Success(t *testing.T) {
 
 	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
-	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return(nil, nil)
+	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err != nil {
@@ -84,7 +98,7 @@ func TestPlayerService_Create_TeamNotFound(t *testing.T) {
 	mockTeamRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, domain.ErrTeamNotFound)
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -115,7 +129,7 @@ func TestPlayerService_Create_JerseyCheckRepoError(t *testing.T) {
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to check jersey"))
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -144,7 +158,7 @@ func TestPlayerService_Create_JerseyNumberTaken(t *testing.T) {
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(true, nil)
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -176,7 +190,7 @@ func TestPlayerService_Create_ValidationError_EmptyName(t *testing.T) {
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -205,7 +219,7 @@ func TestPlayerService_Create_ValidationError_InvalidPosition(t *testing.T) {
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -234,7 +248,7 @@ func TestPlayerService_Create_ValidationError_JerseyNumberZero(t *testing.T) {
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 0, "").Return(false, nil)
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -263,7 +277,7 @@ func TestPlayerService_Create_ValidationError_JerseyNumberExceeds99(t *testing.T
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 100, "").Return(false, nil)
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -277,7 +291,7 @@ func TestPlayerService_Create_ValidationError_JerseyNumberExceeds99(t *testing.T
 
 func TestPlayerService_Create_RepoError(t *testing.T) {
 	// Given
-	svc, mockPlayerRepo, mockTeamRepo := setupPlayerService(t)
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
 	ctx := context.Background()
 	input := &domain.Player{
 		TeamID:       "team-1",
@@ -290,10 +304,11 @@ func TestPlayerService_Create_RepoError(t *testing.T) {
 
 	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
 	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
-	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any()).Return(derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to create player"))
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return(nil, nil)
+	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to create player"))
 
 	// When
-	id, err := svc.Create(ctx, input)
+	id, err := svc.Create(ctx, input, false, "")
 
 	// Then
 	if err == nil {
@@ -371,10 +386,10 @@ func TestPlayerService_GetByTeamID_Success(t *testing.T) {
 	}
 
 	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
-	mockPlayerRepo.EXPECT().FindByTeamID(ctx, "team-1").Return(expected, nil)
+	mockPlayerRepo.EXPECT().FindAll(ctx, domain.PlayerFilter{TeamID: "team-1"}).Return(expected, nil)
 
 	// When
-	players, err := svc.GetByTeamID(ctx, "team-1")
+	players, err := svc.GetByTeamID(ctx, "team-1", domain.PlayerFilter{})
 
 	// Then
 	if err != nil {
@@ -396,7 +411,7 @@ func TestPlayerService_GetByTeamID_TeamNotFound(t *testing.T) {
 	mockTeamRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, domain.ErrTeamNotFound)
 
 	// When
-	players, err := svc.GetByTeamID(ctx, "nonexistent")
+	players, err := svc.GetByTeamID(ctx, "nonexistent", domain.PlayerFilter{})
 
 	// Then
 	if err == nil {
@@ -416,10 +431,10 @@ func TestPlayerService_GetByTeamID_RepoError(t *testing.T) {
 	ctx := context.Background()
 
 	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
-	mockPlayerRepo.EXPECT().FindByTeamID(ctx, "team-1").Return(nil, derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to fetch players"))
+	mockPlayerRepo.EXPECT().FindAll(ctx, domain.PlayerFilter{TeamID: "team-1"}).Return(nil, derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to fetch players"))
 
 	// When
-	players, err := svc.GetByTeamID(ctx, "team-1")
+	players, err := svc.GetByTeamID(ctx, "team-1", domain.PlayerFilter{})
 
 	// Then
 	if err == nil {
@@ -683,3 +698,462 @@ func TestPlayerService_Delete_RepoError(t *testing.T) {
 	}
 	assertPlayerErrorCode(t, err, derrors.ErrorCodeInternal)
 }
+
+// ---------------------------------------------------------------------------
+// Transfer
+// ---------------------------------------------------------------------------
+
+// transferablePlayer returns a player who joined team-1 a year ago, with their current spell.
+func transferablePlayer() (*domain.Player, *domain.Spell) {
+	joinedOn := time.Now().AddDate(-1, 0, 0)
+	player := &domain.Player{ID: "player-1", TeamID: "team-1", Name: "Beckham", Position: domain.PositionCM, JerseyNumber: 10, CreatedAt: joinedOn}
+	return player, domain.NewSpell(player)
+}
+
+func TestPlayerService_Transfer_Success(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+	player, current := transferablePlayer()
+	effective := time.Now().AddDate(0, -1, 0)
+
+	mockPlayerRepo.EXPECT().FindByID(ctx, "player-1").Return(player, nil)
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-2").Return(&domain.Team{ID: "team-2"}, nil)
+	mockPlayerRepo.EXPECT().FindCurrentSpell(ctx, "player-1").Return(current, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-2", 10, "player-1").Return(false, nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-2", gomock.Any()).Return(nil, nil)
+	mockPlayerRepo.EXPECT().Transfer(ctx, player, current, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *domain.Player, closed, opened *domain.Spell, _ []domain.RegistrationOverride) error {
+			if closed.LeftOn == nil || !closed.LeftOn.Equal(opened.JoinedOn) {
+				t.Fatalf("expected the old spell to end the day the new one starts, got %v and %v", closed.LeftOn, opened.JoinedOn)
+			}
+			if opened.TeamID != "team-2" || opened.FeeType != domain.FeePermanent {
+				t.Fatalf("expected a permanent spell at team-2, got %+v", opened)
+			}
+			return nil
+		})
+
+	// When
+	moved, err := svc.Transfer(ctx, "player-1", domain.Transfer{ToTeamID: "team-2", EffectiveOn: effective, FeeType: "permanent"}, false, "")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if moved.TeamID != "team-2" || moved.JerseyNumber != 10 {
+		t.Fatalf("expected the player at team-2 wearing 10, got %+v", moved)
+	}
+}
+
+func TestPlayerService_Transfer_JerseyNumberTakenAtDestination(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo := setupPlayerService(t)
+	ctx := context.Background()
+	player, current := transferablePlayer()
+
+	mockPlayerRepo.EXPECT().FindByID(ctx, "player-1").Return(player, nil)
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-2").Return(&domain.Team{ID: "team-2"}, nil)
+	mockPlayerRepo.EXPECT().FindCurrentSpell(ctx, "player-1").Return(current, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-2", 7, "player-1").Return(true, nil)
+
+	// When
+	_, err := svc.Transfer(ctx, "player-1", domain.Transfer{ToTeamID: "team-2", EffectiveOn: time.Now(), FeeType: domain.FeeFree, JerseyNumber: 7}, false, "")
+
+	// Then
+	if !errors.Is(err, domain.ErrJerseyNumberTaken) {
+		t.Fatalf("expected ErrJerseyNumberTaken, got: %v", err)
+	}
+	var dErr *derrors.Error
+	if !errors.As(err, &dErr) || len(dErr.Details()) != 1 || dErr.Details()[0].Field != "jersey_number" {
+		t.Fatalf("expected a detail on jersey_number, got: %v", err)
+	}
+}
+
+func TestPlayerService_Transfer_LoanEndDateOnlyForLoans(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo := setupPlayerService(t)
+	ctx := context.Background()
+	player, current := transferablePlayer()
+	loanEnd := time.Now().AddDate(0, 6, 0)
+
+	mockPlayerRepo.EXPECT().FindByID(ctx, "player-1").Return(player, nil)
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-2").Return(&domain.Team{ID: "team-2"}, nil)
+	mockPlayerRepo.EXPECT().FindCurrentSpell(ctx, "player-1").Return(current, nil)
+
+	// When
+	_, err := svc.Transfer(ctx, "player-1", domain.Transfer{ToTeamID: "team-2", EffectiveOn: time.Now(), FeeType: domain.FeePermanent, LoanEndsOn: &loanEnd}, false, "")
+
+	// Then
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestPlayerService_Transfer_BeforeJoiningCurrentTeam(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo := setupPlayerService(t)
+	ctx := context.Background()
+	player, current := transferablePlayer()
+
+	mockPlayerRepo.EXPECT().FindByID(ctx, "player-1").Return(player, nil)
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-2").Return(&domain.Team{ID: "team-2"}, nil)
+	mockPlayerRepo.EXPECT().FindCurrentSpell(ctx, "player-1").Return(current, nil)
+
+	// When
+	_, err := svc.Transfer(ctx, "player-1", domain.Transfer{ToTeamID: "team-2", EffectiveOn: time.Now().AddDate(-2, 0, 0), FeeType: domain.FeeLoan}, false, "")
+
+	// Then
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestPlayerService_Transfer_SameTeam(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo := setupPlayerService(t)
+	ctx := context.Background()
+	player, current := transferablePlayer()
+
+	mockPlayerRepo.EXPECT().FindByID(ctx, "player-1").Return(player, nil)
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockPlayerRepo.EXPECT().FindCurrentSpell(ctx, "player-1").Return(current, nil)
+
+	// When
+	_, err := svc.Transfer(ctx, "player-1", domain.Transfer{ToTeamID: "team-1", EffectiveOn: time.Now(), FeeType: domain.FeeFree}, false, "")
+
+	// Then
+	if !errors.Is(err, domain.ErrSameTeamTransfer) {
+		t.Fatalf("expected ErrSameTeamTransfer, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Registration rules
+// ---------------------------------------------------------------------------
+
+// seasonRules returns rules of a season with a window that closed last month, a squad of at most
+// 25 players and at most 5 foreign ones.
+func seasonRules() domain.SeasonRules {
+	maxSquad, maxForeign := 25, 5
+	return domain.SeasonRules{
+		SeasonID:          "season-1",
+		SeasonName:        "Liga 1 2026/2027",
+		Windows:           []domain.RegistrationWindow{{OpensOn: time.Now().AddDate(0, -3, 0), ClosesOn: time.Now().AddDate(0, -1, 0)}},
+		MaxSquadSize:      &maxSquad,
+		MaxForeignPlayers: &maxForeign,
+		HomeNationality:   "ID",
+	}
+}
+
+func registeringPlayer(nationality string) *domain.Player {
+	return &domain.Player{TeamID: "team-1", Name: "Beckham", Height: 180, Weight: 75, Position: domain.PositionCM, JerseyNumber: 10, Profile: domain.Profile{Nationality: nationality}}
+}
+
+func TestPlayerService_Create_OutsideRegistrationWindow(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return([]domain.SeasonRules{seasonRules()}, nil)
+	mockRegistrationRepo.EXPECT().CountSquad(ctx, "team-1", "ID").Return(domain.Squad{Size: 20, Foreign: 2}, nil)
+
+	// When
+	_, err := svc.Create(ctx, registeringPlayer(""), false, "")
+
+	// Then
+	if !errors.Is(err, domain.ErrRegistrationRules) {
+		t.Fatalf("expected ErrRegistrationRules, got: %v", err)
+	}
+	var dErr *derrors.Error
+	if !errors.As(err, &dErr) || len(dErr.Details()) != 1 || dErr.Details()[0].Field != "window" {
+		t.Fatalf("expected a detail on window, got: %v", err)
+	}
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestPlayerService_Create_SquadFull(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+	rules := seasonRules()
+	rules.Windows = nil
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return([]domain.SeasonRules{rules}, nil)
+	mockRegistrationRepo.EXPECT().CountSquad(ctx, "team-1", "ID").Return(domain.Squad{Size: 25, Foreign: 2}, nil)
+
+	// When
+	_, err := svc.Create(ctx, registeringPlayer("ID"), false, "")
+
+	// Then
+	var dErr *derrors.Error
+	if !errors.As(err, &dErr) || len(dErr.Details()) != 1 || dErr.Details()[0].Field != "squad_size" {
+		t.Fatalf("expected a detail on squad_size, got: %v", err)
+	}
+}
+
+func TestPlayerService_Create_ForeignQuotaOnlyCountsForeignPlayers(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+	rules := seasonRules()
+	rules.Windows = nil
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil).Times(2)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil).Times(2)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return([]domain.SeasonRules{rules}, nil).Times(2)
+	mockRegistrationRepo.EXPECT().CountSquad(ctx, "team-1", "ID").Return(domain.Squad{Size: 20, Foreign: 5}, nil).Times(2)
+	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Len(0)).Return(nil)
+
+	// When
+	_, foreignErr := svc.Create(ctx, registeringPlayer("br"), false, "")
+	_, homeErr := svc.Create(ctx, registeringPlayer("ID"), false, "")
+
+	// Then
+	var dErr *derrors.Error
+	if !errors.As(foreignErr, &dErr) || len(dErr.Details()) != 1 || dErr.Details()[0].Field != "foreign_quota" {
+		t.Fatalf("expected a detail on foreign_quota for the foreign player, got: %v", foreignErr)
+	}
+	if homeErr != nil {
+		t.Fatalf("expected the home player to be registered, got: %v", homeErr)
+	}
+}
+
+func TestPlayerService_Create_ForcedRecordsOverrides(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return([]domain.SeasonRules{seasonRules()}, nil)
+	mockRegistrationRepo.EXPECT().CountSquad(ctx, "team-1", "ID").Return(domain.Squad{Size: 25, Foreign: 5}, nil)
+	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, player *domain.Player, _ *domain.Spell, overrides []domain.RegistrationOverride) error {
+			if len(overrides) != 3 {
+				t.Fatalf("expected the window, squad size and foreign quota to be overridden, got %+v", overrides)
+			}
+			for _, o := range overrides {
+				if o.PlayerID != player.ID || o.SeasonID != "season-1" || o.Action != domain.ActionRegistration || o.OverriddenBy != "admin@example.com" {
+					t.Fatalf("expected a registration override of season-1 by the admin, got %+v", o)
+				}
+			}
+			return nil
+		})
+
+	// When
+	_, err := svc.Create(ctx, registeringPlayer("BR"), true, "admin@example.com")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+// youthRules are the rules of an under-17 season, whose players must be at most 16 on the cutoff date.
+func youthRules() domain.SeasonRules {
+	maxAge, cutoff := 16, time.Now().AddDate(0, 6, 0)
+	return domain.SeasonRules{SeasonID: "season-u17", SeasonName: "Liga U-17 2026/2027", HomeNationality: "ID", MaxAge: &maxAge, AgeCutoffDate: &cutoff}
+}
+
+// bornYearsBefore returns a player registering for team-1 who is the given number of years old on the day.
+func bornYearsBefore(years int, on time.Time) *domain.Player {
+	player := registeringPlayer("ID")
+	dob := on.AddDate(-years, -1, 0)
+	player.DateOfBirth = &dob
+	return player
+}
+
+func TestPlayerService_Create_YouthSeasonChecksAge(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+	rules := youthRules()
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil).Times(3)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil).Times(3)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return([]domain.SeasonRules{rules}, nil).Times(3)
+	mockRegistrationRepo.EXPECT().CountSquad(ctx, "team-1", "ID").Return(domain.Squad{Size: 10}, nil).Times(3)
+	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Len(0)).Return(nil)
+
+	// When
+	_, youngErr := svc.Create(ctx, bornYearsBefore(16, *rules.AgeCutoffDate), false, "")
+	_, oldErr := svc.Create(ctx, bornYearsBefore(17, *rules.AgeCutoffDate), false, "")
+	_, unknownErr := svc.Create(ctx, registeringPlayer("ID"), false, "")
+
+	// Then
+	if youngErr != nil {
+		t.Fatalf("expected a player of 16 on the cutoff date to be registered, got: %v", youngErr)
+	}
+	for _, err := range []error{oldErr, unknownErr} {
+		var dErr *derrors.Error
+		if !errors.As(err, &dErr) || len(dErr.Details()) != 1 || dErr.Details()[0].Field != "age" {
+			t.Fatalf("expected a detail on age, got: %v", err)
+		}
+	}
+}
+
+func TestPlayerService_Create_AgeLimitCannotBeForced(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+	rules := youthRules()
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return([]domain.SeasonRules{rules}, nil)
+	mockRegistrationRepo.EXPECT().CountSquad(ctx, "team-1", "ID").Return(domain.Squad{Size: 10}, nil)
+
+	// When
+	_, err := svc.Create(ctx, bornYearsBefore(19, *rules.AgeCutoffDate), true, "admin@example.com")
+
+	// Then
+	if !errors.Is(err, domain.ErrRegistrationRules) {
+		t.Fatalf("expected ErrRegistrationRules even when forced, got: %v", err)
+	}
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestPlayerService_Transfer_OutsideRegistrationWindow(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+	player, current := transferablePlayer()
+
+	mockPlayerRepo.EXPECT().FindByID(ctx, "player-1").Return(player, nil)
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-2").Return(&domain.Team{ID: "team-2"}, nil)
+	mockPlayerRepo.EXPECT().FindCurrentSpell(ctx, "player-1").Return(current, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-2", 10, "player-1").Return(false, nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-2", gomock.Any()).Return([]domain.SeasonRules{seasonRules()}, nil)
+	mockRegistrationRepo.EXPECT().CountSquad(ctx, "team-2", "ID").Return(domain.Squad{Size: 20}, nil)
+
+	// When
+	_, err := svc.Transfer(ctx, "player-1", domain.Transfer{ToTeamID: "team-2", EffectiveOn: time.Now(), FeeType: domain.FeeFree}, false, "")
+
+	// Then
+	if !errors.Is(err, domain.ErrRegistrationRules) {
+		t.Fatalf("expected ErrRegistrationRules, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Profile
+// ---------------------------------------------------------------------------
+
+func TestPlayerService_Create_WithProfile(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, mockTeamRepo, mockRegistrationRepo := setupPlayerServiceWithRules(t)
+	ctx := context.Background()
+	dob := time.Now().AddDate(-21, 0, -1)
+	input := registeringPlayer("br")
+	input.Profile.DateOfBirth = &dob
+	input.Profile.PhotoURL = "/uploads/player-photo/beckham.png"
+	input.Profile.PreferredFoot = "Right"
+	input.Profile.SecondaryPositions = []domain.Position{domain.PositionCAM, domain.PositionRM}
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
+	mockRegistrationRepo.EXPECT().FindSeasonRules(ctx, "team-1", gomock.Any()).Return(nil, nil)
+	mockPlayerRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, player *domain.Player, _ *domain.Spell, _ []domain.RegistrationOverride) error {
+			if player.Nationality != "BR" || player.PreferredFoot != domain.FootRight || len(player.SecondaryPositions) != 2 {
+				t.Fatalf("expected a normalised profile, got %+v", player.Profile)
+			}
+			if age, ok := player.Age(time.Now()); !ok || age != 21 {
+				t.Fatalf("expected the player to be 21, got %d", age)
+			}
+			return nil
+		})
+
+	// When
+	_, err := svc.Create(ctx, input, false, "")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestPlayerService_Create_InvalidProfile(t *testing.T) {
+	future := time.Now().AddDate(0, 0, 1)
+	tests := []struct {
+		name    string
+		profile domain.Profile
+	}{
+		{"date of birth in the future", domain.Profile{DateOfBirth: &future}},
+		{"unparseable date of birth", domain.Profile{DateOfBirth: &time.Time{}}},
+		{"nationality not a country code", domain.Profile{Nationality: "IDN"}},
+		{"photo URL not a URL", domain.Profile{PhotoURL: "beckham photo"}},
+		{"unknown preferred foot", domain.Profile{PreferredFoot: "head"}},
+		{"secondary position is the main one", domain.Profile{SecondaryPositions: []domain.Position{domain.PositionCM}}},
+		{"too many secondary positions", domain.Profile{SecondaryPositions: []domain.Position{domain.PositionCAM, domain.PositionCDM, domain.PositionLM, domain.PositionRM}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			svc, mockPlayerRepo, mockTeamRepo := setupPlayerService(t)
+			ctx := context.Background()
+			input := registeringPlayer("")
+			input.Profile = tt.profile
+
+			mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+			mockPlayerRepo.EXPECT().IsJerseyNumberTaken(ctx, "team-1", 10, "").Return(false, nil)
+
+			// When
+			_, err := svc.Create(ctx, input, false, "")
+
+			// Then
+			assertPlayerErrorCode(t, err, derrors.ErrorCodeBadRequest)
+		})
+	}
+}
+
+func TestPlayerService_GetAll_PassesFilter(t *testing.T) {
+	// Given
+	svc, mockPlayerRepo, _ := setupPlayerService(t)
+	ctx := context.Background()
+	minAge, maxAge := 18, 23
+	filter := domain.PlayerFilter{Nationality: "ID", Position: domain.PositionST, PreferredFoot: domain.FootLeft, MinAge: &minAge, MaxAge: &maxAge}
+
+	mockPlayerRepo.EXPECT().FindAll(ctx, filter).Return([]domain.Player{{ID: "player-1"}}, nil)
+
+	// When
+	players, err := svc.GetAll(ctx, filter)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(players) != 1 {
+		t.Fatalf("expected 1 player, got %d", len(players))
+	}
+}
+
+func TestPlayerService_GetAll_MinAgeAboveMaxAge(t *testing.T) {
+	// Given
+	svc, _, _ := setupPlayerService(t)
+	minAge, maxAge := 25, 21
+
+	// When
+	_, err := svc.GetAll(context.Background(), domain.PlayerFilter{MinAge: &minAge, MaxAge: &maxAge})
+
+	// Then
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestPlayerFilter_BornBetween(t *testing.T) {
+	// Given
+	minAge, maxAge := 18, 21
+	on := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	// When
+	after, onOrBefore := domain.PlayerFilter{MinAge: &minAge, MaxAge: &maxAge}.BornBetween(on)
+
+	// Then
+	if !after.Equal(time.Date(2004, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected players born after 2004-03-15, got %s", after)
+	}
+	if !onOrBefore.Equal(time.Date(2008, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected players born on or before 2008-03-15, got %s", onOrBefore)
+	}
+}
@@ -17,11 +17,41 @@ type TeamServicePort interface {
 	Delete(ctx context.Context, id string) error
 }
 
+// VenueServicePort defines the contract for venue business operations.
+type VenueServicePort interface {
+	Create(ctx context.Context, venue *domain.Venue) (string, error)
+	GetByID(ctx context.Context, id string) (*domain.Venue, error)
+	GetAll(ctx context.Context) ([]domain.Venue, error)
+	Update(ctx context.Context, id string, venue *domain.Venue) error
+	Delete(ctx context.Context, id string) error
+	// Merge folds a duplicate venue into the one that is kept.
+	Merge(ctx context.Context, keepID, duplicateID string) error
+}
+
 // PlayerServicePort defines the contract for player business operations.
 type PlayerServicePort interface {
-	Create(ctx context.Context, player *domain.Player) (string, error)
+	// Create registers a player. Forcing it lets the player break the registration rules of the team's
+	// seasons, recording each rule broken against overriddenBy.
+	Create(ctx context.Context, player *domain.Player, force bool, overriddenBy string) (string, error)
 	GetByID(ctx context.Context, id string) (*domain.Player, error)
-	GetByTeamID(ctx context.Context, teamID string) ([]domain.Player, error)
+	GetAll(ctx context.Context, filter domain.PlayerFilter) ([]domain.Player, error)
+	GetByTeamID(ctx context.Context, teamID string, filter domain.PlayerFilter) ([]domain.Player, error)
 	Update(ctx context.Context, id string, player *domain.Player) error
 	Delete(ctx context.Context, id string) error
+	// Transfer moves a player to another team and returns them as they are at the new team.
+	// It is forced past the registration rules like Create.
+	Transfer(ctx context.Context, id string, transfer domain.Transfer, force bool, overriddenBy string) (*domain.Player, error)
+	GetCareer(ctx context.Context, id string) ([]domain.Spell, error)
+	GetRegistrationOverrides(ctx context.Context, teamID string) ([]domain.RegistrationOverride, error)
+}
+
+// StaffServicePort defines the contract for the business operations on team staff.
+type StaffServicePort interface {
+	Create(ctx context.Context, staff *domain.Staff) (string, error)
+	GetByID(ctx context.Context, id string) (*domain.Staff, error)
+	GetByTeamID(ctx context.Context, teamID string) ([]domain.Staff, error)
+	Update(ctx context.Context, id string, staff *domain.Staff) error
+	Delete(ctx context.Context, id string) error
+	// GetHeadCoaches returns the head coach tenures of a team, newest first.
+	GetHeadCoaches(ctx context.Context, teamID string) ([]domain.Tenure, error)
 }
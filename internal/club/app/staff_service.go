@@ -0,0 +1,161 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+)
+
+type StaffService struct {
+	staffRepo domain.StaffRepository
+	teamRepo  domain.TeamRepository
+}
+
+func NewStaffService(staffRepo domain.StaffRepository, teamRepo domain.TeamRepository) StaffServicePort {
+	return &StaffService{
+		staffRepo: staffRepo,
+		teamRepo:  teamRepo,
+	}
+}
+
+// Create adds a member of staff to a team. A head coach takes charge on the day their contract starts,
+// which may not be before the previous head coach handed over.
+func (s *StaffService) Create(ctx context.Context, staff *domain.Staff) (string, error) {
+	if _, err := s.teamRepo.FindByID(ctx, staff.TeamID); err != nil {
+		return "", err
+	}
+
+	newStaff, err := domain.NewStaff(staff.TeamID, staff.Name, staff.Role, staff.License, staff.ContractStart, staff.ContractEnd, staff.PhotoURL)
+	if err != nil {
+		return "", err
+	}
+
+	var opened *domain.Tenure
+	if newStaff.Role == domain.RoleHeadCoach {
+		last, err := s.staffRepo.FindLatestTenure(ctx, newStaff.TeamID)
+		if err != nil {
+			return "", err
+		}
+		if opened, err = domain.NewTenure(newStaff, newStaff.ContractStart, last); err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.staffRepo.Create(ctx, newStaff, opened); err != nil {
+		return "", err
+	}
+
+	return newStaff.ID, nil
+}
+
+func (s *StaffService) GetByID(ctx context.Context, id string) (*domain.Staff, error) {
+	return s.staffRepo.FindByID(ctx, id)
+}
+
+func (s *StaffService) GetByTeamID(ctx context.Context, teamID string) ([]domain.Staff, error) {
+	if _, err := s.teamRepo.FindByID(ctx, teamID); err != nil {
+		return nil, err
+	}
+	return s.staffRepo.FindByTeamID(ctx, teamID)
+}
+
+// Update changes a member of staff. Promoting them to head coach puts them in charge from today, and
+// moving the head coach to another role ends their tenure today. A head coach whose contract dates change
+// keeps a tenure that follows them.
+func (s *StaffService) Update(ctx context.Context, id string, staff *domain.Staff) error {
+	existing, err := s.staffRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	wasHeadCoach, previousStart := existing.Role == domain.RoleHeadCoach, existing.ContractStart
+	if err := existing.Update(staff.Name, staff.Role, staff.License, staff.ContractStart, staff.ContractEnd, staff.PhotoURL); err != nil {
+		return err
+	}
+	isHeadCoach := existing.Role == domain.RoleHeadCoach
+
+	var changed, opened *domain.Tenure
+	switch {
+	case wasHeadCoach && !isHeadCoach:
+		if changed, err = s.currentTenure(ctx, existing); err != nil {
+			return err
+		}
+	case wasHeadCoach && (!existing.ContractStart.Equal(previousStart) || existing.ContractEnd != nil):
+		if changed, err = s.followContract(ctx, existing, previousStart); err != nil {
+			return err
+		}
+	case !wasHeadCoach && isHeadCoach:
+		last, err := s.staffRepo.FindLatestTenure(ctx, existing.TeamID)
+		if err != nil {
+			return err
+		}
+		if opened, err = domain.NewTenure(existing, time.Now(), last); err != nil {
+			return err
+		}
+	}
+
+	return s.staffRepo.Update(ctx, existing, changed, opened)
+}
+
+// Delete removes a member of staff. A head coach's tenure ends today.
+func (s *StaffService) Delete(ctx context.Context, id string) error {
+	existing, err := s.staffRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var closed *domain.Tenure
+	if existing.Role == domain.RoleHeadCoach {
+		if closed, err = s.currentTenure(ctx, existing); err != nil {
+			return err
+		}
+	}
+
+	return s.staffRepo.SoftDelete(ctx, id, closed)
+}
+
+// GetHeadCoaches lists every head coach the team has had, newest first.
+func (s *StaffService) GetHeadCoaches(ctx context.Context, teamID string) ([]domain.Tenure, error) {
+	if _, err := s.teamRepo.FindByID(ctx, teamID); err != nil {
+		return nil, err
+	}
+	return s.staffRepo.FindTenures(ctx, teamID)
+}
+
+// followContract returns the tenure of the head coach moved to follow their contract, which started on
+// previousStart before the update, or nil when it is unchanged or they are not in charge.
+func (s *StaffService) followContract(ctx context.Context, headCoach *domain.Staff, previousStart time.Time) (*domain.Tenure, error) {
+	tenures, err := s.staffRepo.FindTenures(ctx, headCoach.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	for i, tenure := range tenures {
+		if tenure.StaffID != headCoach.ID || tenure.EndedOn != nil {
+			continue
+		}
+		var previous *domain.Tenure
+		if i+1 < len(tenures) {
+			previous = &tenures[i+1]
+		}
+		changed, err := tenure.FollowContract(headCoach, previousStart, previous)
+		if err != nil || !changed {
+			return nil, err
+		}
+		return &tenure, nil
+	}
+	return nil, nil
+}
+
+// currentTenure returns the tenure of the head coach ended today, or nil when they are not in charge.
+func (s *StaffService) currentTenure(ctx context.Context, headCoach *domain.Staff) (*domain.Tenure, error) {
+	tenure, err := s.staffRepo.FindLatestTenure(ctx, headCoach.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if tenure == nil || tenure.EndedOn != nil || tenure.StaffID != headCoach.ID {
+		return nil, nil
+	}
+	tenure.End(time.Now())
+	return tenure, nil
+}
@@ -0,0 +1,514 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/mock"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"go.uber.org/mock/gomock"
+)
+
+func setupStaffService(t *testing.T) (*StaffService, *mockDomain.MockStaffRepository, *mockDomain.MockTeamRepository) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockStaffRepo := mockDomain.NewMockStaffRepository(ctrl)
+	mockTeamRepo := mockDomain.NewMockTeamRepository(ctrl)
+	svc := &StaffService{
+		staffRepo: mockStaffRepo,
+		teamRepo:  mockTeamRepo,
+	}
+	return svc, mockStaffRepo, mockTeamRepo
+}
+
+func headCoachInput(contractStart time.Time) *domain.Staff {
+	return &domain.Staff{
+		TeamID:        "team-1",
+		Name:          "Shin Tae-yong",
+		Role:          domain.RoleHeadCoach,
+		License:       domain.LicensePro,
+		ContractStart: contractStart,
+	}
+}
+
+func date(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func today() time.Time {
+	now := time.Now()
+	return date(now.Year(), now.Month(), now.Day())
+}
+
+// ---------------------------------------------------------------------------
+// Create
+// ---------------------------------------------------------------------------
+
+func TestStaffService_Create_HeadCoachTakesCharge(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, mockTeamRepo := setupStaffService(t)
+	ctx := context.Background()
+	previousEnded := date(2025, time.June, 30)
+	last := &domain.Tenure{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-0", StaffName: "Indra Sjafri", EndedOn: &previousEnded}
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(last, nil)
+
+	var opened *domain.Tenure
+	mockStaffRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s *domain.Staff, tenure *domain.Tenure) error {
+			if tenure != nil && tenure.StaffID != s.ID {
+				t.Errorf("expected the tenure of %s, got %s", s.ID, tenure.StaffID)
+			}
+			opened = tenure
+			return nil
+		})
+
+	// When
+	id, err := svc.Create(ctx, headCoachInput(date(2025, time.July, 1)))
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if id == "" {
+		t.Fatal("expected non-empty ID, got empty string")
+	}
+	if opened == nil {
+		t.Fatal("expected the head coach to take charge")
+	}
+	if !opened.StartedOn.Equal(date(2025, time.July, 1)) || opened.EndedOn != nil {
+		t.Errorf("expected an open tenure from 2025-07-01, got %v to %v", opened.StartedOn, opened.EndedOn)
+	}
+}
+
+func TestStaffService_Create_SecondHeadCoach(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, mockTeamRepo := setupStaffService(t)
+	ctx := context.Background()
+	current := &domain.Tenure{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-0", StaffName: "Indra Sjafri", StartedOn: date(2024, time.January, 1)}
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(current, nil)
+
+	// When
+	_, err := svc.Create(ctx, headCoachInput(date(2025, time.July, 1)))
+
+	// Then
+	if !errors.Is(err, domain.ErrHeadCoachTaken) {
+		t.Fatalf("expected ErrHeadCoachTaken, got: %v", err)
+	}
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeDuplicate)
+}
+
+func TestStaffService_Create_ExpiredHeadCoachThenNext(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, mockTeamRepo := setupStaffService(t)
+	ctx := context.Background()
+	expired := headCoachInput(date(2020, time.January, 1))
+	contractEnd := date(2022, time.June, 30)
+	expired.ContractEnd = &contractEnd
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil).Times(2)
+
+	var tenures []*domain.Tenure
+	gomock.InOrder(
+		mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(nil, nil),
+		mockStaffRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *domain.Staff, tenure *domain.Tenure) error {
+				tenures = append(tenures, tenure)
+				return nil
+			}),
+		mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").
+			DoAndReturn(func(context.Context, string) (*domain.Tenure, error) {
+				return tenures[0], nil
+			}),
+		mockStaffRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *domain.Staff, tenure *domain.Tenure) error {
+				tenures = append(tenures, tenure)
+				return nil
+			}),
+	)
+
+	// When
+	_, err := svc.Create(ctx, expired)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	_, err = svc.Create(ctx, headCoachInput(date(2022, time.July, 1)))
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if ended := tenures[0].EndedOn; ended == nil || !ended.Equal(date(2022, time.July, 1)) {
+		t.Errorf("expected the expired head coach to hand over on 2022-07-01, got %v", ended)
+	}
+	if tenures[1].EndedOn != nil || !tenures[1].StartedOn.Equal(date(2022, time.July, 1)) {
+		t.Errorf("expected the next head coach in charge from 2022-07-01, got %+v", tenures[1])
+	}
+}
+
+func TestStaffService_Create_BeforePreviousHeadCoachLeft(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, mockTeamRepo := setupStaffService(t)
+	ctx := context.Background()
+	previousEnded := date(2025, time.August, 1)
+	last := &domain.Tenure{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-0", StaffName: "Indra Sjafri", EndedOn: &previousEnded}
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(last, nil)
+
+	// When
+	_, err := svc.Create(ctx, headCoachInput(date(2025, time.July, 1)))
+
+	// Then
+	if !errors.Is(err, domain.ErrHeadCoachTaken) {
+		t.Fatalf("expected ErrHeadCoachTaken, got: %v", err)
+	}
+}
+
+func TestStaffService_Create_BackroomStaff(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, mockTeamRepo := setupStaffService(t)
+	ctx := context.Background()
+	end := date(2026, time.June, 30)
+	input := &domain.Staff{
+		TeamID:        "team-1",
+		Name:          "  Dokter Tim  ",
+		Role:          domain.RolePhysio,
+		ContractStart: date(2025, time.July, 1),
+		ContractEnd:   &end,
+		PhotoURL:      "/uploads/staff-photo/physio.png",
+	}
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+	mockStaffRepo.EXPECT().Create(ctx, gomock.Any(), (*domain.Tenure)(nil)).
+		DoAndReturn(func(_ context.Context, s *domain.Staff, _ *domain.Tenure) error {
+			if s.Name != "Dokter Tim" {
+				t.Errorf("expected the name to be trimmed, got %q", s.Name)
+			}
+			return nil
+		})
+
+	// When
+	_, err := svc.Create(ctx, input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestStaffService_Create_ValidationError(t *testing.T) {
+	end := date(2025, time.January, 1)
+	tests := []struct {
+		name  string
+		input *domain.Staff
+	}{
+		{"missing name", &domain.Staff{TeamID: "team-1", Role: domain.RolePhysio, ContractStart: date(2025, time.July, 1)}},
+		{"unknown role", &domain.Staff{TeamID: "team-1", Name: "Kit Man", Role: "kit_man", ContractStart: date(2025, time.July, 1)}},
+		{"unknown licence", &domain.Staff{TeamID: "team-1", Name: "Coach", Role: domain.RoleAssistantCoach, License: "z", ContractStart: date(2025, time.July, 1)}},
+		{"missing contract start", &domain.Staff{TeamID: "team-1", Name: "Coach", Role: domain.RoleAssistantCoach}},
+		{"contract ends before it starts", &domain.Staff{TeamID: "team-1", Name: "Coach", Role: domain.RoleAssistantCoach, ContractStart: date(2025, time.July, 1), ContractEnd: &end}},
+		{"photo is not a URL", &domain.Staff{TeamID: "team-1", Name: "Coach", Role: domain.RoleAssistantCoach, ContractStart: date(2025, time.July, 1), PhotoURL: "not a url"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			svc, _, mockTeamRepo := setupStaffService(t)
+			ctx := context.Background()
+			mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(&domain.Team{ID: "team-1"}, nil)
+
+			// When
+			_, err := svc.Create(ctx, tt.input)
+
+			// Then
+			assertPlayerErrorCode(t, err, derrors.ErrorCodeBadRequest)
+		})
+	}
+}
+
+func TestStaffService_Create_TeamNotFound(t *testing.T) {
+	// Given
+	svc, _, mockTeamRepo := setupStaffService(t)
+	ctx := context.Background()
+	notFound := derrors.WrapErrorf(domain.ErrTeamNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrTeamNotFound.Error())
+
+	mockTeamRepo.EXPECT().FindByID(ctx, "team-1").Return(nil, notFound)
+
+	// When
+	_, err := svc.Create(ctx, headCoachInput(date(2025, time.July, 1)))
+
+	// Then
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+// ---------------------------------------------------------------------------
+// Update
+// ---------------------------------------------------------------------------
+
+func TestStaffService_Update_HeadCoachMovesToAssistant(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-1"
+	current := &domain.Tenure{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-1", StartedOn: date(2024, time.January, 1)}
+	input := headCoachInput(date(2024, time.January, 1))
+	input.Role = domain.RoleAssistantCoach
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-1").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(current, nil)
+	mockStaffRepo.EXPECT().Update(ctx, existing, current, (*domain.Tenure)(nil)).Return(nil)
+
+	// When
+	err := svc.Update(ctx, "staff-1", input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if current.EndedOn == nil || !current.EndedOn.Equal(today()) {
+		t.Errorf("expected the tenure to end today, got %v", current.EndedOn)
+	}
+}
+
+func TestStaffService_Update_AssistantPromoted(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-2"
+	existing.Role = domain.RoleAssistantCoach
+	previousEnded := today()
+	last := &domain.Tenure{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-1", EndedOn: &previousEnded}
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-2").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(last, nil)
+
+	var opened *domain.Tenure
+	mockStaffRepo.EXPECT().Update(ctx, existing, (*domain.Tenure)(nil), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *domain.Staff, _, tenure *domain.Tenure) error {
+			opened = tenure
+			return nil
+		})
+
+	// When
+	err := svc.Update(ctx, "staff-2", headCoachInput(date(2024, time.January, 1)))
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if opened == nil || opened.StaffID != "staff-2" || !opened.StartedOn.Equal(today()) {
+		t.Fatalf("expected staff-2 to take charge today, got %+v", opened)
+	}
+}
+
+func TestStaffService_Update_PromotedWhileHeadCoachInCharge(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-2"
+	existing.Role = domain.RoleAssistantCoach
+	current := &domain.Tenure{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-1", StaffName: "Shin Tae-yong"}
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-2").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(current, nil)
+
+	// When
+	err := svc.Update(ctx, "staff-2", headCoachInput(date(2024, time.January, 1)))
+
+	// Then
+	if !errors.Is(err, domain.ErrHeadCoachTaken) {
+		t.Fatalf("expected ErrHeadCoachTaken, got: %v", err)
+	}
+}
+
+func TestStaffService_Update_SameRoleKeepsTenure(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-1"
+	input := headCoachInput(date(2024, time.January, 1))
+	input.License = domain.LicenseA
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-1").Return(existing, nil)
+	mockStaffRepo.EXPECT().Update(ctx, existing, (*domain.Tenure)(nil), (*domain.Tenure)(nil)).Return(nil)
+
+	// When
+	err := svc.Update(ctx, "staff-1", input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if existing.License != domain.LicenseA {
+		t.Errorf("expected licence a, got %q", existing.License)
+	}
+}
+
+func TestStaffService_Update_ContractStartMovesTenure(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-1"
+	previousEnded := date(2023, time.December, 1)
+	tenures := []domain.Tenure{
+		{ID: "tenure-2", TeamID: "team-1", StaffID: "staff-1", StartedOn: date(2024, time.January, 1)},
+		{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-0", StartedOn: date(2022, time.January, 1), EndedOn: &previousEnded},
+	}
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-1").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindTenures(ctx, "team-1").Return(tenures, nil)
+
+	var changed *domain.Tenure
+	mockStaffRepo.EXPECT().Update(ctx, existing, gomock.Any(), (*domain.Tenure)(nil)).
+		DoAndReturn(func(_ context.Context, _ *domain.Staff, tenure, _ *domain.Tenure) error {
+			changed = tenure
+			return nil
+		})
+
+	// When
+	err := svc.Update(ctx, "staff-1", headCoachInput(date(2023, time.December, 15)))
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if changed == nil || changed.ID != "tenure-2" || !changed.StartedOn.Equal(date(2023, time.December, 15)) || changed.EndedOn != nil {
+		t.Fatalf("expected tenure-2 to start on 2023-12-15, got %+v", changed)
+	}
+}
+
+func TestStaffService_Update_ContractStartBeforePreviousHeadCoachLeft(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-1"
+	previousEnded := date(2023, time.December, 1)
+	tenures := []domain.Tenure{
+		{ID: "tenure-2", TeamID: "team-1", StaffID: "staff-1", StartedOn: date(2024, time.January, 1)},
+		{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-0", StaffName: "Indra Sjafri", StartedOn: date(2022, time.January, 1), EndedOn: &previousEnded},
+	}
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-1").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindTenures(ctx, "team-1").Return(tenures, nil)
+
+	// When
+	err := svc.Update(ctx, "staff-1", headCoachInput(date(2023, time.November, 1)))
+
+	// Then
+	if !errors.Is(err, domain.ErrHeadCoachTaken) {
+		t.Fatalf("expected ErrHeadCoachTaken, got: %v", err)
+	}
+}
+
+func TestStaffService_Update_ExpiredContractEndsTenure(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-1"
+	tenures := []domain.Tenure{{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-1", StartedOn: date(2024, time.January, 1)}}
+	input := headCoachInput(date(2024, time.January, 1))
+	contractEnd := date(2025, time.June, 30)
+	input.ContractEnd = &contractEnd
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-1").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindTenures(ctx, "team-1").Return(tenures, nil)
+
+	var changed *domain.Tenure
+	mockStaffRepo.EXPECT().Update(ctx, existing, gomock.Any(), (*domain.Tenure)(nil)).
+		DoAndReturn(func(_ context.Context, _ *domain.Staff, tenure, _ *domain.Tenure) error {
+			changed = tenure
+			return nil
+		})
+
+	// When
+	err := svc.Update(ctx, "staff-1", input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if changed == nil || changed.EndedOn == nil || !changed.EndedOn.Equal(date(2025, time.July, 1)) {
+		t.Fatalf("expected the tenure to end on 2025-07-01, got %+v", changed)
+	}
+}
+
+func TestStaffService_Update_RunningContractKeepsTenureOpen(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-1"
+	tenures := []domain.Tenure{{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-1", StartedOn: date(2024, time.January, 1)}}
+	input := headCoachInput(date(2024, time.January, 1))
+	contractEnd := today().AddDate(1, 0, 0)
+	input.ContractEnd = &contractEnd
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-1").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindTenures(ctx, "team-1").Return(tenures, nil)
+	mockStaffRepo.EXPECT().Update(ctx, existing, (*domain.Tenure)(nil), (*domain.Tenure)(nil)).Return(nil)
+
+	// When
+	err := svc.Update(ctx, "staff-1", input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Delete
+// ---------------------------------------------------------------------------
+
+func TestStaffService_Delete_HeadCoachLeaves(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	existing := headCoachInput(date(2024, time.January, 1))
+	existing.ID = "staff-1"
+	current := &domain.Tenure{ID: "tenure-1", TeamID: "team-1", StaffID: "staff-1", StartedOn: date(2024, time.January, 1)}
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "staff-1").Return(existing, nil)
+	mockStaffRepo.EXPECT().FindLatestTenure(ctx, "team-1").Return(current, nil)
+	mockStaffRepo.EXPECT().SoftDelete(ctx, "staff-1", current).Return(nil)
+
+	// When
+	err := svc.Delete(ctx, "staff-1")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if current.EndedOn == nil || !current.EndedOn.Equal(today()) {
+		t.Errorf("expected the tenure to end today, got %v", current.EndedOn)
+	}
+}
+
+func TestStaffService_Delete_NotFound(t *testing.T) {
+	// Given
+	svc, mockStaffRepo, _ := setupStaffService(t)
+	ctx := context.Background()
+	notFound := derrors.WrapErrorf(domain.ErrStaffNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrStaffNotFound.Error())
+
+	mockStaffRepo.EXPECT().FindByID(ctx, "missing").Return(nil, notFound)
+
+	// When
+	err := svc.Delete(ctx, "missing")
+
+	// Then
+	assertPlayerErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
@@ -8,19 +8,27 @@ import (
 )
 
 type TeamService struct {
-	teamRepo domain.TeamRepository
+	teamRepo  domain.TeamRepository
+	venueRepo domain.VenueRepository
 }
 
-func NewTeamService(teamRepo domain.TeamRepository) TeamServicePort {
-	return &TeamService{teamRepo: teamRepo}
+func NewTeamService(teamRepo domain.TeamRepository, venueRepo domain.VenueRepository) TeamServicePort {
+	return &TeamService{
+		teamRepo:  teamRepo,
+		venueRepo: venueRepo,
+	}
 }
 
 func (s *TeamService) Create(ctx context.Context, team *domain.Team) (string, error) {
-	newTeam, err := domain.NewTeam(team.Name, team.LogoURL, team.YearFounded, team.Address, team.City)
+	newTeam, err := domain.NewTeam(team.Name, team.LogoURL, team.YearFounded, team.Address, team.City, team.HomeVenueID)
 	if err != nil {
 		return "", err
 	}
 
+	if err := s.checkHomeVenue(ctx, newTeam); err != nil {
+		return "", err
+	}
+
 	exists, err := s.teamRepo.ExistsByName(ctx, newTeam.Name, "")
 	if err != nil {
 		return "", err
@@ -58,7 +66,11 @@ func (s *TeamService) Update(ctx context.Context, id string, team *domain.Team)
 		return err
 	}
 
-	if err := existing.Update(team.Name, team.LogoURL, team.YearFounded, team.Address, team.City); err != nil {
+	if err := existing.Update(team.Name, team.LogoURL, team.YearFounded, team.Address, team.City, team.HomeVenueID); err != nil {
+		return err
+	}
+
+	if err := s.checkHomeVenue(ctx, existing); err != nil {
 		return err
 	}
 
@@ -89,3 +101,18 @@ func (s *TeamService) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// checkHomeVenue verifies the team's home venue exists and fills in its name.
+func (s *TeamService) checkHomeVenue(ctx context.Context, team *domain.Team) error {
+	if team.HomeVenueID == "" {
+		team.HomeVenueName = ""
+		return nil
+	}
+
+	venue, err := s.venueRepo.FindByID(ctx, team.HomeVenueID)
+	if err != nil {
+		return err
+	}
+	team.HomeVenueName = venue.Name
+	return nil
+}
@@ -15,7 +15,10 @@ func setupTeamService(t *testing.T) (*TeamService, *mockDomain.MockTeamRepositor
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	mockRepo := mockDomain.NewMockTeamRepository(ctrl)
-	svc := &TeamService{teamRepo: mockRepo}
+	svc := &TeamService{
+		teamRepo:  mockRepo,
+		venueRepo: mockDomain.NewMockVenueRepository(ctrl),
+	}
 	return svc, mockRepo
 }
 
@@ -62,6 +65,64 @@ func TestTeamService_Create_Success(t *testing.T) {
 	}
 }
 
+func TestTeamService_Create_WithHomeVenue(t *testing.T) {
+	// Given
+	svc, mockRepo := setupTeamService(t)
+	mockVenueRepo := svc.venueRepo.(*mockDomain.MockVenueRepository)
+	ctx := context.Background()
+	input := &domain.Team{
+		Name:        "Persija Jakarta",
+		YearFounded: 1928,
+		City:        "Jakarta",
+		HomeVenueID: "venue-1",
+	}
+
+	mockVenueRepo.EXPECT().FindByID(ctx, "venue-1").Return(&domain.Venue{ID: "venue-1", Name: "Jakarta International Stadium"}, nil)
+	mockRepo.EXPECT().ExistsByName(ctx, "Persija Jakarta", "").Return(false, nil)
+	mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, team *domain.Team) error {
+		if team.HomeVenueID != "venue-1" {
+			t.Fatalf("expected home venue venue-1, got %q", team.HomeVenueID)
+		}
+		return nil
+	})
+
+	// When
+	_, err := svc.Create(ctx, input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestTeamService_Create_HomeVenueNotFound(t *testing.T) {
+	// Given
+	svc, _ := setupTeamService(t)
+	mockVenueRepo := svc.venueRepo.(*mockDomain.MockVenueRepository)
+	ctx := context.Background()
+	input := &domain.Team{
+		Name:        "Persija Jakarta",
+		YearFounded: 1928,
+		City:        "Jakarta",
+		HomeVenueID: "missing",
+	}
+
+	mockVenueRepo.EXPECT().FindByID(ctx, "missing").
+		Return(nil, derrors.WrapErrorf(domain.ErrVenueNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrVenueNotFound.Error()))
+
+	// When
+	id, err := svc.Create(ctx, input)
+
+	// Then
+	if !errors.Is(err, domain.ErrVenueNotFound) {
+		t.Fatalf("expected ErrVenueNotFound, got: %v", err)
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeNotFound)
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
 func TestTeamService_Create_ValidationError_EmptyName(t *testing.T) {
 	// Given
 	svc, _ := setupTeamService(t)
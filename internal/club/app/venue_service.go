@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+type VenueService struct {
+	venueRepo      domain.VenueRepository
+	matchVenueRepo domain.MatchVenueRepository
+}
+
+func NewVenueService(venueRepo domain.VenueRepository, matchVenueRepo domain.MatchVenueRepository) VenueServicePort {
+	return &VenueService{
+		venueRepo:      venueRepo,
+		matchVenueRepo: matchVenueRepo,
+	}
+}
+
+func (s *VenueService) Create(ctx context.Context, venue *domain.Venue) (string, error) {
+	newVenue, err := domain.NewVenue(venue.Name, venue.City, venue.Address, venue.Capacity, venue.Surface, venue.Latitude, venue.Longitude, venue.Timezone)
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := s.venueRepo.ExistsByName(ctx, newVenue.Name, "")
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", derrors.WrapErrorf(domain.ErrVenueAlreadyExists, derrors.ErrorCodeDuplicate, "venue name %q is already taken", newVenue.Name)
+	}
+
+	if err := s.venueRepo.Create(ctx, newVenue); err != nil {
+		return "", err
+	}
+
+	return newVenue.ID, nil
+}
+
+func (s *VenueService) GetByID(ctx context.Context, id string) (*domain.Venue, error) {
+	return s.venueRepo.FindByID(ctx, id)
+}
+
+func (s *VenueService) GetAll(ctx context.Context) ([]domain.Venue, error) {
+	return s.venueRepo.FindAll(ctx)
+}
+
+func (s *VenueService) Update(ctx context.Context, id string, venue *domain.Venue) error {
+	existing, err := s.venueRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := existing.Update(venue.Name, venue.City, venue.Address, venue.Capacity, venue.Surface, venue.Latitude, venue.Longitude, venue.Timezone); err != nil {
+		return err
+	}
+
+	exists, err := s.venueRepo.ExistsByName(ctx, existing.Name, id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return derrors.WrapErrorf(domain.ErrVenueAlreadyExists, derrors.ErrorCodeDuplicate, "venue name %q is already taken", existing.Name)
+	}
+
+	return s.venueRepo.Update(ctx, existing)
+}
+
+// Delete removes a venue nobody plays at. Venues that were used are merged into another one instead.
+func (s *VenueService) Delete(ctx context.Context, id string) error {
+	if _, err := s.venueRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	inUse, err := s.venueRepo.IsInUse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return derrors.WrapErrorf(domain.ErrVenueInUse, derrors.ErrorCodeBadRequest, "%s, merge it into another venue instead", domain.ErrVenueInUse.Error())
+	}
+
+	return s.venueRepo.SoftDelete(ctx, id)
+}
+
+// Merge folds a duplicate venue, such as "GBK" for "Gelora Bung Karno", into the venue that is kept.
+// Teams and matches at the duplicate move to the kept venue, and the duplicate is deleted. Matches move
+// first, so a merge that fails halfway leaves the duplicate in place to merge again.
+func (s *VenueService) Merge(ctx context.Context, keepID, duplicateID string) error {
+	if keepID == duplicateID {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a venue cannot be merged into itself")
+	}
+
+	if _, err := s.venueRepo.FindByID(ctx, keepID); err != nil {
+		return err
+	}
+	if _, err := s.venueRepo.FindByID(ctx, duplicateID); err != nil {
+		return err
+	}
+
+	if err := s.matchVenueRepo.MoveMatches(ctx, duplicateID, keepID); err != nil {
+		return err
+	}
+	return s.venueRepo.Merge(ctx, keepID, duplicateID)
+}
@@ -0,0 +1,280 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/mock"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"go.uber.org/mock/gomock"
+)
+
+func setupVenueService(t *testing.T) (*VenueService, *mockDomain.MockVenueRepository) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockRepo := mockDomain.NewMockVenueRepository(ctrl)
+	svc := &VenueService{
+		venueRepo:      mockRepo,
+		matchVenueRepo: mockDomain.NewMockMatchVenueRepository(ctrl),
+	}
+	return svc, mockRepo
+}
+
+func venueNotFound() error {
+	return derrors.WrapErrorf(domain.ErrVenueNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrVenueNotFound.Error())
+}
+
+// ---------------------------------------------------------------------------
+// Create
+// ---------------------------------------------------------------------------
+
+func TestVenueService_Create_Success(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+	lat, lng := -6.218335, 106.802216
+	input := &domain.Venue{
+		Name:      "Gelora Bung Karno",
+		City:      "Jakarta",
+		Capacity:  77193,
+		Surface:   domain.SurfaceHybrid,
+		Latitude:  &lat,
+		Longitude: &lng,
+	}
+
+	mockRepo.EXPECT().ExistsByName(ctx, "Gelora Bung Karno", "").Return(false, nil)
+	mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	// When
+	id, err := svc.Create(ctx, input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if id == "" {
+		t.Fatal("expected non-empty ID, got empty string")
+	}
+}
+
+func TestVenueService_Create_ValidationError(t *testing.T) {
+	lat := -6.2
+	tests := []struct {
+		name  string
+		input *domain.Venue
+	}{
+		{"empty name", &domain.Venue{Name: "  "}},
+		{"negative capacity", &domain.Venue{Name: "GBK", Capacity: -1}},
+		{"unknown surface", &domain.Venue{Name: "GBK", Surface: "sand"}},
+		{"latitude without longitude", &domain.Venue{Name: "GBK", Latitude: &lat}},
+		{"unknown time zone", &domain.Venue{Name: "GBK", Timezone: "Asia/Atlantis"}},
+		{"server time zone", &domain.Venue{Name: "GBK", Timezone: "Local"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			svc, _ := setupVenueService(t)
+
+			// When
+			_, err := svc.Create(context.Background(), tt.input)
+
+			// Then
+			assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+		})
+	}
+}
+
+func TestVenueService_Create_DefaultsToJakartaTime(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().ExistsByName(ctx, "Gelora Bung Karno", "").Return(false, nil)
+	mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, venue *domain.Venue) error {
+		if venue.Timezone != domain.DefaultTimezone {
+			t.Fatalf("expected venue in %s, got %q", domain.DefaultTimezone, venue.Timezone)
+		}
+		return nil
+	})
+
+	// When
+	_, err := svc.Create(ctx, &domain.Venue{Name: "Gelora Bung Karno"})
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestVenueService_Create_NameAlreadyExists(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().ExistsByName(ctx, "gelora bung karno", "").Return(true, nil)
+
+	// When
+	_, err := svc.Create(ctx, &domain.Venue{Name: "gelora bung karno"})
+
+	// Then
+	if !errors.Is(err, domain.ErrVenueAlreadyExists) {
+		t.Fatalf("expected ErrVenueAlreadyExists, got: %v", err)
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeDuplicate)
+}
+
+// ---------------------------------------------------------------------------
+// Update
+// ---------------------------------------------------------------------------
+
+func TestVenueService_Update_Success(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+	existing := &domain.Venue{ID: "venue-1", Name: "GBK"}
+
+	mockRepo.EXPECT().FindByID(ctx, "venue-1").Return(existing, nil)
+	mockRepo.EXPECT().ExistsByName(ctx, "Gelora Bung Karno", "venue-1").Return(false, nil)
+	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, venue *domain.Venue) error {
+		if venue.Name != "Gelora Bung Karno" || venue.Capacity != 77193 {
+			t.Fatalf("expected venue to be updated, got %+v", venue)
+		}
+		return nil
+	})
+
+	// When
+	err := svc.Update(ctx, "venue-1", &domain.Venue{Name: "Gelora Bung Karno", Capacity: 77193})
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestVenueService_Update_NotFound(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByID(ctx, "missing").Return(nil, venueNotFound())
+
+	// When
+	err := svc.Update(ctx, "missing", &domain.Venue{Name: "GBK"})
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+// ---------------------------------------------------------------------------
+// Delete
+// ---------------------------------------------------------------------------
+
+func TestVenueService_Delete_Success(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByID(ctx, "venue-1").Return(&domain.Venue{ID: "venue-1"}, nil)
+	mockRepo.EXPECT().IsInUse(ctx, "venue-1").Return(false, nil)
+	mockRepo.EXPECT().SoftDelete(ctx, "venue-1").Return(nil)
+
+	// When
+	err := svc.Delete(ctx, "venue-1")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestVenueService_Delete_InUse(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByID(ctx, "venue-1").Return(&domain.Venue{ID: "venue-1"}, nil)
+	mockRepo.EXPECT().IsInUse(ctx, "venue-1").Return(true, nil)
+
+	// When
+	err := svc.Delete(ctx, "venue-1")
+
+	// Then
+	if !errors.Is(err, domain.ErrVenueInUse) {
+		t.Fatalf("expected ErrVenueInUse, got: %v", err)
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+// ---------------------------------------------------------------------------
+// Merge
+// ---------------------------------------------------------------------------
+
+func TestVenueService_Merge_Success(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByID(ctx, "venue-gbk").Return(&domain.Venue{ID: "venue-gbk", Name: "Gelora Bung Karno"}, nil)
+	mockRepo.EXPECT().FindByID(ctx, "venue-sugbk").Return(&domain.Venue{ID: "venue-sugbk", Name: "SUGBK"}, nil)
+	gomock.InOrder(
+		svc.matchVenueRepo.(*mockDomain.MockMatchVenueRepository).EXPECT().MoveMatches(ctx, "venue-sugbk", "venue-gbk").Return(nil),
+		mockRepo.EXPECT().Merge(ctx, "venue-gbk", "venue-sugbk").Return(nil),
+	)
+
+	// When
+	err := svc.Merge(ctx, "venue-gbk", "venue-sugbk")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestVenueService_Merge_MovingMatchesFailsKeepsDuplicate(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByID(ctx, "venue-gbk").Return(&domain.Venue{ID: "venue-gbk"}, nil)
+	mockRepo.EXPECT().FindByID(ctx, "venue-sugbk").Return(&domain.Venue{ID: "venue-sugbk"}, nil)
+	svc.matchVenueRepo.(*mockDomain.MockMatchVenueRepository).EXPECT().MoveMatches(ctx, "venue-sugbk", "venue-gbk").
+		Return(derrors.NewErrorf(derrors.ErrorCodeInternal, "failed to move matches to venue"))
+	mockRepo.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	// When
+	err := svc.Merge(ctx, "venue-gbk", "venue-sugbk")
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeInternal)
+}
+
+func TestVenueService_Merge_IntoItself(t *testing.T) {
+	// Given
+	svc, _ := setupVenueService(t)
+
+	// When
+	err := svc.Merge(context.Background(), "venue-gbk", "venue-gbk")
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestVenueService_Merge_DuplicateNotFound(t *testing.T) {
+	// Given
+	svc, mockRepo := setupVenueService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByID(ctx, "venue-gbk").Return(&domain.Venue{ID: "venue-gbk"}, nil)
+	mockRepo.EXPECT().FindByID(ctx, "missing").Return(nil, venueNotFound())
+
+	// When
+	err := svc.Merge(ctx, "venue-gbk", "missing")
+
+	// Then
+	if !errors.Is(err, domain.ErrVenueNotFound) {
+		t.Fatalf("expected ErrVenueNotFound, got: %v", err)
+	}
+}
@@ -8,8 +8,24 @@ var (
 	ErrTeamAlreadyExists = errors.New("team name already exists")
 )
 
+// Venue domain errors.
+var (
+	ErrVenueNotFound      = errors.New("venue not found")
+	ErrVenueAlreadyExists = errors.New("venue name already exists")
+	ErrVenueInUse         = errors.New("venue is still used by teams or matches")
+)
+
 // Player domain errors.
 var (
 	ErrPlayerNotFound    = errors.New("player not found")
 	ErrJerseyNumberTaken = errors.New("jersey number already taken in this team")
+	ErrSameTeamTransfer  = errors.New("player is already registered with this team")
+	ErrTransferConflict  = errors.New("player was transferred at the same time, try again")
+	ErrRegistrationRules = errors.New("player cannot join the team under the registration rules of its seasons")
+)
+
+// Staff domain errors.
+var (
+	ErrStaffNotFound  = errors.New("staff member not found")
+	ErrHeadCoachTaken = errors.New("team already has a head coach")
 )
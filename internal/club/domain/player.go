@@ -101,9 +101,11 @@ type Player struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    *time.Time
+
+	Profile
 }
 
-func NewPlayer(teamID, name string, height, weight float64, position Position, jerseyNumber int) (*Player, error) {
+func NewPlayer(teamID, name string, height, weight float64, position Position, jerseyNumber int, profile Profile) (*Player, error) {
 	name = strings.TrimSpace(name)
 
 	if teamID == "" {
@@ -128,6 +130,10 @@ func NewPlayer(teamID, name string, height, weight float64, position Position, j
 	if jerseyNumber <= 0 || jerseyNumber > 99 {
 		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "jersey number must be between 1 and 99")
 	}
+	profile, err := profile.normalize(position, DefaultNationality)
+	if err != nil {
+		return nil, err
+	}
 
 	now := time.Now()
 	return &Player{
@@ -138,12 +144,14 @@ func NewPlayer(teamID, name string, height, weight float64, position Position, j
 		Weight:       weight,
 		Position:     position,
 		JerseyNumber: jerseyNumber,
+		Profile:      profile,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}, nil
 }
 
-func (p *Player) Update(name string, height, weight float64, position Position, jerseyNumber int) error {
+// Update replaces the details of the player. An empty nationality keeps the current one.
+func (p *Player) Update(name string, height, weight float64, position Position, jerseyNumber int, profile Profile) error {
 	name = strings.TrimSpace(name)
 
 	if name == "" {
@@ -165,12 +173,17 @@ func (p *Player) Update(name string, height, weight float64, position Position,
 	if jerseyNumber <= 0 || jerseyNumber > 99 {
 		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "jersey number must be between 1 and 99")
 	}
+	profile, err := profile.normalize(position, p.Nationality)
+	if err != nil {
+		return err
+	}
 
 	p.Name = name
 	p.Height = height
 	p.Weight = weight
 	p.Position = position
 	p.JerseyNumber = jerseyNumber
+	p.Profile = profile
 	p.UpdatedAt = time.Now()
 	return nil
 }
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+const (
+	// DefaultNationality is given to players registered without one.
+	DefaultNationality = "ID"
+
+	maxPhotoURLLength     = 500
+	maxSecondaryPositions = 3
+	maxPlayerAge          = 60
+)
+
+var nationalityCode = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Foot is the foot a player prefers to play with.
+type Foot string
+
+const (
+	FootLeft  Foot = "left"
+	FootRight Foot = "right"
+	FootBoth  Foot = "both"
+)
+
+func ParseFoot(s string) (Foot, error) {
+	switch f := Foot(strings.ToLower(strings.TrimSpace(s))); f {
+	case FootLeft, FootRight, FootBoth:
+		return f, nil
+	}
+	return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown preferred foot %q, use left, right or both", s)
+}
+
+// Profile holds the personal details of a player. Everything but the nationality is optional.
+type Profile struct {
+	DateOfBirth        *time.Time
+	Nationality        string // ISO 3166-1 alpha-2 code
+	PhotoURL           string // Usually a player-photo upload
+	PreferredFoot      Foot   // Empty when unknown
+	SecondaryPositions []Position
+}
+
+// Age returns how old the player is on the given day, and false when their date of birth is unknown.
+func (p Profile) Age(on time.Time) (int, bool) {
+	if p.DateOfBirth == nil {
+		return 0, false
+	}
+	dob := *p.DateOfBirth
+	age := on.Year() - dob.Year()
+	if on.Month() < dob.Month() || (on.Month() == dob.Month() && on.Day() < dob.Day()) {
+		age--
+	}
+	return age, true
+}
+
+// IsForeign reports whether the player counts against the foreign quota of a league based in home.
+func (p Profile) IsForeign(home string) bool {
+	return p.Nationality != home
+}
+
+// normalize validates the profile of a player whose main position is given. An empty nationality
+// falls back to the one given.
+func (p Profile) normalize(position Position, nationality string) (Profile, error) {
+	if p.DateOfBirth != nil {
+		if p.DateOfBirth.IsZero() {
+			return Profile{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "date of birth must be a date (YYYY-MM-DD)")
+		}
+		dob := day(*p.DateOfBirth)
+		today := day(time.Now())
+		if dob.After(today) {
+			return Profile{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "date of birth must not be in the future")
+		}
+		if dob.Before(today.AddDate(-maxPlayerAge, 0, 0)) {
+			return Profile{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "players must not be older than %d", maxPlayerAge)
+		}
+		p.DateOfBirth = &dob
+	}
+
+	p.Nationality = strings.ToUpper(strings.TrimSpace(p.Nationality))
+	if p.Nationality == "" {
+		p.Nationality = nationality
+	} else if !nationalityCode.MatchString(p.Nationality) {
+		return Profile{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "nationality must be a two-letter ISO 3166-1 country code")
+	}
+
+	photoURL, err := normalizePhotoURL(p.PhotoURL)
+	if err != nil {
+		return Profile{}, err
+	}
+	p.PhotoURL = photoURL
+
+	if p.PreferredFoot != "" {
+		foot, err := ParseFoot(string(p.PreferredFoot))
+		if err != nil {
+			return Profile{}, err
+		}
+		p.PreferredFoot = foot
+	}
+
+	if len(p.SecondaryPositions) > maxSecondaryPositions {
+		return Profile{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a player can have at most %d secondary positions", maxSecondaryPositions)
+	}
+	seen := map[Position]bool{position: true}
+	for _, s := range p.SecondaryPositions {
+		if !s.IsValid() {
+			validPositions := []string{"GK", "CB", "LB", "RB", "LWB", "RWB", "CDM", "CM", "CAM", "LM", "RM", "LW", "RW", "CF", "ST", "SS"}
+			return Profile{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "invalid secondary position: must be one of [%s]", strings.Join(validPositions, ", "))
+		}
+		if seen[s] {
+			return Profile{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "secondary position %s is repeated or is the main position", s)
+		}
+		seen[s] = true
+	}
+
+	return p, nil
+}
+
+// normalizePhotoURL validates an optional photo URL, usually that of an upload.
+func normalizePhotoURL(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) > maxPhotoURLLength {
+		return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "photo URL must not exceed %d characters", maxPhotoURLLength)
+	}
+	if s != "" {
+		if _, err := url.ParseRequestURI(s); err != nil {
+			return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "photo URL must be an absolute URL or path")
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+// RegistrationRule names a squad registration rule of a season.
+type RegistrationRule string
+
+const (
+	RuleWindow       RegistrationRule = "window"
+	RuleSquadSize    RegistrationRule = "squad_size"
+	RuleForeignQuota RegistrationRule = "foreign_quota"
+	RuleAge          RegistrationRule = "age" // Cannot be overridden
+)
+
+// RegistrationAction tells how a player joined the team whose rules were overridden.
+type RegistrationAction string
+
+const (
+	ActionRegistration RegistrationAction = "registration"
+	ActionTransfer     RegistrationAction = "transfer"
+)
+
+// RegistrationWindow is a period in which teams may register and sign players, inclusive of both ends.
+type RegistrationWindow struct {
+	OpensOn  time.Time
+	ClosesOn time.Time
+}
+
+// SeasonRules are the squad registration rules of a season a team plays in.
+type SeasonRules struct {
+	SeasonID          string
+	SeasonName        string
+	Windows           []RegistrationWindow // Empty to allow registrations at any time
+	MaxSquadSize      *int                 // Nil for no limit
+	MaxForeignPlayers *int                 // Nil for no quota
+	HomeNationality   string
+	MaxAge            *int       // Nil unless the competition is for an age group
+	AgeCutoffDate     *time.Time // The day on which players must be no older than MaxAge
+}
+
+// Squad counts the players a team has registered, and how many of them are foreign.
+type Squad struct {
+	Size    int
+	Foreign int
+}
+
+// Violation is a registration rule of a season that a player joining a team breaks.
+type Violation struct {
+	SeasonID string
+	Rule     RegistrationRule
+	Message  string
+}
+
+func (v Violation) Detail() derrors.Detail {
+	return derrors.Detail{Field: string(v.Rule), Message: v.Message}
+}
+
+// Overridable reports whether an admin may let a player through in spite of the violation. Age limits
+// are set by the federation to protect young players, so no one may waive them.
+func (v Violation) Overridable() bool {
+	return v.Rule != RuleAge
+}
+
+// Check returns the rules the player breaks by joining the team on the given day,
+// with squad being the team as it is without them.
+func (r SeasonRules) Check(p *Player, on time.Time, squad Squad) []Violation {
+	var violations []Violation
+
+	if len(r.Windows) > 0 && !r.windowOpen(day(on)) {
+		violations = append(violations, Violation{
+			SeasonID: r.SeasonID,
+			Rule:     RuleWindow,
+			Message:  fmt.Sprintf("registrations for %s are closed on %s", r.SeasonName, on.Format("2006-01-02")),
+		})
+	}
+	if r.MaxSquadSize != nil && squad.Size >= *r.MaxSquadSize {
+		violations = append(violations, Violation{
+			SeasonID: r.SeasonID,
+			Rule:     RuleSquadSize,
+			Message:  fmt.Sprintf("squad already has the %d players allowed in %s", *r.MaxSquadSize, r.SeasonName),
+		})
+	}
+	if r.MaxForeignPlayers != nil && p.IsForeign(r.HomeNationality) && squad.Foreign >= *r.MaxForeignPlayers {
+		violations = append(violations, Violation{
+			SeasonID: r.SeasonID,
+			Rule:     RuleForeignQuota,
+			Message:  fmt.Sprintf("squad already has the %d foreign players allowed in %s", *r.MaxForeignPlayers, r.SeasonName),
+		})
+	}
+	if r.MaxAge != nil && r.AgeCutoffDate != nil {
+		if age, known := p.Age(*r.AgeCutoffDate); !known {
+			violations = append(violations, Violation{
+				SeasonID: r.SeasonID,
+				Rule:     RuleAge,
+				Message:  fmt.Sprintf("date of birth is required to play in %s", r.SeasonName),
+			})
+		} else if age > *r.MaxAge {
+			violations = append(violations, Violation{
+				SeasonID: r.SeasonID,
+				Rule:     RuleAge,
+				Message:  fmt.Sprintf("player is %d on %s, older than the maximum age of %d in %s", age, r.AgeCutoffDate.Format("2006-01-02"), *r.MaxAge, r.SeasonName),
+			})
+		}
+	}
+
+	return violations
+}
+
+func (r SeasonRules) windowOpen(on time.Time) bool {
+	for _, w := range r.Windows {
+		if !on.Before(day(w.OpensOn)) && !on.After(day(w.ClosesOn)) {
+			return true
+		}
+	}
+	return false
+}
+
+// RegistrationOverride records a registration rule an admin let a player break.
+type RegistrationOverride struct {
+	ID           string
+	PlayerID     string
+	TeamID       string
+	SeasonID     string
+	Action       RegistrationAction
+	Rule         RegistrationRule
+	Message      string
+	OverriddenBy string
+	CreatedAt    time.Time
+}
+
+// NewRegistrationOverrides records each violation the player was let through with by the admin.
+func NewRegistrationOverrides(p *Player, action RegistrationAction, violations []Violation, overriddenBy string) []RegistrationOverride {
+	now := time.Now()
+	overrides := make([]RegistrationOverride, len(violations))
+	for i, v := range violations {
+		overrides[i] = RegistrationOverride{
+			ID:           ulid.GenerateID(),
+			PlayerID:     p.ID,
+			TeamID:       p.TeamID,
+			SeasonID:     v.SeasonID,
+			Action:       action,
+			Rule:         v.Rule,
+			Message:      v.Message,
+			OverriddenBy: overriddenBy,
+			CreatedAt:    now,
+		}
+	}
+	return overrides
+}
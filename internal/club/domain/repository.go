@@ -1,6 +1,11 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
 
 // TeamRepository defines the port for team persistence.
 type TeamRepository interface {
@@ -12,12 +17,109 @@ type TeamRepository interface {
 	ExistsByName(ctx context.Context, name string, excludeID string) (bool, error)
 }
 
+// VenueRepository defines the port for venue persistence.
+type VenueRepository interface {
+	Create(ctx context.Context, venue *Venue) error
+	FindByID(ctx context.Context, id string) (*Venue, error)
+	FindAll(ctx context.Context) ([]Venue, error)
+	Update(ctx context.Context, venue *Venue) error
+	SoftDelete(ctx context.Context, id string) error
+	// ExistsByName compares names ignoring case.
+	ExistsByName(ctx context.Context, name string, excludeID string) (bool, error)
+	// IsInUse reports whether a team plays at the venue or a match was scheduled there.
+	IsInUse(ctx context.Context, id string) (bool, error)
+	// Merge moves every team from the duplicate venue to the kept one and deletes the duplicate,
+	// in one transaction.
+	Merge(ctx context.Context, keepID, duplicateID string) error
+}
+
+// MatchVenueRepository is the port to the match context for the matches played at a venue.
+type MatchVenueRepository interface {
+	// MoveMatches moves every match and reschedule from one venue to another, in one transaction.
+	MoveMatches(ctx context.Context, fromID, toID string) error
+}
+
+// PlayerFilter narrows down player listings. Empty fields are ignored.
+type PlayerFilter struct {
+	TeamID        string
+	Nationality   string
+	Position      Position // Players who play there, as their main or a secondary position
+	PreferredFoot Foot
+	MinAge        *int
+	MaxAge        *int
+}
+
+func (f PlayerFilter) Validate() error {
+	if (f.MinAge != nil && *f.MinAge < 0) || (f.MaxAge != nil && *f.MaxAge < 0) {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "ages must not be negative")
+	}
+	if f.MinAge != nil && f.MaxAge != nil && *f.MinAge > *f.MaxAge {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "min age must not be greater than max age")
+	}
+	return nil
+}
+
+// BornBetween turns the age range of the filter into the dates of birth it covers on the given day:
+// born after the first and on or before the second. Players without a date of birth never match an age range.
+func (f PlayerFilter) BornBetween(on time.Time) (after, onOrBefore *time.Time) {
+	on = day(on)
+	if f.MaxAge != nil {
+		t := on.AddDate(-*f.MaxAge-1, 0, 0)
+		after = &t
+	}
+	if f.MinAge != nil {
+		t := on.AddDate(-*f.MinAge, 0, 0)
+		onOrBefore = &t
+	}
+	return after, onOrBefore
+}
+
 // PlayerRepository defines the port for player persistence.
 type PlayerRepository interface {
-	Create(ctx context.Context, player *Player) error
+	// Create saves the player with the first spell at their team and the registration rules
+	// overridden to register them, in one transaction.
+	Create(ctx context.Context, player *Player, spell *Spell, overrides []RegistrationOverride) error
 	FindByID(ctx context.Context, id string) (*Player, error)
-	FindByTeamID(ctx context.Context, teamID string) ([]Player, error)
+	// FindAll returns the players matching the filter, by team and jersey number.
+	FindAll(ctx context.Context, filter PlayerFilter) ([]Player, error)
 	Update(ctx context.Context, player *Player) error
 	SoftDelete(ctx context.Context, id string) error
 	IsJerseyNumberTaken(ctx context.Context, teamID string, jerseyNumber int, excludePlayerID string) (bool, error)
+	// FindCurrentSpell returns the spell of the player at the team they are registered with.
+	FindCurrentSpell(ctx context.Context, playerID string) (*Spell, error)
+	// FindSpells returns every spell of the player, oldest first.
+	FindSpells(ctx context.Context, playerID string) ([]Spell, error)
+	// Transfer closes the current spell, opens the new one, saves the player and the registration
+	// rules overridden to sign them, in one transaction.
+	Transfer(ctx context.Context, player *Player, closed, opened *Spell, overrides []RegistrationOverride) error
+}
+
+// StaffRepository defines the port for the persistence of team staff and head coach tenures.
+type StaffRepository interface {
+	// Create saves the member of staff with the head coach tenure they start, if any, in one transaction.
+	Create(ctx context.Context, staff *Staff, opened *Tenure) error
+	FindByID(ctx context.Context, id string) (*Staff, error)
+	// FindByTeamID returns the staff of the team, by role and name.
+	FindByTeamID(ctx context.Context, teamID string) ([]Staff, error)
+	// Update saves the member of staff with the head coach tenure their new role or contract changes and
+	// the one it opens, either of which may be nil, in one transaction.
+	Update(ctx context.Context, staff *Staff, changed, opened *Tenure) error
+	// SoftDelete removes the member of staff and closes their head coach tenure, if any, in one transaction.
+	SoftDelete(ctx context.Context, id string, closed *Tenure) error
+	// FindLatestTenure returns the tenure of the current head coach of the team, or of the last one
+	// when it has none, and nil when it never had one.
+	FindLatestTenure(ctx context.Context, teamID string) (*Tenure, error)
+	// FindTenures returns every head coach tenure of the team, newest first.
+	FindTenures(ctx context.Context, teamID string) ([]Tenure, error)
+}
+
+// RegistrationRepository defines the port for the squad registration rules teams play under.
+type RegistrationRepository interface {
+	// FindSeasonRules returns the rules of the seasons the team is registered in that are under way
+	// on the given day, counting the registration windows that open before a season starts.
+	FindSeasonRules(ctx context.Context, teamID string, on time.Time) ([]SeasonRules, error)
+	// CountSquad counts the players registered with the team, and those not of the home nationality.
+	CountSquad(ctx context.Context, teamID, homeNationality string) (Squad, error)
+	// FindOverrides returns the registration rules overridden for the team, newest first.
+	FindOverrides(ctx context.Context, teamID string) ([]RegistrationOverride, error)
 }
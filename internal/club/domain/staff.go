@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+const (
+	maxStaffNameLength = 255
+)
+
+// StaffRole is the job of a member of a team's coaching and backroom staff.
+type StaffRole string
+
+const (
+	RoleHeadCoach       StaffRole = "head_coach"
+	RoleAssistantCoach  StaffRole = "assistant_coach"
+	RoleGoalkeeperCoach StaffRole = "goalkeeper_coach"
+	RolePhysio          StaffRole = "physio"
+	RoleTeamManager     StaffRole = "team_manager"
+)
+
+func ParseStaffRole(s string) (StaffRole, error) {
+	switch r := StaffRole(strings.ToLower(strings.TrimSpace(s))); r {
+	case RoleHeadCoach, RoleAssistantCoach, RoleGoalkeeperCoach, RolePhysio, RoleTeamManager:
+		return r, nil
+	}
+	return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown staff role %q, use head_coach, assistant_coach, goalkeeper_coach, physio or team_manager", s)
+}
+
+// License is the AFC coaching licence a member of staff holds.
+type License string
+
+const (
+	LicensePro License = "pro"
+	LicenseA   License = "a"
+	LicenseB   License = "b"
+	LicenseC   License = "c"
+	LicenseD   License = "d"
+)
+
+func ParseLicense(s string) (License, error) {
+	switch l := License(strings.ToLower(strings.TrimSpace(s))); l {
+	case LicensePro, LicenseA, LicenseB, LicenseC, LicenseD:
+		return l, nil
+	}
+	return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown licence %q, use pro, a, b, c or d", s)
+}
+
+// Staff is a member of the coaching or backroom staff of a team.
+type Staff struct {
+	ID            string
+	TeamID        string
+	Name          string
+	Role          StaffRole
+	License       License // Empty when they hold no coaching licence
+	ContractStart time.Time
+	ContractEnd   *time.Time // Nil for an open-ended contract
+	PhotoURL      string     // Usually a staff-photo upload
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     *time.Time
+}
+
+func NewStaff(teamID, name string, role StaffRole, license License, contractStart time.Time, contractEnd *time.Time, photoURL string) (*Staff, error) {
+	teamID = strings.TrimSpace(teamID)
+	if teamID == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "team ID is required")
+	}
+
+	s := &Staff{TeamID: teamID}
+	if err := s.set(name, role, license, contractStart, contractEnd, photoURL); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	s.ID = ulid.GenerateID()
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	return s, nil
+}
+
+func (s *Staff) Update(name string, role StaffRole, license License, contractStart time.Time, contractEnd *time.Time, photoURL string) error {
+	if err := s.set(name, role, license, contractStart, contractEnd, photoURL); err != nil {
+		return err
+	}
+
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+func (s *Staff) set(name string, role StaffRole, license License, contractStart time.Time, contractEnd *time.Time, photoURL string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "staff name is required")
+	}
+	if len(name) > maxStaffNameLength {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "staff name must not exceed %d characters", maxStaffNameLength)
+	}
+
+	role, err := ParseStaffRole(string(role))
+	if err != nil {
+		return err
+	}
+	if license != "" {
+		if license, err = ParseLicense(string(license)); err != nil {
+			return err
+		}
+	}
+
+	if contractStart.IsZero() {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "contract start date is required (YYYY-MM-DD)")
+	}
+	contractStart = day(contractStart)
+	if contractEnd != nil {
+		end := day(*contractEnd)
+		if !end.After(contractStart) {
+			return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "contract end date must be after its start date")
+		}
+		contractEnd = &end
+	}
+
+	photoURL, err = normalizePhotoURL(photoURL)
+	if err != nil {
+		return err
+	}
+
+	s.Name = name
+	s.Role = role
+	s.License = license
+	s.ContractStart = contractStart
+	s.ContractEnd = contractEnd
+	s.PhotoURL = photoURL
+	return nil
+}
+
+// Tenure is a period a member of staff was the head coach of a team, from the day they took charge
+// until the day they handed over. Results are attributed to the head coach whose tenure covers the match date.
+type Tenure struct {
+	ID        string
+	TeamID    string
+	StaffID   string
+	StaffName string // Populated on read
+	StartedOn time.Time
+	EndedOn   *time.Time // Nil while they are still in charge
+	CreatedAt time.Time
+}
+
+// NewTenure puts the member of staff in charge of their team from the given day. A team has one head
+// coach at a time, so the tenure may not start before the last one ended. A contract that has already
+// run out hands over the day after it ended.
+func NewTenure(s *Staff, on time.Time, last *Tenure) (*Tenure, error) {
+	on = day(on)
+	if last != nil && last.EndedOn == nil {
+		return nil, derrors.WrapErrorf(ErrHeadCoachTaken, derrors.ErrorCodeDuplicate, "%s, %s is in charge", ErrHeadCoachTaken.Error(), last.StaffName)
+	}
+	if last != nil && last.EndedOn.After(on) {
+		return nil, derrors.WrapErrorf(ErrHeadCoachTaken, derrors.ErrorCodeDuplicate, "%s, %s was in charge until %s", ErrHeadCoachTaken.Error(), last.StaffName, last.EndedOn.Format("2006-01-02"))
+	}
+
+	t := &Tenure{
+		ID:        ulid.GenerateID(),
+		TeamID:    s.TeamID,
+		StaffID:   s.ID,
+		StartedOn: on,
+		CreatedAt: time.Now(),
+	}
+	t.endWithContract(s)
+	return t, nil
+}
+
+// FollowContract keeps the tenure of the head coach in line with their contract, which started on
+// previousStart before it was changed. A tenure that began with the old contract moves to the new start,
+// though not before the previous head coach handed over, and a contract that has run out hands over the
+// day after it ended. It reports whether the tenure changed.
+func (t *Tenure) FollowContract(s *Staff, previousStart time.Time, previous *Tenure) (bool, error) {
+	changed := false
+	if t.StartedOn.Equal(day(previousStart)) && !t.StartedOn.Equal(s.ContractStart) {
+		if previous != nil && previous.EndedOn != nil && previous.EndedOn.After(s.ContractStart) {
+			return false, derrors.WrapErrorf(ErrHeadCoachTaken, derrors.ErrorCodeDuplicate, "%s, %s was in charge until %s", ErrHeadCoachTaken.Error(), previous.StaffName, previous.EndedOn.Format("2006-01-02"))
+		}
+		t.StartedOn = s.ContractStart
+		changed = true
+	}
+	if t.endWithContract(s) {
+		changed = true
+	}
+	return changed, nil
+}
+
+// endWithContract closes the tenure the day after the head coach's contract ended, if that day has come.
+// It reports whether it did.
+func (t *Tenure) endWithContract(s *Staff) bool {
+	if s.ContractEnd == nil {
+		return false
+	}
+	handover := s.ContractEnd.AddDate(0, 0, 1)
+	if handover.After(day(time.Now())) {
+		return false
+	}
+	t.End(handover)
+	return true
+}
+
+// End closes the tenure on the given day, or on the day it started if that is still to come.
+func (t *Tenure) End(on time.Time) {
+	on = day(on)
+	if on.Before(t.StartedOn) {
+		on = t.StartedOn
+	}
+	t.EndedOn = &on
+}
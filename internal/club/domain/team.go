@@ -14,22 +14,25 @@ const (
 )
 
 type Team struct {
-	ID          string
-	Name        string
-	LogoURL     string
-	YearFounded int
-	Address     string
-	City        string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   *time.Time
+	ID            string
+	Name          string
+	LogoURL       string
+	YearFounded   int
+	Address       string
+	City          string
+	HomeVenueID   string // Empty when the team has no home venue
+	HomeVenueName string // Populated on read
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     *time.Time
 }
 
-func NewTeam(name, logoURL string, yearFounded int, address, city string) (*Team, error) {
+func NewTeam(name, logoURL string, yearFounded int, address, city, homeVenueID string) (*Team, error) {
 	name = strings.TrimSpace(name)
 	city = strings.TrimSpace(city)
 	logoURL = strings.TrimSpace(logoURL)
 	address = strings.TrimSpace(address)
+	homeVenueID = strings.TrimSpace(homeVenueID)
 
 	if name == "" {
 		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "team name is required")
@@ -58,16 +61,18 @@ func NewTeam(name, logoURL string, yearFounded int, address, city string) (*Team
 		YearFounded: yearFounded,
 		Address:     address,
 		City:        city,
+		HomeVenueID: homeVenueID,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}, nil
 }
 
-func (t *Team) Update(name, logoURL string, yearFounded int, address, city string) error {
+func (t *Team) Update(name, logoURL string, yearFounded int, address, city, homeVenueID string) error {
 	name = strings.TrimSpace(name)
 	city = strings.TrimSpace(city)
 	logoURL = strings.TrimSpace(logoURL)
 	address = strings.TrimSpace(address)
+	homeVenueID = strings.TrimSpace(homeVenueID)
 
 	if name == "" {
 		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "team name is required")
@@ -93,6 +98,7 @@ func (t *Team) Update(name, logoURL string, yearFounded int, address, city strin
 	t.YearFounded = yearFounded
 	t.Address = address
 	t.City = city
+	t.HomeVenueID = homeVenueID
 	t.UpdatedAt = time.Now()
 	return nil
 }
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+// FeeType tells on what terms a player moved to a team.
+type FeeType string
+
+const (
+	FeePermanent FeeType = "permanent"
+	FeeLoan      FeeType = "loan"
+	FeeFree      FeeType = "free"
+)
+
+func ParseFeeType(s string) (FeeType, error) {
+	switch t := FeeType(strings.ToLower(strings.TrimSpace(s))); t {
+	case FeePermanent, FeeLoan, FeeFree:
+		return t, nil
+	}
+	return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown fee type %q, use permanent, loan or free", s)
+}
+
+// Spell is a period a player was registered with a team, from the day they joined until the day they left.
+type Spell struct {
+	ID         string
+	PlayerID   string
+	TeamID     string
+	TeamName   string // Populated on read
+	JoinedOn   time.Time
+	LeftOn     *time.Time // Nil while the player is still with the team
+	FeeType    FeeType    // How the player joined, empty when they were registered with the team directly
+	LoanEndsOn *time.Time // When the loan is agreed to end, loans only
+	CreatedAt  time.Time
+}
+
+// Transfer is a request to move a player to another team.
+type Transfer struct {
+	ToTeamID     string
+	EffectiveOn  time.Time
+	FeeType      FeeType
+	LoanEndsOn   *time.Time
+	JerseyNumber int // Number at the new team, 0 to keep the current one
+}
+
+// NewSpell opens the first spell of a player who was just registered with their team.
+func NewSpell(p *Player) *Spell {
+	return &Spell{
+		ID:        ulid.GenerateID(),
+		PlayerID:  p.ID,
+		TeamID:    p.TeamID,
+		JoinedOn:  day(p.CreatedAt),
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// Transfer moves the player to another team from the effective date. It closes the current spell
+// and returns the spell at the new team. Transfers cannot be dated in the future, nor before the
+// player joined their current team.
+func (p *Player) Transfer(current *Spell, t Transfer) (*Spell, error) {
+	t.ToTeamID = strings.TrimSpace(t.ToTeamID)
+	if t.ToTeamID == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "destination team ID is required")
+	}
+	if t.ToTeamID == p.TeamID {
+		return nil, derrors.WrapErrorf(ErrSameTeamTransfer, derrors.ErrorCodeBadRequest, "%s", ErrSameTeamTransfer.Error())
+	}
+	feeType, err := ParseFeeType(string(t.FeeType))
+	if err != nil {
+		return nil, err
+	}
+	if t.EffectiveOn.IsZero() {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "effective date is required")
+	}
+
+	effectiveOn := day(t.EffectiveOn)
+	if effectiveOn.After(day(time.Now())) {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "effective date must not be in the future")
+	}
+	if effectiveOn.Before(current.JoinedOn) {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "effective date must not be before the player joined their current team on %s", current.JoinedOn.Format("2006-01-02"))
+	}
+
+	var loanEndsOn *time.Time
+	if t.LoanEndsOn != nil {
+		if feeType != FeeLoan {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "loan end date is only allowed for loans")
+		}
+		end := day(*t.LoanEndsOn)
+		if !end.After(effectiveOn) {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "loan end date must be after the effective date")
+		}
+		loanEndsOn = &end
+	}
+
+	jerseyNumber := t.JerseyNumber
+	if jerseyNumber == 0 {
+		jerseyNumber = p.JerseyNumber
+	}
+	if jerseyNumber < 0 || jerseyNumber > 99 {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "jersey number must be between 1 and 99")
+	}
+
+	now := time.Now()
+	current.LeftOn = &effectiveOn
+	p.TeamID = t.ToTeamID
+	p.JerseyNumber = jerseyNumber
+	p.UpdatedAt = now
+
+	return &Spell{
+		ID:         ulid.GenerateID(),
+		PlayerID:   p.ID,
+		TeamID:     t.ToTeamID,
+		JoinedOn:   effectiveOn,
+		FeeType:    feeType,
+		LoanEndsOn: loanEndsOn,
+		CreatedAt:  now,
+	}, nil
+}
+
+// day truncates a time to its calendar date.
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+const (
+	maxVenueNameLength = 255
+	maxCapacity        = 200000
+)
+
+// DefaultTimezone is the time zone of venues registered without one, Western Indonesian Time (WIB).
+// It matches the default of the venues.timezone column.
+const DefaultTimezone = "Asia/Jakarta"
+
+// Surface is the playing surface of a venue.
+type Surface string
+
+const (
+	SurfaceGrass      Surface = "grass"
+	SurfaceArtificial Surface = "artificial"
+	SurfaceHybrid     Surface = "hybrid"
+)
+
+// Venue is a stadium matches are played at. Teams play their home matches at their home venue.
+type Venue struct {
+	ID        string
+	Name      string
+	City      string
+	Address   string
+	Capacity  int      // 0 when unknown
+	Surface   Surface  // Empty when unknown
+	Latitude  *float64 // Coordinates are either both set or both unknown
+	Longitude *float64
+	Timezone  string // IANA time zone kickoffs at the venue are given in, e.g. Asia/Makassar
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func NewVenue(name, city, address string, capacity int, surface Surface, latitude, longitude *float64, timezone string) (*Venue, error) {
+	v := &Venue{}
+	if err := v.set(name, city, address, capacity, surface, latitude, longitude, timezone); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	v.ID = ulid.GenerateID()
+	v.CreatedAt = now
+	v.UpdatedAt = now
+	return v, nil
+}
+
+func (v *Venue) Update(name, city, address string, capacity int, surface Surface, latitude, longitude *float64, timezone string) error {
+	if err := v.set(name, city, address, capacity, surface, latitude, longitude, timezone); err != nil {
+		return err
+	}
+
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
+func (v *Venue) set(name, city, address string, capacity int, surface Surface, latitude, longitude *float64, timezone string) error {
+	name = strings.TrimSpace(name)
+	city = strings.TrimSpace(city)
+	address = strings.TrimSpace(address)
+	surface = Surface(strings.ToLower(strings.TrimSpace(string(surface))))
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+
+	if name == "" {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "venue name is required")
+	}
+	if len(name) > maxVenueNameLength {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "venue name must not exceed %d characters", maxVenueNameLength)
+	}
+	if len(city) > maxCityLength {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "venue city must not exceed %d characters", maxCityLength)
+	}
+	if capacity < 0 || capacity > maxCapacity {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "capacity must be between 0 and %d", maxCapacity)
+	}
+	switch surface {
+	case "", SurfaceGrass, SurfaceArtificial, SurfaceHybrid:
+	default:
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "surface must be one of %q, %q or %q", SurfaceGrass, SurfaceArtificial, SurfaceHybrid)
+	}
+	if (latitude == nil) != (longitude == nil) {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "latitude and longitude must be given together")
+	}
+	if latitude != nil && (*latitude < -90 || *latitude > 90) {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "latitude must be between -90 and 90")
+	}
+	if longitude != nil && (*longitude < -180 || *longitude > 180) {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "longitude must be between -180 and 180")
+	}
+	if !isTimezone(timezone) {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "timezone must be an IANA time zone such as %q", DefaultTimezone)
+	}
+
+	v.Name = name
+	v.City = city
+	v.Address = address
+	v.Capacity = capacity
+	v.Surface = surface
+	v.Latitude = latitude
+	v.Longitude = longitude
+	v.Timezone = timezone
+	return nil
+}
+
+// isTimezone reports whether name is a zone a venue can be in. Venues exist to stop kickoffs following
+// the server's clock, so "Local" is not one.
+func isTimezone(name string) bool {
+	_, err := time.LoadLocation(name)
+	return err == nil && name != "Local"
+}
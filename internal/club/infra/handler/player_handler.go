@@ -2,11 +2,17 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/handler/request"
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/handler/response"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
 	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	authguard "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http/middleware/authguard"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/http/middleware/force"
 	"github.com/gin-gonic/gin"
 )
 
@@ -25,7 +31,8 @@ func (h *PlayerHandler) Create(c *gin.Context) {
 		return
 	}
 
-	id, err := h.service.Create(c.Request.Context(), req.ToDomain())
+	user := authguard.CurrentUser(c)
+	id, err := h.service.Create(c.Request.Context(), req.ToDomain(), force.Requested(c), user.Email)
 	if err != nil {
 		resp := common.RenderErrorResponse(err)
 		c.JSON(resp.Code, resp)
@@ -48,10 +55,37 @@ func (h *PlayerHandler) GetByID(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromPlayer(player)))
 }
 
+// GetAll lists players across teams, narrowed down by the filters of playerFilter and ?team_id=.
+func (h *PlayerHandler) GetAll(c *gin.Context) {
+	filter, err := playerFilter(c)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+	filter.TeamID = c.Query("team_id")
+
+	players, err := h.service.GetAll(c.Request.Context(), filter)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromPlayers(players)))
+}
+
 func (h *PlayerHandler) GetByTeamID(c *gin.Context) {
 	teamID := c.Param("id")
 
-	players, err := h.service.GetByTeamID(c.Request.Context(), teamID)
+	filter, err := playerFilter(c)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	players, err := h.service.GetByTeamID(c.Request.Context(), teamID, filter)
 	if err != nil {
 		resp := common.RenderErrorResponse(err)
 		c.JSON(resp.Code, resp)
@@ -90,3 +124,96 @@ func (h *PlayerHandler) Delete(c *gin.Context) {
 
 	c.JSON(http.StatusOK, common.NewSuccessResponse())
 }
+
+func (h *PlayerHandler) Transfer(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.TransferPlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	user := authguard.CurrentUser(c)
+	player, err := h.service.Transfer(c.Request.Context(), id, req.ToDomain(), force.Requested(c), user.Email)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromPlayer(player)))
+}
+
+// GetCareer lists every team the player was registered with.
+func (h *PlayerHandler) GetCareer(c *gin.Context) {
+	id := c.Param("id")
+
+	spells, err := h.service.GetCareer(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromSpells(spells)))
+}
+
+// GetRegistrationOverrides lists the registration rules admins overrode to bring players to the team.
+func (h *PlayerHandler) GetRegistrationOverrides(c *gin.Context) {
+	teamID := c.Param("id")
+
+	overrides, err := h.service.GetRegistrationOverrides(c.Request.Context(), teamID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromRegistrationOverrides(overrides)))
+}
+
+// playerFilter reads ?nationality=, ?position= (main or secondary), ?preferred_foot=, ?min_age= and ?max_age=.
+func playerFilter(c *gin.Context) (domain.PlayerFilter, error) {
+	filter := domain.PlayerFilter{
+		Nationality: strings.ToUpper(strings.TrimSpace(c.Query("nationality"))),
+	}
+
+	if position := c.Query("position"); position != "" {
+		pos, ok := domain.ParsePosition(position)
+		if !ok {
+			return domain.PlayerFilter{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown position %q", position)
+		}
+		filter.Position = pos
+	}
+
+	if foot := c.Query("preferred_foot"); foot != "" {
+		preferred, err := domain.ParseFoot(foot)
+		if err != nil {
+			return domain.PlayerFilter{}, err
+		}
+		filter.PreferredFoot = preferred
+	}
+
+	var err error
+	if filter.MinAge, err = queryAge(c, "min_age"); err != nil {
+		return domain.PlayerFilter{}, err
+	}
+	if filter.MaxAge, err = queryAge(c, "max_age"); err != nil {
+		return domain.PlayerFilter{}, err
+	}
+
+	return filter, nil
+}
+
+func queryAge(c *gin.Context, param string) (*int, error) {
+	v := c.Query(param)
+	if v == "" {
+		return nil, nil
+	}
+	age, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "%s must be a whole number", param)
+	}
+	return &age, nil
+}
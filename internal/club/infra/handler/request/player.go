@@ -1,6 +1,10 @@
 package request
 
-import "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+)
 
 type CreatePlayerRequest struct {
 	TeamID       string  `json:"team_id" binding:"required"`
@@ -9,6 +13,8 @@ type CreatePlayerRequest struct {
 	Weight       float64 `json:"weight" binding:"required"`
 	Position     string  `json:"position" binding:"required"`
 	JerseyNumber int     `json:"jersey_number" binding:"required"`
+	Nationality  string  `json:"nationality"` // ISO 3166-1 alpha-2, defaults to ID
+	ProfileRequest
 }
 
 func (r CreatePlayerRequest) ToDomain() *domain.Player {
@@ -20,6 +26,7 @@ func (r CreatePlayerRequest) ToDomain() *domain.Player {
 		Weight:       r.Weight,
 		Position:     pos,
 		JerseyNumber: r.JerseyNumber,
+		Profile:      r.ProfileRequest.toDomain(r.Nationality),
 	}
 }
 
@@ -29,6 +36,8 @@ type UpdatePlayerRequest struct {
 	Weight       float64 `json:"weight" binding:"required"`
 	Position     string  `json:"position" binding:"required"`
 	JerseyNumber int     `json:"jersey_number" binding:"required"`
+	Nationality  string  `json:"nationality"` // ISO 3166-1 alpha-2, keeps the current one when empty
+	ProfileRequest
 }
 
 func (r UpdatePlayerRequest) ToDomain() *domain.Player {
@@ -39,5 +48,54 @@ func (r UpdatePlayerRequest) ToDomain() *domain.Player {
 		Weight:       r.Weight,
 		Position:     pos,
 		JerseyNumber: r.JerseyNumber,
+		Profile:      r.ProfileRequest.toDomain(r.Nationality),
+	}
+}
+
+// ProfileRequest holds the optional personal details of a player.
+type ProfileRequest struct {
+	DateOfBirth        string   `json:"date_of_birth"`       // YYYY-MM-DD
+	PhotoURL           string   `json:"photo_url"`           // Usually the URL of a player-photo upload
+	PreferredFoot      string   `json:"preferred_foot"`      // left, right or both
+	SecondaryPositions []string `json:"secondary_positions"` // Up to 3, e.g. ["CAM", "RW"]
+}
+
+func (r ProfileRequest) toDomain(nationality string) domain.Profile {
+	profile := domain.Profile{
+		Nationality:   nationality,
+		PhotoURL:      r.PhotoURL,
+		PreferredFoot: domain.Foot(r.PreferredFoot),
+	}
+	if r.DateOfBirth != "" {
+		dob, _ := time.Parse("2006-01-02", r.DateOfBirth)
+		profile.DateOfBirth = &dob
+	}
+	for _, name := range r.SecondaryPositions {
+		pos, _ := domain.ParsePosition(name)
+		profile.SecondaryPositions = append(profile.SecondaryPositions, pos)
+	}
+	return profile
+}
+
+type TransferPlayerRequest struct {
+	TeamID        string `json:"team_id" binding:"required"`
+	EffectiveDate string `json:"effective_date" binding:"required"` // YYYY-MM-DD
+	FeeType       string `json:"fee_type" binding:"required"`       // permanent, loan or free
+	LoanEndDate   string `json:"loan_end_date"`                     // YYYY-MM-DD, loans only
+	JerseyNumber  int    `json:"jersey_number"`                     // Defaults to the current number
+}
+
+func (r TransferPlayerRequest) ToDomain() domain.Transfer {
+	effectiveOn, _ := time.Parse("2006-01-02", r.EffectiveDate)
+	transfer := domain.Transfer{
+		ToTeamID:     r.TeamID,
+		EffectiveOn:  effectiveOn,
+		FeeType:      domain.FeeType(r.FeeType),
+		JerseyNumber: r.JerseyNumber,
+	}
+	if r.LoanEndDate != "" {
+		loanEndsOn, _ := time.Parse("2006-01-02", r.LoanEndDate)
+		transfer.LoanEndsOn = &loanEndsOn
 	}
+	return transfer
 }
@@ -0,0 +1,35 @@
+package request
+
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+)
+
+// StaffRequest creates or updates a member of staff. The team is the one in the path on creation and
+// cannot be changed.
+type StaffRequest struct {
+	Name          string `json:"name" binding:"required"`
+	Role          string `json:"role" binding:"required"`           // head_coach, assistant_coach, goalkeeper_coach, physio or team_manager
+	License       string `json:"license"`                           // pro, a, b, c or d; empty when they hold none
+	ContractStart string `json:"contract_start" binding:"required"` // YYYY-MM-DD
+	ContractEnd   string `json:"contract_end"`                      // YYYY-MM-DD, empty for an open-ended contract
+	PhotoURL      string `json:"photo_url"`                         // Usually the URL of a staff-photo upload
+}
+
+func (r StaffRequest) ToDomain(teamID string) *domain.Staff {
+	contractStart, _ := time.Parse("2006-01-02", r.ContractStart)
+	staff := &domain.Staff{
+		TeamID:        teamID,
+		Name:          r.Name,
+		Role:          domain.StaffRole(r.Role),
+		License:       domain.License(r.License),
+		ContractStart: contractStart,
+		PhotoURL:      r.PhotoURL,
+	}
+	if r.ContractEnd != "" {
+		contractEnd, _ := time.Parse("2006-01-02", r.ContractEnd)
+		staff.ContractEnd = &contractEnd
+	}
+	return staff
+}
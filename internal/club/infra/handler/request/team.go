@@ -8,6 +8,7 @@ type CreateTeamRequest struct {
 	YearFounded int    `json:"year_founded" binding:"required"`
 	Address     string `json:"address"`
 	City        string `json:"city" binding:"required"`
+	HomeVenueID string `json:"home_venue_id"`
 }
 
 func (r CreateTeamRequest) ToDomain() *domain.Team {
@@ -17,6 +18,7 @@ func (r CreateTeamRequest) ToDomain() *domain.Team {
 		YearFounded: r.YearFounded,
 		Address:     r.Address,
 		City:        r.City,
+		HomeVenueID: r.HomeVenueID,
 	}
 }
 
@@ -26,6 +28,7 @@ type UpdateTeamRequest struct {
 	YearFounded int    `json:"year_founded" binding:"required"`
 	Address     string `json:"address"`
 	City        string `json:"city" binding:"required"`
+	HomeVenueID string `json:"home_venue_id"`
 }
 
 func (r UpdateTeamRequest) ToDomain() *domain.Team {
@@ -35,5 +38,6 @@ func (r UpdateTeamRequest) ToDomain() *domain.Team {
 		YearFounded: r.YearFounded,
 		Address:     r.Address,
 		City:        r.City,
+		HomeVenueID: r.HomeVenueID,
 	}
 }
@@ -0,0 +1,56 @@
+package request
+
+import "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+
+type CreateVenueRequest struct {
+	Name      string   `json:"name" binding:"required"`
+	City      string   `json:"city"`
+	Address   string   `json:"address"`
+	Capacity  int      `json:"capacity"`
+	Surface   string   `json:"surface"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Timezone  string   `json:"timezone"` // IANA time zone, defaults to Asia/Jakarta
+}
+
+func (r CreateVenueRequest) ToDomain() *domain.Venue {
+	return &domain.Venue{
+		Name:      r.Name,
+		City:      r.City,
+		Address:   r.Address,
+		Capacity:  r.Capacity,
+		Surface:   domain.Surface(r.Surface),
+		Latitude:  r.Latitude,
+		Longitude: r.Longitude,
+		Timezone:  r.Timezone,
+	}
+}
+
+type UpdateVenueRequest struct {
+	Name      string   `json:"name" binding:"required"`
+	City      string   `json:"city"`
+	Address   string   `json:"address"`
+	Capacity  int      `json:"capacity"`
+	Surface   string   `json:"surface"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Timezone  string   `json:"timezone"` // IANA time zone, defaults to Asia/Jakarta
+}
+
+func (r UpdateVenueRequest) ToDomain() *domain.Venue {
+	return &domain.Venue{
+		Name:      r.Name,
+		City:      r.City,
+		Address:   r.Address,
+		Capacity:  r.Capacity,
+		Surface:   domain.Surface(r.Surface),
+		Latitude:  r.Latitude,
+		Longitude: r.Longitude,
+		Timezone:  r.Timezone,
+	}
+}
+
+// MergeVenueRequest names the duplicate venue to fold into the one in the path.
+type MergeVenueRequest struct {
+	VenueID string `json:"venue_id" binding:"required"`
+}
@@ -1,26 +1,52 @@
 package response
 
-import "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+)
 
 type PlayerResponse struct {
-	ID           string  `json:"id"`
-	TeamID       string  `json:"team_id"`
-	Name         string  `json:"name"`
-	Height       float64 `json:"height"`
-	Weight       float64 `json:"weight"`
-	Position     string  `json:"position"`
-	JerseyNumber int     `json:"jersey_number"`
+	ID                 string   `json:"id"`
+	TeamID             string   `json:"team_id"`
+	Name               string   `json:"name"`
+	Height             float64  `json:"height"`
+	Weight             float64  `json:"weight"`
+	Position           string   `json:"position"`
+	SecondaryPositions []string `json:"secondary_positions"`
+	JerseyNumber       int      `json:"jersey_number"`
+	Nationality        string   `json:"nationality"`
+	DateOfBirth        *string  `json:"date_of_birth"`
+	Age                *int     `json:"age"` // Null when the date of birth is unknown
+	PhotoURL           string   `json:"photo_url"`
+	PreferredFoot      string   `json:"preferred_foot"`
 }
 
 func FromPlayer(player *domain.Player) PlayerResponse {
+	secondary := make([]string, len(player.SecondaryPositions))
+	for i, p := range player.SecondaryPositions {
+		secondary[i] = p.String()
+	}
+
+	var age *int
+	if years, ok := player.Age(time.Now()); ok {
+		age = &years
+	}
+
 	return PlayerResponse{
-		ID:           player.ID,
-		TeamID:       player.TeamID,
-		Name:         player.Name,
-		Height:       player.Height,
-		Weight:       player.Weight,
-		Position:     player.Position.String(),
-		JerseyNumber: player.JerseyNumber,
+		ID:                 player.ID,
+		TeamID:             player.TeamID,
+		Name:               player.Name,
+		Height:             player.Height,
+		Weight:             player.Weight,
+		Position:           player.Position.String(),
+		SecondaryPositions: secondary,
+		JerseyNumber:       player.JerseyNumber,
+		Nationality:        player.Nationality,
+		DateOfBirth:        formatDate(player.DateOfBirth),
+		Age:                age,
+		PhotoURL:           player.PhotoURL,
+		PreferredFoot:      string(player.PreferredFoot),
 	}
 }
 
@@ -31,3 +57,63 @@ func FromPlayers(players []domain.Player) []PlayerResponse {
 	}
 	return result
 }
+
+type SpellResponse struct {
+	TeamID      string  `json:"team_id"`
+	TeamName    string  `json:"team_name"`
+	JoinedOn    string  `json:"joined_on"`
+	LeftOn      *string `json:"left_on"` // Null while the player is still with the team
+	FeeType     string  `json:"fee_type,omitempty"`
+	LoanEndDate *string `json:"loan_end_date,omitempty"`
+}
+
+func FromSpells(spells []domain.Spell) []SpellResponse {
+	result := make([]SpellResponse, len(spells))
+	for i, s := range spells {
+		result[i] = SpellResponse{
+			TeamID:      s.TeamID,
+			TeamName:    s.TeamName,
+			JoinedOn:    s.JoinedOn.Format("2006-01-02"),
+			LeftOn:      formatDate(s.LeftOn),
+			FeeType:     string(s.FeeType),
+			LoanEndDate: formatDate(s.LoanEndsOn),
+		}
+	}
+	return result
+}
+
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
+
+type RegistrationOverrideResponse struct {
+	ID           string `json:"id"`
+	PlayerID     string `json:"player_id"`
+	SeasonID     string `json:"season_id"`
+	Action       string `json:"action"`
+	Rule         string `json:"rule"`
+	Message      string `json:"message"`
+	OverriddenBy string `json:"overridden_by"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func FromRegistrationOverrides(overrides []domain.RegistrationOverride) []RegistrationOverrideResponse {
+	result := make([]RegistrationOverrideResponse, len(overrides))
+	for i, o := range overrides {
+		result[i] = RegistrationOverrideResponse{
+			ID:           o.ID,
+			PlayerID:     o.PlayerID,
+			SeasonID:     o.SeasonID,
+			Action:       string(o.Action),
+			Rule:         string(o.Rule),
+			Message:      o.Message,
+			OverriddenBy: o.OverriddenBy,
+			CreatedAt:    o.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return result
+}
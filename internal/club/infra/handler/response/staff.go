@@ -0,0 +1,57 @@
+package response
+
+import (
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+)
+
+type StaffResponse struct {
+	ID            string  `json:"id"`
+	TeamID        string  `json:"team_id"`
+	Name          string  `json:"name"`
+	Role          string  `json:"role"`
+	License       string  `json:"license"`
+	ContractStart string  `json:"contract_start"`
+	ContractEnd   *string `json:"contract_end"` // Null for an open-ended contract
+	PhotoURL      string  `json:"photo_url"`
+}
+
+func FromStaff(staff *domain.Staff) StaffResponse {
+	return StaffResponse{
+		ID:            staff.ID,
+		TeamID:        staff.TeamID,
+		Name:          staff.Name,
+		Role:          string(staff.Role),
+		License:       string(staff.License),
+		ContractStart: staff.ContractStart.Format("2006-01-02"),
+		ContractEnd:   formatDate(staff.ContractEnd),
+		PhotoURL:      staff.PhotoURL,
+	}
+}
+
+func FromStaffList(staff []domain.Staff) []StaffResponse {
+	result := make([]StaffResponse, len(staff))
+	for i, s := range staff {
+		result[i] = FromStaff(&s)
+	}
+	return result
+}
+
+type HeadCoachResponse struct {
+	StaffID   string  `json:"staff_id"`
+	Name      string  `json:"name"`
+	StartedOn string  `json:"started_on"`
+	EndedOn   *string `json:"ended_on"` // Null while they are still in charge
+}
+
+func FromTenures(tenures []domain.Tenure) []HeadCoachResponse {
+	result := make([]HeadCoachResponse, len(tenures))
+	for i, t := range tenures {
+		result[i] = HeadCoachResponse{
+			StaffID:   t.StaffID,
+			Name:      t.StaffName,
+			StartedOn: t.StartedOn.Format("2006-01-02"),
+			EndedOn:   formatDate(t.EndedOn),
+		}
+	}
+	return result
+}
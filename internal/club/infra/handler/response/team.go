@@ -3,22 +3,26 @@ package response
 import "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
 
 type TeamResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	LogoURL     string `json:"logo_url"`
-	YearFounded int    `json:"year_founded"`
-	Address     string `json:"address"`
-	City        string `json:"city"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	LogoURL       string `json:"logo_url"`
+	YearFounded   int    `json:"year_founded"`
+	Address       string `json:"address"`
+	City          string `json:"city"`
+	HomeVenueID   string `json:"home_venue_id"`
+	HomeVenueName string `json:"home_venue_name"`
 }
 
 func FromTeam(team *domain.Team) TeamResponse {
 	return TeamResponse{
-		ID:          team.ID,
-		Name:        team.Name,
-		LogoURL:     team.LogoURL,
-		YearFounded: team.YearFounded,
-		Address:     team.Address,
-		City:        team.City,
+		ID:            team.ID,
+		Name:          team.Name,
+		LogoURL:       team.LogoURL,
+		YearFounded:   team.YearFounded,
+		Address:       team.Address,
+		City:          team.City,
+		HomeVenueID:   team.HomeVenueID,
+		HomeVenueName: team.HomeVenueName,
 	}
 }
 
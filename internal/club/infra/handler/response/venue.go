@@ -0,0 +1,37 @@
+package response
+
+import "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+
+type VenueResponse struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	City      string   `json:"city"`
+	Address   string   `json:"address"`
+	Capacity  int      `json:"capacity"`
+	Surface   string   `json:"surface"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Timezone  string   `json:"timezone"`
+}
+
+func FromVenue(venue *domain.Venue) VenueResponse {
+	return VenueResponse{
+		ID:        venue.ID,
+		Name:      venue.Name,
+		City:      venue.City,
+		Address:   venue.Address,
+		Capacity:  venue.Capacity,
+		Surface:   string(venue.Surface),
+		Latitude:  venue.Latitude,
+		Longitude: venue.Longitude,
+		Timezone:  venue.Timezone,
+	}
+}
+
+func FromVenues(venues []domain.Venue) []VenueResponse {
+	result := make([]VenueResponse, len(venues))
+	for i, v := range venues {
+		result[i] = FromVenue(&v)
+	}
+	return result
+}
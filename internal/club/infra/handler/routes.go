@@ -1,11 +1,18 @@
 package handler
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/http/middleware/force"
+	"github.com/gin-gonic/gin"
+)
 
 // RegisterRoutes registers all Club Management routes.
 // Write routes (POST, PUT, DELETE) are protected by the auth middleware.
+// Registering or transferring a player against the registration rules of the team's seasons
+// (?force=true) additionally requires the admin middleware, as does reading the overrides.
 // Read routes (GET) are public.
-func RegisterRoutes(rg *gin.RouterGroup, teamHandler *TeamHandler, playerHandler *PlayerHandler, authMiddleware ...gin.HandlerFunc) {
+func RegisterRoutes(rg *gin.RouterGroup, teamHandler *TeamHandler, playerHandler *PlayerHandler, venueHandler *VenueHandler, staffHandler *StaffHandler, adminMiddleware gin.HandlerFunc, authMiddleware ...gin.HandlerFunc) {
+	forceMiddleware := force.RequireAdmin(adminMiddleware)
+
 	// Team routes
 	teams := rg.Group("/teams")
 	{
@@ -13,22 +20,56 @@ func RegisterRoutes(rg *gin.RouterGroup, teamHandler *TeamHandler, playerHandler
 		teams.GET("", teamHandler.GetAll)
 		teams.GET("/:id", teamHandler.GetByID)
 		teams.GET("/:id/players", playerHandler.GetByTeamID)
+		teams.GET("/:id/staff", staffHandler.GetByTeamID)
+		teams.GET("/:id/head-coaches", staffHandler.GetHeadCoaches)
 
 		// Protected (write) — middleware applied per-route
 		teams.POST("", append(authMiddleware, teamHandler.Create)...)
 		teams.PUT("/:id", append(authMiddleware, teamHandler.Update)...)
 		teams.DELETE("/:id", append(authMiddleware, teamHandler.Delete)...)
+		teams.POST("/:id/staff", append(authMiddleware, staffHandler.Create)...)
+
+		// Admin only
+		teams.GET("/:id/registration-overrides", append(authMiddleware, adminMiddleware, playerHandler.GetRegistrationOverrides)...)
+	}
+
+	// Venue routes
+	venues := rg.Group("/venues")
+	{
+		// Public (read-only)
+		venues.GET("", venueHandler.GetAll)
+		venues.GET("/:id", venueHandler.GetByID)
+
+		// Protected (write) — middleware applied per-route
+		venues.POST("", append(authMiddleware, venueHandler.Create)...)
+		venues.PUT("/:id", append(authMiddleware, venueHandler.Update)...)
+		venues.DELETE("/:id", append(authMiddleware, venueHandler.Delete)...)
+		venues.POST("/:id/merge", append(authMiddleware, venueHandler.Merge)...)
+	}
+
+	// Staff routes
+	staff := rg.Group("/staff")
+	{
+		// Public (read-only)
+		staff.GET("/:id", staffHandler.GetByID)
+
+		// Protected (write) — middleware applied per-route
+		staff.PUT("/:id", append(authMiddleware, staffHandler.Update)...)
+		staff.DELETE("/:id", append(authMiddleware, staffHandler.Delete)...)
 	}
 
 	// Player routes
 	players := rg.Group("/players")
 	{
 		// Public (read-only)
+		players.GET("", playerHandler.GetAll)
 		players.GET("/:id", playerHandler.GetByID)
+		players.GET("/:id/career", playerHandler.GetCareer)
 
 		// Protected (write) — middleware applied per-route
-		players.POST("", append(authMiddleware, playerHandler.Create)...)
+		players.POST("", append(authMiddleware, forceMiddleware, playerHandler.Create)...)
 		players.PUT("/:id", append(authMiddleware, playerHandler.Update)...)
 		players.DELETE("/:id", append(authMiddleware, playerHandler.Delete)...)
+		players.POST("/:id/transfer", append(authMiddleware, forceMiddleware, playerHandler.Transfer)...)
 	}
 }
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/handler/request"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/handler/response"
+	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	"github.com/gin-gonic/gin"
+)
+
+type StaffHandler struct {
+	service app.StaffServicePort
+}
+
+func NewStaffHandler(service app.StaffServicePort) *StaffHandler {
+	return &StaffHandler{service: service}
+}
+
+func (h *StaffHandler) Create(c *gin.Context) {
+	teamID := c.Param("id")
+
+	var req request.StaffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	id, err := h.service.Create(c.Request.Context(), req.ToDomain(teamID))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
+}
+
+func (h *StaffHandler) GetByID(c *gin.Context) {
+	id := c.Param("id")
+
+	staff, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromStaff(staff)))
+}
+
+func (h *StaffHandler) GetByTeamID(c *gin.Context) {
+	teamID := c.Param("id")
+
+	staff, err := h.service.GetByTeamID(c.Request.Context(), teamID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromStaffList(staff)))
+}
+
+func (h *StaffHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.StaffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	if err := h.service.Update(c.Request.Context(), id, req.ToDomain("")); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *StaffHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+// GetHeadCoaches lists the head coaches the team has had, newest first.
+func (h *StaffHandler) GetHeadCoaches(c *gin.Context) {
+	teamID := c.Param("id")
+
+	tenures, err := h.service.GetHeadCoaches(c.Request.Context(), teamID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromTenures(tenures)))
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/handler/request"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/infra/handler/response"
+	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	"github.com/gin-gonic/gin"
+)
+
+type VenueHandler struct {
+	service app.VenueServicePort
+}
+
+func NewVenueHandler(service app.VenueServicePort) *VenueHandler {
+	return &VenueHandler{service: service}
+}
+
+func (h *VenueHandler) Create(c *gin.Context) {
+	var req request.CreateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	id, err := h.service.Create(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
+}
+
+func (h *VenueHandler) GetAll(c *gin.Context) {
+	venues, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromVenues(venues)))
+}
+
+func (h *VenueHandler) GetByID(c *gin.Context) {
+	id := c.Param("id")
+
+	venue, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromVenue(venue)))
+}
+
+func (h *VenueHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.UpdateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	if err := h.service.Update(c.Request.Context(), id, req.ToDomain()); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *VenueHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+// Merge folds the venue in the body into the venue in the path.
+func (h *VenueHandler) Merge(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.MergeVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	if err := h.service.Merge(c.Request.Context(), id, req.VenueID); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
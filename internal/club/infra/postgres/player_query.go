@@ -2,30 +2,46 @@ package postgres
 
 const (
 	queryInsertPlayer = `
-		INSERT INTO players (id, team_id, name, height, weight, position, jersey_number, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO players (id, team_id, name, height, weight, position, jersey_number, nationality, date_of_birth, photo_url, preferred_foot, secondary_positions, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''), $12, $13, $14)
 	`
 
 	queryFindPlayerByID = `
-		SELECT id, team_id, name, height, weight, position, jersey_number, created_at, updated_at, deleted_at
+		SELECT id, team_id, name, height, weight, position, jersey_number, nationality, date_of_birth, photo_url, COALESCE(preferred_foot, ''), secondary_positions, created_at, updated_at, deleted_at
 		FROM players
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
-	queryFindPlayersByTeamID = `
-		SELECT id, team_id, name, height, weight, position, jersey_number, created_at, updated_at, deleted_at
+	// Players without a date of birth only match when no age range is asked for
+	queryFindAllPlayers = `
+		SELECT id, team_id, name, height, weight, position, jersey_number, nationality, date_of_birth, photo_url, COALESCE(preferred_foot, ''), secondary_positions, created_at, updated_at, deleted_at
 		FROM players
-		WHERE team_id = $1 AND deleted_at IS NULL
-		ORDER BY jersey_number ASC
+		WHERE deleted_at IS NULL
+			AND ($1 = '' OR team_id = $1)
+			AND ($2 = '' OR nationality = $2)
+			AND ($3 = '' OR position = $3 OR $3 = ANY(secondary_positions))
+			AND ($4 = '' OR preferred_foot = $4)
+			AND ($5::date IS NULL OR date_of_birth > $5)
+			AND ($6::date IS NULL OR date_of_birth <= $6)
+		ORDER BY team_id, jersey_number ASC
 	`
 
 	queryUpdatePlayer = `
 		UPDATE players
-		SET name = $1, height = $2, weight = $3, position = $4, jersey_number = $5, updated_at = $6
-		WHERE id = $7 AND deleted_at IS NULL
+		SET name = $1, height = $2, weight = $3, position = $4, jersey_number = $5, nationality = $6,
+			date_of_birth = $7, photo_url = $8, preferred_foot = NULLIF($9, ''), secondary_positions = $10, updated_at = $11
+		WHERE id = $12 AND deleted_at IS NULL
 	`
 
-	querySoftDeletePlayer = `UPDATE players SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+	// The player leaves their team on the day they are deleted
+	querySoftDeletePlayer = `
+		WITH deleted AS (
+			UPDATE players SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
+			RETURNING id
+		)
+		UPDATE player_spells SET left_on = GREATEST(CURRENT_DATE, joined_on)
+		WHERE player_id IN (SELECT id FROM deleted) AND left_on IS NULL
+	`
 
 	queryIsJerseyNumberTaken = `
 		SELECT EXISTS(
@@ -33,4 +49,33 @@ const (
 			WHERE team_id = $1 AND jersey_number = $2 AND deleted_at IS NULL AND id != $3
 		)
 	`
+
+	queryInsertSpell = `
+		INSERT INTO player_spells (id, player_id, team_id, joined_on, fee_type, loan_ends_on, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7)
+	`
+
+	queryCloseSpell = `UPDATE player_spells SET left_on = $1 WHERE id = $2 AND left_on IS NULL`
+
+	queryTransferPlayer = `
+		UPDATE players
+		SET team_id = $1, jersey_number = $2, updated_at = $3
+		WHERE id = $4 AND deleted_at IS NULL
+	`
+
+	queryFindCurrentSpell = `
+		SELECT s.id, s.player_id, s.team_id, t.name, s.joined_on, s.left_on, COALESCE(s.fee_type, ''), s.loan_ends_on, s.created_at
+		FROM player_spells s
+		JOIN teams t ON t.id = s.team_id
+		WHERE s.player_id = $1 AND s.left_on IS NULL
+	`
+
+	// Teams deleted afterwards still show in the career of the players who played for them
+	queryFindSpellsByPlayerID = `
+		SELECT s.id, s.player_id, s.team_id, t.name, s.joined_on, s.left_on, COALESCE(s.fee_type, ''), s.loan_ends_on, s.created_at
+		FROM player_spells s
+		JOIN teams t ON t.id = s.team_id
+		WHERE s.player_id = $1
+		ORDER BY s.joined_on, s.created_at
+	`
 )
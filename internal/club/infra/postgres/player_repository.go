@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
 	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
@@ -18,8 +19,14 @@ func NewPlayerRepository(db *pgxpool.Pool) domain.PlayerRepository {
 	return &playerRepository{db: db}
 }
 
-func (r *playerRepository) Create(ctx context.Context, player *domain.Player) error {
-	_, err := r.db.Exec(ctx, queryInsertPlayer,
+func (r *playerRepository) Create(ctx context.Context, player *domain.Player, spell *domain.Spell, overrides []domain.RegistrationOverride) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, queryInsertPlayer,
 		player.ID,
 		player.TeamID,
 		player.Name,
@@ -27,69 +34,65 @@ func (r *playerRepository) Create(ctx context.Context, player *domain.Player) er
 		player.Weight,
 		player.Position.String(),
 		player.JerseyNumber,
+		player.Nationality,
+		player.DateOfBirth,
+		player.PhotoURL,
+		string(player.PreferredFoot),
+		positionNames(player.SecondaryPositions),
 		player.CreatedAt,
 		player.UpdatedAt,
 	)
 	if err != nil {
 		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert player")
 	}
+
+	if err := insertSpell(ctx, tx, spell); err != nil {
+		return err
+	}
+
+	if err := insertOverrides(ctx, tx, overrides); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
 	return nil
 }
 
 func (r *playerRepository) FindByID(ctx context.Context, id string) (*domain.Player, error) {
-	var player domain.Player
-	var position string
-	err := r.db.QueryRow(ctx, queryFindPlayerByID, id).Scan(
-		&player.ID,
-		&player.TeamID,
-		&player.Name,
-		&player.Height,
-		&player.Weight,
-		&position,
-		&player.JerseyNumber,
-		&player.CreatedAt,
-		&player.UpdatedAt,
-		&player.DeletedAt,
-	)
+	player, err := scanPlayer(r.db.QueryRow(ctx, queryFindPlayerByID, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, derrors.WrapErrorf(domain.ErrPlayerNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrPlayerNotFound.Error())
 		}
 		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find player")
 	}
-	pos, _ := domain.ParsePosition(position)
-	player.Position = pos
-	return &player, nil
+	return player, nil
 }
 
-func (r *playerRepository) FindByTeamID(ctx context.Context, teamID string) ([]domain.Player, error) {
-	rows, err := r.db.Query(ctx, queryFindPlayersByTeamID, teamID)
+func (r *playerRepository) FindAll(ctx context.Context, filter domain.PlayerFilter) ([]domain.Player, error) {
+	bornAfter, bornOnOrBefore := filter.BornBetween(time.Now())
+	rows, err := r.db.Query(ctx, queryFindAllPlayers,
+		filter.TeamID,
+		filter.Nationality,
+		filter.Position.String(),
+		string(filter.PreferredFoot),
+		bornAfter,
+		bornOnOrBefore,
+	)
 	if err != nil {
-		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query players by team")
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query players")
 	}
 	defer rows.Close()
 
 	var players []domain.Player
 	for rows.Next() {
-		var player domain.Player
-		var position string
-		if err := rows.Scan(
-			&player.ID,
-			&player.TeamID,
-			&player.Name,
-			&player.Height,
-			&player.Weight,
-			&position,
-			&player.JerseyNumber,
-			&player.CreatedAt,
-			&player.UpdatedAt,
-			&player.DeletedAt,
-		); err != nil {
+		player, err := scanPlayer(rows)
+		if err != nil {
 			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan player row")
 		}
-		pos, _ := domain.ParsePosition(position)
-		player.Position = pos
-		players = append(players, player)
+		players = append(players, *player)
 	}
 
 	return players, nil
@@ -102,6 +105,11 @@ func (r *playerRepository) Update(ctx context.Context, player *domain.Player) er
 		player.Weight,
 		player.Position.String(),
 		player.JerseyNumber,
+		player.Nationality,
+		player.DateOfBirth,
+		player.PhotoURL,
+		string(player.PreferredFoot),
+		positionNames(player.SecondaryPositions),
 		player.UpdatedAt,
 		player.ID,
 	)
@@ -127,3 +135,145 @@ func (r *playerRepository) IsJerseyNumberTaken(ctx context.Context, teamID strin
 	}
 	return exists, nil
 }
+
+func (r *playerRepository) FindCurrentSpell(ctx context.Context, playerID string) (*domain.Spell, error) {
+	spell, err := scanSpell(r.db.QueryRow(ctx, queryFindCurrentSpell, playerID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrPlayerNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrPlayerNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find current spell")
+	}
+	return spell, nil
+}
+
+func (r *playerRepository) FindSpells(ctx context.Context, playerID string) ([]domain.Spell, error) {
+	rows, err := r.db.Query(ctx, queryFindSpellsByPlayerID, playerID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query spells")
+	}
+	defer rows.Close()
+
+	var spells []domain.Spell
+	for rows.Next() {
+		spell, err := scanSpell(rows)
+		if err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan spell row")
+		}
+		spells = append(spells, *spell)
+	}
+
+	return spells, nil
+}
+
+func (r *playerRepository) Transfer(ctx context.Context, player *domain.Player, closed, opened *domain.Spell, overrides []domain.RegistrationOverride) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	// The spell was closed by another transfer since it was read
+	tag, err := tx.Exec(ctx, queryCloseSpell, closed.LeftOn, closed.ID)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to close spell")
+	}
+	if tag.RowsAffected() == 0 {
+		return derrors.WrapErrorf(domain.ErrTransferConflict, derrors.ErrorCodeDuplicate, "%s", domain.ErrTransferConflict.Error())
+	}
+
+	if err := insertSpell(ctx, tx, opened); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, queryTransferPlayer, player.TeamID, player.JerseyNumber, player.UpdatedAt, player.ID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to move player")
+	}
+
+	if err := insertOverrides(ctx, tx, overrides); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
+
+func insertSpell(ctx context.Context, tx pgx.Tx, spell *domain.Spell) error {
+	_, err := tx.Exec(ctx, queryInsertSpell,
+		spell.ID,
+		spell.PlayerID,
+		spell.TeamID,
+		spell.JoinedOn,
+		string(spell.FeeType),
+		spell.LoanEndsOn,
+		spell.CreatedAt,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert spell")
+	}
+	return nil
+}
+
+func scanSpell(row pgx.Row) (*domain.Spell, error) {
+	var spell domain.Spell
+	var feeType string
+	if err := row.Scan(
+		&spell.ID,
+		&spell.PlayerID,
+		&spell.TeamID,
+		&spell.TeamName,
+		&spell.JoinedOn,
+		&spell.LeftOn,
+		&feeType,
+		&spell.LoanEndsOn,
+		&spell.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	spell.FeeType = domain.FeeType(feeType)
+	return &spell, nil
+}
+
+func scanPlayer(row pgx.Row) (*domain.Player, error) {
+	var player domain.Player
+	var position, preferredFoot string
+	var secondaryPositions []string
+	if err := row.Scan(
+		&player.ID,
+		&player.TeamID,
+		&player.Name,
+		&player.Height,
+		&player.Weight,
+		&position,
+		&player.JerseyNumber,
+		&player.Nationality,
+		&player.DateOfBirth,
+		&player.PhotoURL,
+		&preferredFoot,
+		&secondaryPositions,
+		&player.CreatedAt,
+		&player.UpdatedAt,
+		&player.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+	player.Position, _ = domain.ParsePosition(position)
+	player.PreferredFoot = domain.Foot(preferredFoot)
+	for _, name := range secondaryPositions {
+		if pos, ok := domain.ParsePosition(name); ok {
+			player.SecondaryPositions = append(player.SecondaryPositions, pos)
+		}
+	}
+	return &player, nil
+}
+
+// positionNames stores positions by name, like the main position of a player.
+func positionNames(positions []domain.Position) []string {
+	names := make([]string, len(positions))
+	for i, p := range positions {
+		names[i] = p.String()
+	}
+	return names
+}
@@ -0,0 +1,43 @@
+package postgres
+
+const (
+	// A season governs registrations from its first window, which may open before it starts, until it ends
+	queryFindSeasonRulesByTeamID = `
+		SELECT s.id, c.name || ' ' || s.name, s.max_squad_size, s.max_foreign_players, s.home_nationality, c.max_age, c.age_cutoff_date
+		FROM seasons s
+		JOIN competitions c ON c.id = s.competition_id
+		JOIN season_teams st ON st.season_id = s.id
+		WHERE st.team_id = $1 AND s.deleted_at IS NULL
+			AND $2::date <= s.end_date
+			AND $2::date >= LEAST(s.start_date, COALESCE(
+				(SELECT MIN(w.opens_on) FROM season_registration_windows w WHERE w.season_id = s.id),
+				s.start_date
+			))
+		ORDER BY s.start_date
+	`
+
+	queryFindRegistrationWindowsBySeasonIDs = `
+		SELECT season_id, opens_on, closes_on
+		FROM season_registration_windows
+		WHERE season_id = ANY($1)
+		ORDER BY opens_on
+	`
+
+	queryCountSquad = `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE nationality <> $2)
+		FROM players
+		WHERE team_id = $1 AND deleted_at IS NULL
+	`
+
+	queryInsertRegistrationOverride = `
+		INSERT INTO registration_overrides (id, player_id, team_id, season_id, action, rule, message, overridden_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	queryFindRegistrationOverridesByTeamID = `
+		SELECT id, player_id, team_id, season_id, action, rule, message, overridden_by, created_at
+		FROM registration_overrides
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+	`
+)
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type registrationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRegistrationRepository(db *pgxpool.Pool) domain.RegistrationRepository {
+	return &registrationRepository{db: db}
+}
+
+func (r *registrationRepository) FindSeasonRules(ctx context.Context, teamID string, on time.Time) ([]domain.SeasonRules, error) {
+	rows, err := r.db.Query(ctx, queryFindSeasonRulesByTeamID, teamID, on)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query season rules")
+	}
+	defer rows.Close()
+
+	var seasons []domain.SeasonRules
+	var seasonIDs []string
+	for rows.Next() {
+		var rules domain.SeasonRules
+		if err := rows.Scan(
+			&rules.SeasonID,
+			&rules.SeasonName,
+			&rules.MaxSquadSize,
+			&rules.MaxForeignPlayers,
+			&rules.HomeNationality,
+			&rules.MaxAge,
+			&rules.AgeCutoffDate,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan season rules row")
+		}
+		seasons = append(seasons, rules)
+		seasonIDs = append(seasonIDs, rules.SeasonID)
+	}
+	if len(seasons) == 0 {
+		return nil, nil
+	}
+
+	windowRows, err := r.db.Query(ctx, queryFindRegistrationWindowsBySeasonIDs, seasonIDs)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query registration windows")
+	}
+	defer windowRows.Close()
+
+	for windowRows.Next() {
+		var seasonID string
+		var w domain.RegistrationWindow
+		if err := windowRows.Scan(&seasonID, &w.OpensOn, &w.ClosesOn); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan registration window row")
+		}
+		for i := range seasons {
+			if seasons[i].SeasonID == seasonID {
+				seasons[i].Windows = append(seasons[i].Windows, w)
+			}
+		}
+	}
+
+	return seasons, nil
+}
+
+func (r *registrationRepository) CountSquad(ctx context.Context, teamID, homeNationality string) (domain.Squad, error) {
+	var squad domain.Squad
+	err := r.db.QueryRow(ctx, queryCountSquad, teamID, homeNationality).Scan(&squad.Size, &squad.Foreign)
+	if err != nil {
+		return domain.Squad{}, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to count squad")
+	}
+	return squad, nil
+}
+
+func (r *registrationRepository) FindOverrides(ctx context.Context, teamID string) ([]domain.RegistrationOverride, error) {
+	rows, err := r.db.Query(ctx, queryFindRegistrationOverridesByTeamID, teamID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query registration overrides")
+	}
+	defer rows.Close()
+
+	var overrides []domain.RegistrationOverride
+	for rows.Next() {
+		var o domain.RegistrationOverride
+		var action, rule string
+		if err := rows.Scan(
+			&o.ID,
+			&o.PlayerID,
+			&o.TeamID,
+			&o.SeasonID,
+			&action,
+			&rule,
+			&o.Message,
+			&o.OverriddenBy,
+			&o.CreatedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan registration override row")
+		}
+		o.Action = domain.RegistrationAction(action)
+		o.Rule = domain.RegistrationRule(rule)
+		overrides = append(overrides, o)
+	}
+
+	return overrides, nil
+}
+
+func insertOverrides(ctx context.Context, tx pgx.Tx, overrides []domain.RegistrationOverride) error {
+	for _, o := range overrides {
+		_, err := tx.Exec(ctx, queryInsertRegistrationOverride,
+			o.ID,
+			o.PlayerID,
+			o.TeamID,
+			o.SeasonID,
+			string(o.Action),
+			string(o.Rule),
+			o.Message,
+			o.OverriddenBy,
+			o.CreatedAt,
+		)
+		if err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert registration override")
+		}
+	}
+	return nil
+}
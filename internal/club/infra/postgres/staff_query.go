@@ -0,0 +1,63 @@
+package postgres
+
+const (
+	queryInsertStaff = `
+		INSERT INTO team_staff (id, team_id, name, role, license, contract_start, contract_end, photo_url, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, $10)
+	`
+
+	queryFindStaffByID = `
+		SELECT id, team_id, name, role, COALESCE(license, ''), contract_start, contract_end, photo_url, created_at, updated_at, deleted_at
+		FROM team_staff
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	queryFindStaffByTeamID = `
+		SELECT id, team_id, name, role, COALESCE(license, ''), contract_start, contract_end, photo_url, created_at, updated_at, deleted_at
+		FROM team_staff
+		WHERE team_id = $1 AND deleted_at IS NULL
+		ORDER BY CASE role
+			WHEN 'head_coach' THEN 1
+			WHEN 'assistant_coach' THEN 2
+			WHEN 'goalkeeper_coach' THEN 3
+			WHEN 'physio' THEN 4
+			ELSE 5
+		END, name ASC
+	`
+
+	queryUpdateStaff = `
+		UPDATE team_staff
+		SET name = $1, role = $2, license = NULLIF($3, ''), contract_start = $4, contract_end = $5, photo_url = $6, updated_at = $7
+		WHERE id = $8 AND deleted_at IS NULL
+	`
+
+	querySoftDeleteStaff = `UPDATE team_staff SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	queryInsertTenure = `
+		INSERT INTO head_coach_tenures (id, team_id, staff_id, started_on, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	queryCloseTenure = `UPDATE head_coach_tenures SET ended_on = $1 WHERE id = $2 AND ended_on IS NULL`
+
+	queryUpdateTenure = `UPDATE head_coach_tenures SET started_on = $1, ended_on = $2 WHERE id = $3 AND ended_on IS NULL`
+
+	// The current head coach comes first, then the one who left last
+	queryFindLatestTenure = `
+		SELECT h.id, h.team_id, h.staff_id, s.name, h.started_on, h.ended_on, h.created_at
+		FROM head_coach_tenures h
+		JOIN team_staff s ON s.id = h.staff_id
+		WHERE h.team_id = $1
+		ORDER BY h.ended_on DESC NULLS FIRST, h.started_on DESC
+		LIMIT 1
+	`
+
+	// Head coaches deleted afterwards still show in the history of the team
+	queryFindTenuresByTeamID = `
+		SELECT h.id, h.team_id, h.staff_id, s.name, h.started_on, h.ended_on, h.created_at
+		FROM head_coach_tenures h
+		JOIN team_staff s ON s.id = h.staff_id
+		WHERE h.team_id = $1
+		ORDER BY h.started_on DESC, h.created_at DESC
+	`
+)
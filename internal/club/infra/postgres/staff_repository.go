@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const uniqueViolation = "23505"
+
+type staffRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewStaffRepository(db *pgxpool.Pool) domain.StaffRepository {
+	return &staffRepository{db: db}
+}
+
+func (r *staffRepository) Create(ctx context.Context, staff *domain.Staff, opened *domain.Tenure) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, queryInsertStaff,
+		staff.ID,
+		staff.TeamID,
+		staff.Name,
+		string(staff.Role),
+		string(staff.License),
+		staff.ContractStart,
+		staff.ContractEnd,
+		staff.PhotoURL,
+		staff.CreatedAt,
+		staff.UpdatedAt,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert staff")
+	}
+
+	if err := insertTenure(ctx, tx, opened); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
+
+func (r *staffRepository) FindByID(ctx context.Context, id string) (*domain.Staff, error) {
+	staff, err := scanStaff(r.db.QueryRow(ctx, queryFindStaffByID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrStaffNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrStaffNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find staff")
+	}
+	return staff, nil
+}
+
+func (r *staffRepository) FindByTeamID(ctx context.Context, teamID string) ([]domain.Staff, error) {
+	rows, err := r.db.Query(ctx, queryFindStaffByTeamID, teamID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query staff")
+	}
+	defer rows.Close()
+
+	var staff []domain.Staff
+	for rows.Next() {
+		s, err := scanStaff(rows)
+		if err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan staff row")
+		}
+		staff = append(staff, *s)
+	}
+
+	return staff, nil
+}
+
+func (r *staffRepository) Update(ctx context.Context, staff *domain.Staff, changed, opened *domain.Tenure) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, queryUpdateStaff,
+		staff.Name,
+		string(staff.Role),
+		string(staff.License),
+		staff.ContractStart,
+		staff.ContractEnd,
+		staff.PhotoURL,
+		staff.UpdatedAt,
+		staff.ID,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update staff")
+	}
+
+	if err := updateTenure(ctx, tx, changed); err != nil {
+		return err
+	}
+	if err := insertTenure(ctx, tx, opened); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
+
+func (r *staffRepository) SoftDelete(ctx context.Context, id string, closed *domain.Tenure) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, querySoftDeleteStaff, id); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete staff")
+	}
+
+	if err := closeTenure(ctx, tx, closed); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
+
+func (r *staffRepository) FindLatestTenure(ctx context.Context, teamID string) (*domain.Tenure, error) {
+	tenure, err := scanTenure(r.db.QueryRow(ctx, queryFindLatestTenure, teamID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find latest head coach tenure")
+	}
+	return tenure, nil
+}
+
+func (r *staffRepository) FindTenures(ctx context.Context, teamID string) ([]domain.Tenure, error) {
+	rows, err := r.db.Query(ctx, queryFindTenuresByTeamID, teamID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query head coach tenures")
+	}
+	defer rows.Close()
+
+	var tenures []domain.Tenure
+	for rows.Next() {
+		tenure, err := scanTenure(rows)
+		if err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan head coach tenure row")
+		}
+		tenures = append(tenures, *tenure)
+	}
+
+	return tenures, nil
+}
+
+func insertTenure(ctx context.Context, tx pgx.Tx, tenure *domain.Tenure) error {
+	if tenure == nil {
+		return nil
+	}
+	_, err := tx.Exec(ctx, queryInsertTenure,
+		tenure.ID,
+		tenure.TeamID,
+		tenure.StaffID,
+		tenure.StartedOn,
+		tenure.CreatedAt,
+	)
+	if err != nil {
+		// Another head coach took charge of the team since the latest tenure was read
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return derrors.WrapErrorf(domain.ErrHeadCoachTaken, derrors.ErrorCodeDuplicate, "%s", domain.ErrHeadCoachTaken.Error())
+		}
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert head coach tenure")
+	}
+	return nil
+}
+
+func updateTenure(ctx context.Context, tx pgx.Tx, tenure *domain.Tenure) error {
+	if tenure == nil {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, queryUpdateTenure, tenure.StartedOn, tenure.EndedOn, tenure.ID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update head coach tenure")
+	}
+	return nil
+}
+
+func closeTenure(ctx context.Context, tx pgx.Tx, tenure *domain.Tenure) error {
+	if tenure == nil {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, queryCloseTenure, tenure.EndedOn, tenure.ID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to close head coach tenure")
+	}
+	return nil
+}
+
+func scanStaff(row pgx.Row) (*domain.Staff, error) {
+	var staff domain.Staff
+	var role, license string
+	if err := row.Scan(
+		&staff.ID,
+		&staff.TeamID,
+		&staff.Name,
+		&role,
+		&license,
+		&staff.ContractStart,
+		&staff.ContractEnd,
+		&staff.PhotoURL,
+		&staff.CreatedAt,
+		&staff.UpdatedAt,
+		&staff.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+	staff.Role = domain.StaffRole(role)
+	staff.License = domain.License(license)
+	return &staff, nil
+}
+
+func scanTenure(row pgx.Row) (*domain.Tenure, error) {
+	var tenure domain.Tenure
+	if err := row.Scan(
+		&tenure.ID,
+		&tenure.TeamID,
+		&tenure.StaffID,
+		&tenure.StaffName,
+		&tenure.StartedOn,
+		&tenure.EndedOn,
+		&tenure.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &tenure, nil
+}
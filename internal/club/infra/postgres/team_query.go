@@ -2,27 +2,33 @@ package postgres
 
 const (
 	queryInsertTeam = `
-		INSERT INTO teams (id, name, logo_url, year_founded, address, city, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO teams (id, name, logo_url, year_founded, address, city, home_venue_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9)
 	`
 
 	queryFindTeamByID = `
-		SELECT id, name, logo_url, year_founded, address, city, created_at, updated_at, deleted_at
-		FROM teams
-		WHERE id = $1 AND deleted_at IS NULL
+		SELECT t.id, t.name, t.logo_url, t.year_founded, t.address, t.city,
+			COALESCE(t.home_venue_id, '') AS home_venue_id, COALESCE(v.name, '') AS home_venue_name,
+			t.created_at, t.updated_at, t.deleted_at
+		FROM teams t
+		LEFT JOIN venues v ON v.id = t.home_venue_id
+		WHERE t.id = $1 AND t.deleted_at IS NULL
 	`
 
 	queryFindAllTeams = `
-		SELECT id, name, logo_url, year_founded, address, city, created_at, updated_at, deleted_at
-		FROM teams
-		WHERE deleted_at IS NULL
-		ORDER BY created_at DESC
+		SELECT t.id, t.name, t.logo_url, t.year_founded, t.address, t.city,
+			COALESCE(t.home_venue_id, '') AS home_venue_id, COALESCE(v.name, '') AS home_venue_name,
+			t.created_at, t.updated_at, t.deleted_at
+		FROM teams t
+		LEFT JOIN venues v ON v.id = t.home_venue_id
+		WHERE t.deleted_at IS NULL
+		ORDER BY t.created_at DESC
 	`
 
 	queryUpdateTeam = `
 		UPDATE teams
-		SET name = $1, logo_url = $2, year_founded = $3, address = $4, city = $5, updated_at = $6
-		WHERE id = $7 AND deleted_at IS NULL
+		SET name = $1, logo_url = $2, year_founded = $3, address = $4, city = $5, home_venue_id = NULLIF($6, ''), updated_at = $7
+		WHERE id = $8 AND deleted_at IS NULL
 	`
 
 	querySoftDeleteTeam = `UPDATE teams SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
@@ -26,6 +26,7 @@ func (r *teamRepository) Create(ctx context.Context, team *domain.Team) error {
 		team.YearFounded,
 		team.Address,
 		team.City,
+		team.HomeVenueID,
 		team.CreatedAt,
 		team.UpdatedAt,
 	)
@@ -44,6 +45,8 @@ func (r *teamRepository) FindByID(ctx context.Context, id string) (*domain.Team,
 		&team.YearFounded,
 		&team.Address,
 		&team.City,
+		&team.HomeVenueID,
+		&team.HomeVenueName,
 		&team.CreatedAt,
 		&team.UpdatedAt,
 		&team.DeletedAt,
@@ -74,6 +77,8 @@ func (r *teamRepository) FindAll(ctx context.Context) ([]domain.Team, error) {
 			&team.YearFounded,
 			&team.Address,
 			&team.City,
+			&team.HomeVenueID,
+			&team.HomeVenueName,
 			&team.CreatedAt,
 			&team.UpdatedAt,
 			&team.DeletedAt,
@@ -93,6 +98,7 @@ func (r *teamRepository) Update(ctx context.Context, team *domain.Team) error {
 		team.YearFounded,
 		team.Address,
 		team.City,
+		team.HomeVenueID,
 		team.UpdatedAt,
 		team.ID,
 	)
@@ -0,0 +1,50 @@
+package postgres
+
+const (
+	queryInsertVenue = `
+		INSERT INTO venues (id, name, city, address, capacity, surface, latitude, longitude, timezone, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), NULLIF($6, ''), $7, $8, $9, $10, $11)
+	`
+
+	queryFindVenueByID = `
+		SELECT id, name, city, address, COALESCE(capacity, 0) AS capacity, COALESCE(surface, '') AS surface,
+			latitude, longitude, timezone, created_at, updated_at, deleted_at
+		FROM venues
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	queryFindAllVenues = `
+		SELECT id, name, city, address, COALESCE(capacity, 0) AS capacity, COALESCE(surface, '') AS surface,
+			latitude, longitude, timezone, created_at, updated_at, deleted_at
+		FROM venues
+		WHERE deleted_at IS NULL
+		ORDER BY name
+	`
+
+	queryUpdateVenue = `
+		UPDATE venues
+		SET name = $1, city = $2, address = $3, capacity = NULLIF($4, 0), surface = NULLIF($5, ''),
+			latitude = $6, longitude = $7, timezone = $8, updated_at = $9
+		WHERE id = $10 AND deleted_at IS NULL
+	`
+
+	querySoftDeleteVenue = `UPDATE venues SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	queryExistsVenueByName = `
+		SELECT EXISTS (
+			SELECT 1 FROM venues
+			WHERE LOWER(name) = LOWER($1) AND id != $2 AND deleted_at IS NULL
+		)
+	`
+
+	queryIsVenueInUse = `
+		SELECT EXISTS (
+			SELECT 1 FROM teams WHERE home_venue_id = $1 AND deleted_at IS NULL
+		) OR EXISTS (
+			SELECT 1 FROM matches WHERE venue_id = $1 AND deleted_at IS NULL
+		)
+	`
+
+	// Merging rewrites every reference, deleted rows included, so nothing is left pointing at the duplicate
+	queryMoveTeamsToVenue = `UPDATE teams SET home_venue_id = $1 WHERE home_venue_id = $2`
+)
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type venueRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewVenueRepository(db *pgxpool.Pool) domain.VenueRepository {
+	return &venueRepository{db: db}
+}
+
+func (r *venueRepository) Create(ctx context.Context, venue *domain.Venue) error {
+	_, err := r.db.Exec(ctx, queryInsertVenue,
+		venue.ID,
+		venue.Name,
+		venue.City,
+		venue.Address,
+		venue.Capacity,
+		string(venue.Surface),
+		venue.Latitude,
+		venue.Longitude,
+		venue.Timezone,
+		venue.CreatedAt,
+		venue.UpdatedAt,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert venue")
+	}
+	return nil
+}
+
+func (r *venueRepository) FindByID(ctx context.Context, id string) (*domain.Venue, error) {
+	var venue domain.Venue
+	err := r.db.QueryRow(ctx, queryFindVenueByID, id).Scan(
+		&venue.ID,
+		&venue.Name,
+		&venue.City,
+		&venue.Address,
+		&venue.Capacity,
+		&venue.Surface,
+		&venue.Latitude,
+		&venue.Longitude,
+		&venue.Timezone,
+		&venue.CreatedAt,
+		&venue.UpdatedAt,
+		&venue.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrVenueNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrVenueNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find venue")
+	}
+	return &venue, nil
+}
+
+func (r *venueRepository) FindAll(ctx context.Context) ([]domain.Venue, error) {
+	rows, err := r.db.Query(ctx, queryFindAllVenues)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query venues")
+	}
+	defer rows.Close()
+
+	var venues []domain.Venue
+	for rows.Next() {
+		var venue domain.Venue
+		if err := rows.Scan(
+			&venue.ID,
+			&venue.Name,
+			&venue.City,
+			&venue.Address,
+			&venue.Capacity,
+			&venue.Surface,
+			&venue.Latitude,
+			&venue.Longitude,
+			&venue.Timezone,
+			&venue.CreatedAt,
+			&venue.UpdatedAt,
+			&venue.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan venue row")
+		}
+		venues = append(venues, venue)
+	}
+
+	return venues, nil
+}
+
+func (r *venueRepository) Update(ctx context.Context, venue *domain.Venue) error {
+	_, err := r.db.Exec(ctx, queryUpdateVenue,
+		venue.Name,
+		venue.City,
+		venue.Address,
+		venue.Capacity,
+		string(venue.Surface),
+		venue.Latitude,
+		venue.Longitude,
+		venue.Timezone,
+		venue.UpdatedAt,
+		venue.ID,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update venue")
+	}
+	return nil
+}
+
+func (r *venueRepository) SoftDelete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, querySoftDeleteVenue, id)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete venue")
+	}
+	return nil
+}
+
+func (r *venueRepository) ExistsByName(ctx context.Context, name string, excludeID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsVenueByName, name, excludeID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check if venue exists by name")
+	}
+	return exists, nil
+}
+
+func (r *venueRepository) IsInUse(ctx context.Context, id string) (bool, error) {
+	var inUse bool
+	err := r.db.QueryRow(ctx, queryIsVenueInUse, id).Scan(&inUse)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check if venue is in use")
+	}
+	return inUse, nil
+}
+
+func (r *venueRepository) Merge(ctx context.Context, keepID, duplicateID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, queryMoveTeamsToVenue, keepID, duplicateID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to move teams to venue")
+	}
+
+	if _, err := tx.Exec(ctx, querySoftDeleteVenue, duplicateID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete venue")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
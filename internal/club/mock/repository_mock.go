@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/ZyoGo/ayo-indonesia-footbal/internal/club/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -128,6 +129,184 @@ func (mr *MockTeamRepositoryMockRecorder) Update(ctx, team any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTeamRepository)(nil).Update), ctx, team)
 }
 
+// MockVenueRepository is a mock of VenueRepository interface.
+type MockVenueRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVenueRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVenueRepositoryMockRecorder is the mock recorder for MockVenueRepository.
+type MockVenueRepositoryMockRecorder struct {
+	mock *MockVenueRepository
+}
+
+// NewMockVenueRepository creates a new mock instance.
+func NewMockVenueRepository(ctrl *gomock.Controller) *MockVenueRepository {
+	mock := &MockVenueRepository{ctrl: ctrl}
+	mock.recorder = &MockVenueRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVenueRepository) EXPECT() *MockVenueRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockVenueRepository) Create(ctx context.Context, venue *domain.Venue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, venue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVenueRepositoryMockRecorder) Create(ctx, venue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVenueRepository)(nil).Create), ctx, venue)
+}
+
+// ExistsByName mocks base method.
+func (m *MockVenueRepository) ExistsByName(ctx context.Context, name, excludeID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByName", ctx, name, excludeID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByName indicates an expected call of ExistsByName.
+func (mr *MockVenueRepositoryMockRecorder) ExistsByName(ctx, name, excludeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByName", reflect.TypeOf((*MockVenueRepository)(nil).ExistsByName), ctx, name, excludeID)
+}
+
+// FindAll mocks base method.
+func (m *MockVenueRepository) FindAll(ctx context.Context) ([]domain.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockVenueRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockVenueRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockVenueRepository) FindByID(ctx context.Context, id string) (*domain.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockVenueRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockVenueRepository)(nil).FindByID), ctx, id)
+}
+
+// IsInUse mocks base method.
+func (m *MockVenueRepository) IsInUse(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInUse", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsInUse indicates an expected call of IsInUse.
+func (mr *MockVenueRepositoryMockRecorder) IsInUse(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInUse", reflect.TypeOf((*MockVenueRepository)(nil).IsInUse), ctx, id)
+}
+
+// Merge mocks base method.
+func (m *MockVenueRepository) Merge(ctx context.Context, keepID, duplicateID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, keepID, duplicateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockVenueRepositoryMockRecorder) Merge(ctx, keepID, duplicateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockVenueRepository)(nil).Merge), ctx, keepID, duplicateID)
+}
+
+// SoftDelete mocks base method.
+func (m *MockVenueRepository) SoftDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockVenueRepositoryMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockVenueRepository)(nil).SoftDelete), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockVenueRepository) Update(ctx context.Context, venue *domain.Venue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, venue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVenueRepositoryMockRecorder) Update(ctx, venue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVenueRepository)(nil).Update), ctx, venue)
+}
+
+// MockMatchVenueRepository is a mock of MatchVenueRepository interface.
+type MockMatchVenueRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMatchVenueRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMatchVenueRepositoryMockRecorder is the mock recorder for MockMatchVenueRepository.
+type MockMatchVenueRepositoryMockRecorder struct {
+	mock *MockMatchVenueRepository
+}
+
+// NewMockMatchVenueRepository creates a new mock instance.
+func NewMockMatchVenueRepository(ctrl *gomock.Controller) *MockMatchVenueRepository {
+	mock := &MockMatchVenueRepository{ctrl: ctrl}
+	mock.recorder = &MockMatchVenueRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMatchVenueRepository) EXPECT() *MockMatchVenueRepositoryMockRecorder {
+	return m.recorder
+}
+
+// MoveMatches mocks base method.
+func (m *MockMatchVenueRepository) MoveMatches(ctx context.Context, fromID, toID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMatches", ctx, fromID, toID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMatches indicates an expected call of MoveMatches.
+func (mr *MockMatchVenueRepositoryMockRecorder) MoveMatches(ctx, fromID, toID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMatches", reflect.TypeOf((*MockMatchVenueRepository)(nil).MoveMatches), ctx, fromID, toID)
+}
+
 // MockPlayerRepository is a mock of PlayerRepository interface.
 type MockPlayerRepository struct {
 	ctrl     *gomock.Controller
@@ -153,17 +332,32 @@ func (m *MockPlayerRepository) EXPECT() *MockPlayerRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockPlayerRepository) Create(ctx context.Context, player *domain.Player) error {
+func (m *MockPlayerRepository) Create(ctx context.Context, player *domain.Player, spell *domain.Spell, overrides []domain.RegistrationOverride) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, player)
+	ret := m.ctrl.Call(m, "Create", ctx, player, spell, overrides)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockPlayerRepositoryMockRecorder) Create(ctx, player any) *gomock.Call {
+func (mr *MockPlayerRepositoryMockRecorder) Create(ctx, player, spell, overrides any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPlayerRepository)(nil).Create), ctx, player)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPlayerRepository)(nil).Create), ctx, player, spell, overrides)
+}
+
+// FindAll mocks base method.
+func (m *MockPlayerRepository) FindAll(ctx context.Context, filter domain.PlayerFilter) ([]domain.Player, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.Player)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPlayerRepositoryMockRecorder) FindAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPlayerRepository)(nil).FindAll), ctx, filter)
 }
 
 // FindByID mocks base method.
@@ -181,19 +375,34 @@ func (mr *MockPlayerRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPlayerRepository)(nil).FindByID), ctx, id)
 }
 
-// FindByTeamID mocks base method.
-func (m *MockPlayerRepository) FindByTeamID(ctx context.Context, teamID string) ([]domain.Player, error) {
+// FindCurrentSpell mocks base method.
+func (m *MockPlayerRepository) FindCurrentSpell(ctx context.Context, playerID string) (*domain.Spell, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByTeamID", ctx, teamID)
-	ret0, _ := ret[0].([]domain.Player)
+	ret := m.ctrl.Call(m, "FindCurrentSpell", ctx, playerID)
+	ret0, _ := ret[0].(*domain.Spell)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByTeamID indicates an expected call of FindByTeamID.
-func (mr *MockPlayerRepositoryMockRecorder) FindByTeamID(ctx, teamID any) *gomock.Call {
+// FindCurrentSpell indicates an expected call of FindCurrentSpell.
+func (mr *MockPlayerRepositoryMockRecorder) FindCurrentSpell(ctx, playerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTeamID", reflect.TypeOf((*MockPlayerRepository)(nil).FindByTeamID), ctx, teamID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCurrentSpell", reflect.TypeOf((*MockPlayerRepository)(nil).FindCurrentSpell), ctx, playerID)
+}
+
+// FindSpells mocks base method.
+func (m *MockPlayerRepository) FindSpells(ctx context.Context, playerID string) ([]domain.Spell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSpells", ctx, playerID)
+	ret0, _ := ret[0].([]domain.Spell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSpells indicates an expected call of FindSpells.
+func (mr *MockPlayerRepositoryMockRecorder) FindSpells(ctx, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSpells", reflect.TypeOf((*MockPlayerRepository)(nil).FindSpells), ctx, playerID)
 }
 
 // IsJerseyNumberTaken mocks base method.
@@ -225,6 +434,20 @@ func (mr *MockPlayerRepositoryMockRecorder) SoftDelete(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockPlayerRepository)(nil).SoftDelete), ctx, id)
 }
 
+// Transfer mocks base method.
+func (m *MockPlayerRepository) Transfer(ctx context.Context, player *domain.Player, closed, opened *domain.Spell, overrides []domain.RegistrationOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, player, closed, opened, overrides)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockPlayerRepositoryMockRecorder) Transfer(ctx, player, closed, opened, overrides any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockPlayerRepository)(nil).Transfer), ctx, player, closed, opened, overrides)
+}
+
 // Update mocks base method.
 func (m *MockPlayerRepository) Update(ctx context.Context, player *domain.Player) error {
 	m.ctrl.T.Helper()
@@ -238,3 +461,198 @@ func (mr *MockPlayerRepositoryMockRecorder) Update(ctx, player any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPlayerRepository)(nil).Update), ctx, player)
 }
+
+// MockStaffRepository is a mock of StaffRepository interface.
+type MockStaffRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStaffRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStaffRepositoryMockRecorder is the mock recorder for MockStaffRepository.
+type MockStaffRepositoryMockRecorder struct {
+	mock *MockStaffRepository
+}
+
+// NewMockStaffRepository creates a new mock instance.
+func NewMockStaffRepository(ctrl *gomock.Controller) *MockStaffRepository {
+	mock := &MockStaffRepository{ctrl: ctrl}
+	mock.recorder = &MockStaffRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStaffRepository) EXPECT() *MockStaffRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStaffRepository) Create(ctx context.Context, staff *domain.Staff, opened *domain.Tenure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, staff, opened)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStaffRepositoryMockRecorder) Create(ctx, staff, opened any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStaffRepository)(nil).Create), ctx, staff, opened)
+}
+
+// FindByID mocks base method.
+func (m *MockStaffRepository) FindByID(ctx context.Context, id string) (*domain.Staff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Staff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockStaffRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockStaffRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByTeamID mocks base method.
+func (m *MockStaffRepository) FindByTeamID(ctx context.Context, teamID string) ([]domain.Staff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTeamID", ctx, teamID)
+	ret0, _ := ret[0].([]domain.Staff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTeamID indicates an expected call of FindByTeamID.
+func (mr *MockStaffRepositoryMockRecorder) FindByTeamID(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTeamID", reflect.TypeOf((*MockStaffRepository)(nil).FindByTeamID), ctx, teamID)
+}
+
+// FindLatestTenure mocks base method.
+func (m *MockStaffRepository) FindLatestTenure(ctx context.Context, teamID string) (*domain.Tenure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestTenure", ctx, teamID)
+	ret0, _ := ret[0].(*domain.Tenure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestTenure indicates an expected call of FindLatestTenure.
+func (mr *MockStaffRepositoryMockRecorder) FindLatestTenure(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestTenure", reflect.TypeOf((*MockStaffRepository)(nil).FindLatestTenure), ctx, teamID)
+}
+
+// FindTenures mocks base method.
+func (m *MockStaffRepository) FindTenures(ctx context.Context, teamID string) ([]domain.Tenure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTenures", ctx, teamID)
+	ret0, _ := ret[0].([]domain.Tenure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTenures indicates an expected call of FindTenures.
+func (mr *MockStaffRepositoryMockRecorder) FindTenures(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTenures", reflect.TypeOf((*MockStaffRepository)(nil).FindTenures), ctx, teamID)
+}
+
+// SoftDelete mocks base method.
+func (m *MockStaffRepository) SoftDelete(ctx context.Context, id string, closed *domain.Tenure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id, closed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockStaffRepositoryMockRecorder) SoftDelete(ctx, id, closed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockStaffRepository)(nil).SoftDelete), ctx, id, closed)
+}
+
+// Update mocks base method.
+func (m *MockStaffRepository) Update(ctx context.Context, staff *domain.Staff, changed, opened *domain.Tenure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, staff, changed, opened)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStaffRepositoryMockRecorder) Update(ctx, staff, changed, opened any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStaffRepository)(nil).Update), ctx, staff, changed, opened)
+}
+
+// MockRegistrationRepository is a mock of RegistrationRepository interface.
+type MockRegistrationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistrationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRegistrationRepositoryMockRecorder is the mock recorder for MockRegistrationRepository.
+type MockRegistrationRepositoryMockRecorder struct {
+	mock *MockRegistrationRepository
+}
+
+// NewMockRegistrationRepository creates a new mock instance.
+func NewMockRegistrationRepository(ctrl *gomock.Controller) *MockRegistrationRepository {
+	mock := &MockRegistrationRepository{ctrl: ctrl}
+	mock.recorder = &MockRegistrationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistrationRepository) EXPECT() *MockRegistrationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountSquad mocks base method.
+func (m *MockRegistrationRepository) CountSquad(ctx context.Context, teamID, homeNationality string) (domain.Squad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSquad", ctx, teamID, homeNationality)
+	ret0, _ := ret[0].(domain.Squad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSquad indicates an expected call of CountSquad.
+func (mr *MockRegistrationRepositoryMockRecorder) CountSquad(ctx, teamID, homeNationality any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSquad", reflect.TypeOf((*MockRegistrationRepository)(nil).CountSquad), ctx, teamID, homeNationality)
+}
+
+// FindOverrides mocks base method.
+func (m *MockRegistrationRepository) FindOverrides(ctx context.Context, teamID string) ([]domain.RegistrationOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverrides", ctx, teamID)
+	ret0, _ := ret[0].([]domain.RegistrationOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverrides indicates an expected call of FindOverrides.
+func (mr *MockRegistrationRepositoryMockRecorder) FindOverrides(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverrides", reflect.TypeOf((*MockRegistrationRepository)(nil).FindOverrides), ctx, teamID)
+}
+
+// FindSeasonRules mocks base method.
+func (m *MockRegistrationRepository) FindSeasonRules(ctx context.Context, teamID string, on time.Time) ([]domain.SeasonRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSeasonRules", ctx, teamID, on)
+	ret0, _ := ret[0].([]domain.SeasonRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSeasonRules indicates an expected call of FindSeasonRules.
+func (mr *MockRegistrationRepositoryMockRecorder) FindSeasonRules(ctx, teamID, on any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSeasonRules", reflect.TypeOf((*MockRegistrationRepository)(nil).FindSeasonRules), ctx, teamID, on)
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+type CompetitionService struct {
+	competitionRepo domain.CompetitionRepository
+}
+
+func NewCompetitionService(competitionRepo domain.CompetitionRepository) CompetitionServicePort {
+	return &CompetitionService{competitionRepo: competitionRepo}
+}
+
+func (s *CompetitionService) Create(ctx context.Context, competition *domain.Competition) (string, error) {
+	newCompetition, err := domain.NewCompetition(competition.Name, competition.Description, competition.Format, competition.Rules, competition.Discipline, competition.AgeLimit)
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := s.competitionRepo.ExistsByName(ctx, newCompetition.Name, "")
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", derrors.WrapErrorf(domain.ErrCompetitionAlreadyExists, derrors.ErrorCodeDuplicate, "competition name %q is already taken", newCompetition.Name)
+	}
+
+	if err := s.competitionRepo.Create(ctx, newCompetition); err != nil {
+		return "", err
+	}
+
+	return newCompetition.ID, nil
+}
+
+func (s *CompetitionService) GetByID(ctx context.Context, id string) (*domain.Competition, error) {
+	competition, err := s.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return competition, nil
+}
+
+func (s *CompetitionService) GetAll(ctx context.Context) ([]domain.Competition, error) {
+	competitions, err := s.competitionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return competitions, nil
+}
+
+func (s *CompetitionService) Update(ctx context.Context, id string, competition *domain.Competition) error {
+	existing, err := s.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := existing.Update(competition.Name, competition.Description); err != nil {
+		return err
+	}
+	// Discipline rules are only replaced when the update carries them
+	if competition.Discipline != (domain.DisciplineRules{}) {
+		if err := existing.SetDiscipline(competition.Discipline); err != nil {
+			return err
+		}
+	}
+	// Likewise the age limit, where a zero limit lifts it
+	if competition.AgeLimit != nil {
+		if err := existing.SetAgeLimit(*competition.AgeLimit); err != nil {
+			return err
+		}
+	}
+
+	exists, err := s.competitionRepo.ExistsByName(ctx, existing.Name, id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return derrors.WrapErrorf(domain.ErrCompetitionAlreadyExists, derrors.ErrorCodeDuplicate, "competition name %q is already taken", existing.Name)
+	}
+
+	if err := s.competitionRepo.Update(ctx, existing); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *CompetitionService) Delete(ctx context.Context, id string) error {
+	_, err := s.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.competitionRepo.SoftDelete(ctx, id); err != nil {
+		return err
+	}
+
+	return nil
+}
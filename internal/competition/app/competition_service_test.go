@@ -0,0 +1,315 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/mock"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"go.uber.org/mock/gomock"
+)
+
+func setupCompetitionService(t *testing.T) (*CompetitionService, *mockDomain.MockCompetitionRepository) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockRepo := mockDomain.NewMockCompetitionRepository(ctrl)
+	svc := &CompetitionService{competitionRepo: mockRepo}
+	return svc, mockRepo
+}
+
+// assertErrorCode verifies the error is a *derrors.Error with the expected code.
+func assertErrorCode(t *testing.T, err error, expectedCode derrors.ErrorCode) {
+	t.Helper()
+	var dErr *derrors.Error
+	if !errors.As(err, &dErr) {
+		t.Fatalf("expected *derrors.Error, got %T: %v", err, err)
+	}
+	if dErr.Code() != expectedCode {
+		t.Fatalf("expected error code %d, got %d", expectedCode, dErr.Code())
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Create
+// ---------------------------------------------------------------------------
+
+func TestCompetitionService_Create_Success(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+	input := &domain.Competition{Name: "Liga 1", Description: "Indonesian top flight"}
+
+	mockRepo.EXPECT().ExistsByName(ctx, "Liga 1", "").Return(false, nil)
+	mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	// When
+	id, err := svc.Create(ctx, input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if id == "" {
+		t.Fatal("expected non-empty ID, got empty string")
+	}
+}
+
+func TestCompetitionService_Create_ValidationError_EmptyName(t *testing.T) {
+	// Given
+	svc, _ := setupCompetitionService(t)
+	ctx := context.Background()
+
+	// When
+	id, err := svc.Create(ctx, &domain.Competition{Name: "  "})
+
+	// Then
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
+func TestCompetitionService_Create_DuplicateName(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().ExistsByName(ctx, "Liga 1", "").Return(true, nil)
+
+	// When
+	_, err := svc.Create(ctx, &domain.Competition{Name: "Liga 1"})
+
+	// Then
+	if !errors.Is(err, domain.ErrCompetitionAlreadyExists) {
+		t.Fatalf("expected ErrCompetitionAlreadyExists, got: %v", err)
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeDuplicate)
+}
+
+func TestCompetitionService_Create_KnockoutWithRules(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+	input := &domain.Competition{
+		Name:   "Piala Indonesia",
+		Format: domain.FormatKnockout,
+		Rules:  domain.KnockoutRules{TwoLegged: true, AwayGoalsRule: true},
+	}
+
+	var saved *domain.Competition
+	mockRepo.EXPECT().ExistsByName(ctx, "Piala Indonesia", "").Return(false, nil)
+	mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c *domain.Competition) error {
+		saved = c
+		return nil
+	})
+
+	// When
+	_, err := svc.Create(ctx, input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if saved.Format != domain.FormatKnockout || !saved.Rules.TwoLegged || !saved.Rules.AwayGoalsRule {
+		t.Fatalf("expected two-legged knockout with away goals, got %+v", saved)
+	}
+}
+
+func TestCompetitionService_Create_DefaultsToLeague(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+
+	var saved *domain.Competition
+	mockRepo.EXPECT().ExistsByName(ctx, "Liga 1", "").Return(false, nil)
+	mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c *domain.Competition) error {
+		saved = c
+		return nil
+	})
+
+	// When
+	_, err := svc.Create(ctx, &domain.Competition{Name: "Liga 1"})
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if saved.Format != domain.FormatLeague {
+		t.Fatalf("expected league format, got %q", saved.Format)
+	}
+	if saved.Discipline != domain.DefaultDisciplineRules() {
+		t.Fatalf("expected default discipline rules, got %+v", saved.Discipline)
+	}
+}
+
+func TestCompetitionService_Create_ValidationError_YellowCardLimit(t *testing.T) {
+	// Given
+	svc, _ := setupCompetitionService(t)
+	ctx := context.Background()
+	input := &domain.Competition{Name: "Liga 1", Discipline: domain.DisciplineRules{RedCardBan: 1, YellowCardBan: 1}}
+
+	// When
+	_, err := svc.Create(ctx, input)
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestCompetitionService_Create_ValidationError_UnknownFormat(t *testing.T) {
+	// Given
+	svc, _ := setupCompetitionService(t)
+	ctx := context.Background()
+
+	// When
+	_, err := svc.Create(ctx, &domain.Competition{Name: "Cup", Format: "groups"})
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestCompetitionService_Create_ValidationError_AwayGoalsWithoutTwoLegs(t *testing.T) {
+	// Given
+	svc, _ := setupCompetitionService(t)
+	ctx := context.Background()
+	input := &domain.Competition{Name: "Cup", Format: domain.FormatKnockout, Rules: domain.KnockoutRules{AwayGoalsRule: true}}
+
+	// When
+	_, err := svc.Create(ctx, input)
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestCompetitionService_Create_ValidationError_AgeLimitWithoutCutoff(t *testing.T) {
+	// Given
+	svc, _ := setupCompetitionService(t)
+	ctx := context.Background()
+	input := &domain.Competition{Name: "Liga U-17", AgeLimit: &domain.AgeLimit{MaxAge: 16}}
+
+	// When
+	_, err := svc.Create(ctx, input)
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+// ---------------------------------------------------------------------------
+// Update
+// ---------------------------------------------------------------------------
+
+func TestCompetitionService_Update_Success(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+	existing := &domain.Competition{ID: "comp-1", Name: "Liga 1"}
+
+	mockRepo.EXPECT().FindByID(ctx, "comp-1").Return(existing, nil)
+	mockRepo.EXPECT().ExistsByName(ctx, "Liga 1 Indonesia", "comp-1").Return(false, nil)
+	mockRepo.EXPECT().Update(ctx, existing).Return(nil)
+
+	// When
+	err := svc.Update(ctx, "comp-1", &domain.Competition{Name: "Liga 1 Indonesia"})
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if existing.Name != "Liga 1 Indonesia" {
+		t.Fatalf("expected name to be updated, got %q", existing.Name)
+	}
+}
+
+func TestCompetitionService_Update_Discipline(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+	existing := &domain.Competition{ID: "comp-1", Name: "Liga 1", Discipline: domain.DefaultDisciplineRules()}
+	rules := domain.DisciplineRules{RedCardBan: 2, YellowCardLimit: 3, YellowCardBan: 1}
+
+	mockRepo.EXPECT().FindByID(ctx, "comp-1").Return(existing, nil)
+	mockRepo.EXPECT().ExistsByName(ctx, "Liga 1", "comp-1").Return(false, nil)
+	mockRepo.EXPECT().Update(ctx, existing).Return(nil)
+
+	// When
+	err := svc.Update(ctx, "comp-1", &domain.Competition{Name: "Liga 1", Discipline: rules})
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if existing.Discipline != rules {
+		t.Fatalf("expected discipline rules to be updated, got %+v", existing.Discipline)
+	}
+}
+
+func TestCompetitionService_Update_AgeLimit(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+	limit := domain.AgeLimit{MaxAge: 19, CutoffDate: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)}
+	existing := &domain.Competition{ID: "comp-1", Name: "Liga U-20", AgeLimit: &domain.AgeLimit{MaxAge: 19, CutoffDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}}
+
+	mockRepo.EXPECT().FindByID(ctx, "comp-1").Return(existing, nil).Times(3)
+	mockRepo.EXPECT().ExistsByName(ctx, "Liga U-20", "comp-1").Return(false, nil).Times(3)
+	mockRepo.EXPECT().Update(ctx, existing).Return(nil).Times(3)
+
+	// When
+	movedErr := svc.Update(ctx, "comp-1", &domain.Competition{Name: "Liga U-20", AgeLimit: &limit})
+	moved := *existing.AgeLimit
+	keptErr := svc.Update(ctx, "comp-1", &domain.Competition{Name: "Liga U-20"})
+	kept := *existing.AgeLimit
+	liftedErr := svc.Update(ctx, "comp-1", &domain.Competition{Name: "Liga U-20", AgeLimit: &domain.AgeLimit{}})
+
+	// Then
+	if err := errors.Join(movedErr, keptErr, liftedErr); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if moved != limit || kept != limit {
+		t.Fatalf("expected the new cutoff date to be set and then kept, got %+v and %+v", moved, kept)
+	}
+	if existing.AgeLimit != nil {
+		t.Fatalf("expected an empty age limit to lift it, got %+v", existing.AgeLimit)
+	}
+}
+
+func TestCompetitionService_Update_NotFound(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+	notFound := derrors.WrapErrorf(domain.ErrCompetitionNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrCompetitionNotFound.Error())
+
+	mockRepo.EXPECT().FindByID(ctx, "missing").Return(nil, notFound)
+
+	// When
+	err := svc.Update(ctx, "missing", &domain.Competition{Name: "Liga 1"})
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+// ---------------------------------------------------------------------------
+// Delete
+// ---------------------------------------------------------------------------
+
+func TestCompetitionService_Delete_Success(t *testing.T) {
+	// Given
+	svc, mockRepo := setupCompetitionService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindByID(ctx, "comp-1").Return(&domain.Competition{ID: "comp-1"}, nil)
+	mockRepo.EXPECT().SoftDelete(ctx, "comp-1").Return(nil)
+
+	// When
+	err := svc.Delete(ctx, "comp-1")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	"context"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+)
+
+//go:generate mockgen -source=port.go -destination=mock/mock_service.go -package=mock
+
+// CompetitionServicePort defines the contract for competition business operations.
+type CompetitionServicePort interface {
+	Create(ctx context.Context, competition *domain.Competition) (string, error)
+	GetByID(ctx context.Context, id string) (*domain.Competition, error)
+	GetAll(ctx context.Context) ([]domain.Competition, error)
+	Update(ctx context.Context, id string, competition *domain.Competition) error
+	Delete(ctx context.Context, id string) error
+}
+
+// SeasonServicePort defines the contract for season business operations.
+type SeasonServicePort interface {
+	Create(ctx context.Context, season *domain.Season) (string, error)
+	GetByID(ctx context.Context, id string) (*domain.Season, error)
+	GetByCompetitionID(ctx context.Context, competitionID string) ([]domain.Season, error)
+	Update(ctx context.Context, id string, season *domain.Season) error
+	Delete(ctx context.Context, id string) error
+	RegisterTeam(ctx context.Context, seasonID, teamID string) error
+	UnregisterTeam(ctx context.Context, seasonID, teamID string) error
+	GetTeams(ctx context.Context, seasonID string) ([]domain.SeasonTeam, error)
+	GetRegistrationRules(ctx context.Context, seasonID string) (*domain.RegistrationRules, error)
+	SetRegistrationRules(ctx context.Context, seasonID string, rules domain.RegistrationRules) (*domain.RegistrationRules, error)
+	GetEligibility(ctx context.Context, seasonID string) (*domain.EligibilityReport, error)
+}
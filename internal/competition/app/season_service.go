@@ -0,0 +1,226 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+type SeasonService struct {
+	seasonRepo      domain.SeasonRepository
+	competitionRepo domain.CompetitionRepository
+	teamRepo        domain.TeamRepository
+}
+
+func NewSeasonService(
+	seasonRepo domain.SeasonRepository,
+	competitionRepo domain.CompetitionRepository,
+	teamRepo domain.TeamRepository,
+) SeasonServicePort {
+	return &SeasonService{
+		seasonRepo:      seasonRepo,
+		competitionRepo: competitionRepo,
+		teamRepo:        teamRepo,
+	}
+}
+
+func (s *SeasonService) Create(ctx context.Context, season *domain.Season) (string, error) {
+	// Verify competition exists
+	_, err := s.competitionRepo.FindByID(ctx, season.CompetitionID)
+	if err != nil {
+		return "", err
+	}
+
+	newSeason, err := domain.NewSeason(season.CompetitionID, season.Name, season.StartDate, season.EndDate)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.seasonRepo.Create(ctx, newSeason); err != nil {
+		return "", err
+	}
+
+	return newSeason.ID, nil
+}
+
+func (s *SeasonService) GetByID(ctx context.Context, id string) (*domain.Season, error) {
+	season, err := s.seasonRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+func (s *SeasonService) GetByCompetitionID(ctx context.Context, competitionID string) ([]domain.Season, error) {
+	_, err := s.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.seasonRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, err
+	}
+	return seasons, nil
+}
+
+func (s *SeasonService) Update(ctx context.Context, id string, season *domain.Season) error {
+	existing, err := s.seasonRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := existing.Update(season.Name, season.StartDate, season.EndDate); err != nil {
+		return err
+	}
+
+	if err := s.seasonRepo.Update(ctx, existing); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SeasonService) Delete(ctx context.Context, id string) error {
+	_, err := s.seasonRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// A season that already has fixtures would orphan its matches and standings
+	hasMatches, err := s.seasonRepo.HasMatches(ctx, id)
+	if err != nil {
+		return err
+	}
+	if hasMatches {
+		return derrors.WrapErrorf(domain.ErrSeasonHasMatches, derrors.ErrorCodeBadRequest, "%s", domain.ErrSeasonHasMatches.Error())
+	}
+
+	if err := s.seasonRepo.SoftDelete(ctx, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SeasonService) RegisterTeam(ctx context.Context, seasonID, teamID string) error {
+	_, err := s.seasonRepo.FindByID(ctx, seasonID)
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.teamRepo.ExistsByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return derrors.WrapErrorf(domain.ErrTeamNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrTeamNotFound.Error())
+	}
+
+	registered, err := s.seasonRepo.IsTeamRegistered(ctx, seasonID, teamID)
+	if err != nil {
+		return err
+	}
+	if registered {
+		return derrors.WrapErrorf(domain.ErrTeamAlreadyRegistered, derrors.ErrorCodeDuplicate, "%s", domain.ErrTeamAlreadyRegistered.Error())
+	}
+
+	return s.seasonRepo.RegisterTeam(ctx, &domain.SeasonTeam{
+		SeasonID:     seasonID,
+		TeamID:       teamID,
+		RegisteredAt: time.Now(),
+	})
+}
+
+func (s *SeasonService) UnregisterTeam(ctx context.Context, seasonID, teamID string) error {
+	registered, err := s.seasonRepo.IsTeamRegistered(ctx, seasonID, teamID)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		return derrors.WrapErrorf(domain.ErrTeamNotRegistered, derrors.ErrorCodeNotFound, "%s", domain.ErrTeamNotRegistered.Error())
+	}
+
+	hasMatches, err := s.seasonRepo.TeamHasMatches(ctx, seasonID, teamID)
+	if err != nil {
+		return err
+	}
+	if hasMatches {
+		return derrors.WrapErrorf(domain.ErrTeamHasMatchesInSeason, derrors.ErrorCodeBadRequest, "%s", domain.ErrTeamHasMatchesInSeason.Error())
+	}
+
+	return s.seasonRepo.UnregisterTeam(ctx, seasonID, teamID)
+}
+
+func (s *SeasonService) GetTeams(ctx context.Context, seasonID string) ([]domain.SeasonTeam, error) {
+	_, err := s.seasonRepo.FindByID(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.seasonRepo.FindTeams(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (s *SeasonService) GetRegistrationRules(ctx context.Context, seasonID string) (*domain.RegistrationRules, error) {
+	return s.seasonRepo.FindRegistrationRules(ctx, seasonID)
+}
+
+// SetRegistrationRules replaces the windows, squad size limit and foreign player quota of a season.
+// Players already registered are not affected; the rules apply to registrations and transfers made afterwards.
+func (s *SeasonService) SetRegistrationRules(ctx context.Context, seasonID string, rules domain.RegistrationRules) (*domain.RegistrationRules, error) {
+	season, err := s.seasonRepo.FindByID(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err = season.SetRegistrationRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.seasonRepo.SaveRegistrationRules(ctx, seasonID, rules); err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// GetEligibility checks the squads of the teams in an age-group season against the age limit of its
+// competition. Before the season starts the squads are taken as they will stand on its opening day,
+// so signings already agreed for then are included; afterwards they are taken as they stand today.
+func (s *SeasonService) GetEligibility(ctx context.Context, seasonID string) (*domain.EligibilityReport, error) {
+	season, err := s.seasonRepo.FindByID(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	competition, err := s.competitionRepo.FindByID(ctx, season.CompetitionID)
+	if err != nil {
+		return nil, err
+	}
+	if competition.AgeLimit == nil {
+		return nil, derrors.WrapErrorf(domain.ErrNoAgeLimit, derrors.ErrorCodeBadRequest, "%s", domain.ErrNoAgeLimit.Error())
+	}
+
+	on := time.Now()
+	if season.StartDate.After(on) {
+		on = season.StartDate
+	}
+
+	teams, err := s.seasonRepo.FindTeams(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	players, err := s.seasonRepo.FindSquadPlayers(ctx, seasonID, on)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewEligibilityReport(seasonID, *competition.AgeLimit, on, teams, players), nil
+}
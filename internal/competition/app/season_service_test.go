@@ -0,0 +1,328 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/mock"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSeasonService(t *testing.T) (
+	*SeasonService,
+	*mockDomain.MockSeasonRepository,
+	*mockDomain.MockCompetitionRepository,
+	*mockDomain.MockTeamRepository,
+) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockSeasonRepo := mockDomain.NewMockSeasonRepository(ctrl)
+	mockCompetitionRepo := mockDomain.NewMockCompetitionRepository(ctrl)
+	mockTeamRepo := mockDomain.NewMockTeamRepository(ctrl)
+	svc := &SeasonService{
+		seasonRepo:      mockSeasonRepo,
+		competitionRepo: mockCompetitionRepo,
+		teamRepo:        mockTeamRepo,
+	}
+	return svc, mockSeasonRepo, mockCompetitionRepo, mockTeamRepo
+}
+
+// ---------------------------------------------------------------------------
+// Create
+// ---------------------------------------------------------------------------
+
+func TestSeasonService_Create_Success(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, mockCompetitionRepo, _ := setupSeasonService(t)
+	ctx := context.Background()
+	input := &domain.Season{
+		CompetitionID: "comp-1",
+		Name:          "2026/2027",
+		StartDate:     time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:       time.Date(2027, 5, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockCompetitionRepo.EXPECT().FindByID(ctx, "comp-1").Return(&domain.Competition{ID: "comp-1"}, nil)
+	mockSeasonRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	// When
+	id, err := svc.Create(ctx, input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if id == "" {
+		t.Fatal("expected non-empty ID, got empty string")
+	}
+}
+
+func TestSeasonService_Create_EndBeforeStart(t *testing.T) {
+	// Given
+	svc, _, mockCompetitionRepo, _ := setupSeasonService(t)
+	ctx := context.Background()
+	input := &domain.Season{
+		CompetitionID: "comp-1",
+		Name:          "2026/2027",
+		StartDate:     time.Date(2027, 5, 31, 0, 0, 0, 0, time.UTC),
+		EndDate:       time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockCompetitionRepo.EXPECT().FindByID(ctx, "comp-1").Return(&domain.Competition{ID: "comp-1"}, nil)
+
+	// When
+	_, err := svc.Create(ctx, input)
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+// ---------------------------------------------------------------------------
+// Delete
+// ---------------------------------------------------------------------------
+
+func TestSeasonService_Delete_HasMatches(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, _ := setupSeasonService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(&domain.Season{ID: "season-1"}, nil)
+	mockSeasonRepo.EXPECT().HasMatches(ctx, "season-1").Return(true, nil)
+
+	// When
+	err := svc.Delete(ctx, "season-1")
+
+	// Then
+	if !errors.Is(err, domain.ErrSeasonHasMatches) {
+		t.Fatalf("expected ErrSeasonHasMatches, got: %v", err)
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+// ---------------------------------------------------------------------------
+// RegisterTeam / UnregisterTeam
+// ---------------------------------------------------------------------------
+
+func TestSeasonService_RegisterTeam_Success(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, mockTeamRepo := setupSeasonService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(&domain.Season{ID: "season-1"}, nil)
+	mockTeamRepo.EXPECT().ExistsByID(ctx, "team-1").Return(true, nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", "team-1").Return(false, nil)
+	mockSeasonRepo.EXPECT().RegisterTeam(ctx, gomock.Any()).Return(nil)
+
+	// When
+	err := svc.RegisterTeam(ctx, "season-1", "team-1")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestSeasonService_RegisterTeam_TeamNotFound(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, mockTeamRepo := setupSeasonService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(&domain.Season{ID: "season-1"}, nil)
+	mockTeamRepo.EXPECT().ExistsByID(ctx, "team-x").Return(false, nil)
+
+	// When
+	err := svc.RegisterTeam(ctx, "season-1", "team-x")
+
+	// Then
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got: %v", err)
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+func TestSeasonService_RegisterTeam_AlreadyRegistered(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, mockTeamRepo := setupSeasonService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(&domain.Season{ID: "season-1"}, nil)
+	mockTeamRepo.EXPECT().ExistsByID(ctx, "team-1").Return(true, nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", "team-1").Return(true, nil)
+
+	// When
+	err := svc.RegisterTeam(ctx, "season-1", "team-1")
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeDuplicate)
+}
+
+func TestSeasonService_UnregisterTeam_HasMatches(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, _ := setupSeasonService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", "team-1").Return(true, nil)
+	mockSeasonRepo.EXPECT().TeamHasMatches(ctx, "season-1", "team-1").Return(true, nil)
+
+	// When
+	err := svc.UnregisterTeam(ctx, "season-1", "team-1")
+
+	// Then
+	if !errors.Is(err, domain.ErrTeamHasMatchesInSeason) {
+		t.Fatalf("expected ErrTeamHasMatchesInSeason, got: %v", err)
+	}
+}
+
+// registrationSeason runs through the 2026 calendar year.
+func registrationSeason() *domain.Season {
+	return &domain.Season{
+		ID:        "season-1",
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestSeasonService_SetRegistrationRules_Success(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, _ := setupSeasonService(t)
+	ctx := context.Background()
+	maxSquad, maxForeign := 30, 5
+	rules := domain.RegistrationRules{
+		Windows: []domain.RegistrationWindow{
+			{OpensOn: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), ClosesOn: time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC)},
+			{OpensOn: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), ClosesOn: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)},
+		},
+		MaxSquadSize:      &maxSquad,
+		MaxForeignPlayers: &maxForeign,
+		HomeNationality:   " id ",
+	}
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(registrationSeason(), nil)
+	mockSeasonRepo.EXPECT().SaveRegistrationRules(ctx, "season-1", gomock.Any()).Return(nil)
+
+	// When
+	saved, err := svc.SetRegistrationRules(ctx, "season-1", rules)
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if saved.HomeNationality != "ID" {
+		t.Fatalf("expected home nationality ID, got %q", saved.HomeNationality)
+	}
+	if !saved.Windows[0].OpensOn.Before(saved.Windows[1].OpensOn) {
+		t.Fatalf("expected windows sorted by opening date, got %+v", saved.Windows)
+	}
+}
+
+func TestSeasonService_SetRegistrationRules_OverlappingWindows(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, _ := setupSeasonService(t)
+	ctx := context.Background()
+	rules := domain.RegistrationRules{
+		Windows: []domain.RegistrationWindow{
+			{OpensOn: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), ClosesOn: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)},
+			{OpensOn: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), ClosesOn: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(registrationSeason(), nil)
+
+	// When
+	_, err := svc.SetRegistrationRules(ctx, "season-1", rules)
+
+	// Then
+	if !errors.Is(err, domain.ErrInvalidRegistrationWindows) {
+		t.Fatalf("expected ErrInvalidRegistrationWindows, got: %v", err)
+	}
+	var dErr *derrors.Error
+	if !errors.As(err, &dErr) || len(dErr.Details()) != 1 || dErr.Details()[0].Field != "windows[1]" {
+		t.Fatalf("expected a detail on windows[1], got: %v", err)
+	}
+}
+
+func TestSeasonService_SetRegistrationRules_ForeignQuotaAboveSquadSize(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, _, _ := setupSeasonService(t)
+	ctx := context.Background()
+	maxSquad, maxForeign := 5, 6
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(registrationSeason(), nil)
+
+	// When
+	_, err := svc.SetRegistrationRules(ctx, "season-1", domain.RegistrationRules{MaxSquadSize: &maxSquad, MaxForeignPlayers: &maxForeign})
+
+	// Then
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+// ---------------------------------------------------------------------------
+// Eligibility
+// ---------------------------------------------------------------------------
+
+func TestSeasonService_GetEligibility_ChecksEachSquad(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, mockCompetitionRepo, _ := setupSeasonService(t)
+	ctx := context.Background()
+	season := &domain.Season{ID: "season-1", CompetitionID: "u17", StartDate: time.Now().AddDate(0, 1, 0), EndDate: time.Now().AddDate(0, 10, 0)}
+	cutoff := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	tooOld := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)     // 17 on the cutoff date
+	justInTime := time.Date(2010, 1, 2, 0, 0, 0, 0, time.UTC) // Turns 17 the day after
+	teams := []domain.SeasonTeam{{TeamID: "team-1", TeamName: "Garuda Muda"}, {TeamID: "team-2", TeamName: "Persija U-17"}}
+	players := []domain.SquadPlayer{
+		{ID: "player-1", Name: "Andi", TeamID: "team-1", DateOfBirth: &justInTime},
+		{ID: "player-2", Name: "Budi", TeamID: "team-1", DateOfBirth: &tooOld},
+		{ID: "player-3", Name: "Cahya", TeamID: "team-1"},
+	}
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(season, nil)
+	mockCompetitionRepo.EXPECT().FindByID(ctx, "u17").Return(&domain.Competition{ID: "u17", AgeLimit: &domain.AgeLimit{MaxAge: 16, CutoffDate: cutoff}}, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(teams, nil)
+	mockSeasonRepo.EXPECT().FindSquadPlayers(ctx, "season-1", season.StartDate).Return(players, nil)
+
+	// When
+	report, err := svc.GetEligibility(ctx, "season-1")
+
+	// Then
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(report.Teams) != 2 || len(report.Teams[1].Players) != 0 {
+		t.Fatalf("expected both teams, the second without players, got %+v", report.Teams)
+	}
+	squad := report.Teams[0]
+	if squad.Ineligible != 2 {
+		t.Fatalf("expected 2 ineligible players, got %d", squad.Ineligible)
+	}
+	if p := squad.Players[0]; !p.Eligible || p.Age == nil || *p.Age != 16 {
+		t.Fatalf("expected Andi to be eligible at 16, got %+v", p)
+	}
+	if p := squad.Players[1]; p.Eligible || p.Age == nil || *p.Age != 17 {
+		t.Fatalf("expected Budi to be too old at 17, got %+v", p)
+	}
+	if p := squad.Players[2]; p.Eligible || p.Age != nil || p.Reason == "" {
+		t.Fatalf("expected Cahya to be ineligible without a date of birth, got %+v", p)
+	}
+}
+
+func TestSeasonService_GetEligibility_OpenAgeCompetition(t *testing.T) {
+	// Given
+	svc, mockSeasonRepo, mockCompetitionRepo, _ := setupSeasonService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(&domain.Season{ID: "season-1", CompetitionID: "comp-1"}, nil)
+	mockCompetitionRepo.EXPECT().FindByID(ctx, "comp-1").Return(&domain.Competition{ID: "comp-1"}, nil)
+
+	// When
+	_, err := svc.GetEligibility(ctx, "season-1")
+
+	// Then
+	if !errors.Is(err, domain.ErrNoAgeLimit) {
+		t.Fatalf("expected ErrNoAgeLimit, got: %v", err)
+	}
+	assertErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+const (
+	maxCompetitionNameLength = 255
+)
+
+// Format describes how a competition decides its winner.
+type Format string
+
+const (
+	FormatLeague   Format = "league"
+	FormatKnockout Format = "knockout"
+)
+
+// KnockoutRules configures how ties are played in a knockout competition.
+type KnockoutRules struct {
+	TwoLegged     bool // Each tie is played home and away, decided on aggregate
+	AwayGoalsRule bool // Away goals break a level aggregate in two-legged ties
+}
+
+// DisciplineRules configures the suspensions players earn from cards.
+type DisciplineRules struct {
+	RedCardBan      int // Matches missed after being sent off
+	YellowCardLimit int // Yellow cards in a season that earn a ban, counted afresh after each ban
+	YellowCardBan   int // Matches missed on reaching the yellow card limit
+}
+
+// DefaultDisciplineRules are used when a competition does not set its own.
+func DefaultDisciplineRules() DisciplineRules {
+	return DisciplineRules{RedCardBan: 1, YellowCardLimit: 5, YellowCardBan: 1}
+}
+
+func (r DisciplineRules) validate() error {
+	if r.RedCardBan < 0 || r.YellowCardBan < 0 {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "suspension lengths cannot be negative")
+	}
+	if r.YellowCardLimit <= 0 {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "yellow card limit must be at least 1")
+	}
+	return nil
+}
+
+type Competition struct {
+	ID          string
+	Name        string
+	Description string
+	Format      Format
+	Rules       KnockoutRules
+	Discipline  DisciplineRules
+	AgeLimit    *AgeLimit // Nil for competitions open to all ages
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+// NewCompetition creates a competition. The format and knockout rules are fixed at creation
+// because changing them would invalidate seasons that are already under way. Zero discipline
+// rules fall back to DefaultDisciplineRules, and a nil age limit opens the competition to all ages.
+func NewCompetition(name, description string, format Format, rules KnockoutRules, discipline DisciplineRules, ageLimit *AgeLimit) (*Competition, error) {
+	name = strings.TrimSpace(name)
+	description = strings.TrimSpace(description)
+	if format == "" {
+		format = FormatLeague
+	}
+
+	if name == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "competition name is required")
+	}
+	if len(name) > maxCompetitionNameLength {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "competition name must not exceed %d characters", maxCompetitionNameLength)
+	}
+	if format != FormatLeague && format != FormatKnockout {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "competition format must be %q or %q", FormatLeague, FormatKnockout)
+	}
+	if format == FormatLeague && (rules.TwoLegged || rules.AwayGoalsRule) {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "knockout rules only apply to knockout competitions")
+	}
+	if rules.AwayGoalsRule && !rules.TwoLegged {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "away goals rule requires two-legged ties")
+	}
+	if discipline == (DisciplineRules{}) {
+		discipline = DefaultDisciplineRules()
+	}
+	if err := discipline.validate(); err != nil {
+		return nil, err
+	}
+	if ageLimit != nil {
+		if err := ageLimit.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	return &Competition{
+		ID:          ulid.GenerateID(),
+		Name:        name,
+		Description: description,
+		Format:      format,
+		Rules:       rules,
+		Discipline:  discipline,
+		AgeLimit:    ageLimit,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (c *Competition) Update(name, description string) error {
+	name = strings.TrimSpace(name)
+	description = strings.TrimSpace(description)
+
+	if name == "" {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "competition name is required")
+	}
+	if len(name) > maxCompetitionNameLength {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "competition name must not exceed %d characters", maxCompetitionNameLength)
+	}
+
+	c.Name = name
+	c.Description = description
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetDiscipline changes the disciplinary rules. Suspensions are worked out from the cards
+// whenever they are read, so the new rules also apply to cards already shown this season.
+func (c *Competition) SetDiscipline(rules DisciplineRules) error {
+	if err := rules.validate(); err != nil {
+		return err
+	}
+	c.Discipline = rules
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetAgeLimit changes the age limit, and a zero limit lifts it. Players already registered are not
+// re-checked; the limit applies to the registrations, lineups and goals recorded afterwards.
+func (c *Competition) SetAgeLimit(limit AgeLimit) error {
+	if limit == (AgeLimit{}) {
+		c.AgeLimit = nil
+	} else {
+		if err := limit.validate(); err != nil {
+			return err
+		}
+		c.AgeLimit = &limit
+	}
+	c.UpdatedAt = time.Now()
+	return nil
+}
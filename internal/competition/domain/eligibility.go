@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+// AgeLimit restricts an age-group competition to players no older than MaxAge on the cutoff date.
+// An under-17 league, for instance, takes players who are at most 16 on its cutoff date.
+type AgeLimit struct {
+	MaxAge     int
+	CutoffDate time.Time
+}
+
+func (l AgeLimit) validate() error {
+	if l.MaxAge <= 0 || l.CutoffDate.IsZero() {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "an age limit needs a maximum age of at least 1 and a cutoff date (YYYY-MM-DD)")
+	}
+	return nil
+}
+
+// Check tells whether a player born on dob may play under the limit, with their age on the cutoff
+// date and, when they may not, the reason. Players whose date of birth is unknown are not eligible.
+func (l AgeLimit) Check(dob *time.Time) (age *int, eligible bool, reason string) {
+	if dob == nil {
+		return nil, false, "date of birth is unknown"
+	}
+	years := ageOn(*dob, l.CutoffDate)
+	if years > l.MaxAge {
+		return &years, false, fmt.Sprintf("is %d on %s, older than the maximum age of %d", years, l.CutoffDate.Format("2006-01-02"), l.MaxAge)
+	}
+	return &years, true, ""
+}
+
+func ageOn(dob, on time.Time) int {
+	age := on.Year() - dob.Year()
+	if on.Month() < dob.Month() || (on.Month() == dob.Month() && on.Day() < dob.Day()) {
+		age--
+	}
+	return age
+}
+
+// SquadPlayer is a player of a team taking part in a season, as the eligibility report sees them.
+type SquadPlayer struct {
+	ID          string
+	Name        string
+	TeamID      string
+	DateOfBirth *time.Time
+}
+
+// PlayerEligibility tells whether a player may play in an age-group season.
+type PlayerEligibility struct {
+	PlayerID    string
+	Name        string
+	DateOfBirth *time.Time
+	Age         *int // On the cutoff date, nil when the date of birth is unknown
+	Eligible    bool
+	Reason      string // Why the player is not eligible
+}
+
+// TeamEligibility is the eligibility of the squad of one team in a season.
+type TeamEligibility struct {
+	TeamID     string
+	TeamName   string
+	Players    []PlayerEligibility
+	Ineligible int
+}
+
+// EligibilityReport checks the squads of the teams of a season against the age limit of its competition.
+type EligibilityReport struct {
+	SeasonID string
+	AgeLimit AgeLimit
+	SquadsOn time.Time // The day the squads were taken on
+	Teams    []TeamEligibility
+}
+
+// NewEligibilityReport checks every player against the limit, listing the teams in the order given
+// and including those without players.
+func NewEligibilityReport(seasonID string, limit AgeLimit, on time.Time, teams []SeasonTeam, players []SquadPlayer) *EligibilityReport {
+	report := &EligibilityReport{SeasonID: seasonID, AgeLimit: limit, SquadsOn: on}
+	index := make(map[string]int, len(teams))
+	for i, t := range teams {
+		index[t.TeamID] = i
+		report.Teams = append(report.Teams, TeamEligibility{TeamID: t.TeamID, TeamName: t.TeamName, Players: []PlayerEligibility{}})
+	}
+
+	for _, p := range players {
+		i, ok := index[p.TeamID]
+		if !ok {
+			continue
+		}
+		age, eligible, reason := limit.Check(p.DateOfBirth)
+		report.Teams[i].Players = append(report.Teams[i].Players, PlayerEligibility{
+			PlayerID:    p.ID,
+			Name:        p.Name,
+			DateOfBirth: p.DateOfBirth,
+			Age:         age,
+			Eligible:    eligible,
+			Reason:      reason,
+		})
+		if !eligible {
+			report.Teams[i].Ineligible++
+		}
+	}
+	return report
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "errors"
+
+// Competition domain errors.
+var (
+	ErrCompetitionNotFound      = errors.New("competition not found")
+	ErrCompetitionAlreadyExists = errors.New("competition name already exists")
+)
+
+// Season domain errors.
+var (
+	ErrSeasonNotFound         = errors.New("season not found")
+	ErrTeamNotFound           = errors.New("team not found")
+	ErrTeamAlreadyRegistered  = errors.New("team is already registered in this season")
+	ErrTeamNotRegistered      = errors.New("team is not registered in this season")
+	ErrSeasonHasMatches       = errors.New("season already has scheduled matches")
+	ErrTeamHasMatchesInSeason = errors.New("team already has matches in this season")
+)
+
+// Registration rule errors.
+var (
+	ErrInvalidRegistrationWindows = errors.New("registration windows are invalid")
+)
+
+// Eligibility errors.
+var (
+	ErrNoAgeLimit = errors.New("competition has no age limit")
+)
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+// DefaultHomeNationality is the nationality players must have not to count as foreign,
+// for seasons that do not set their own.
+const DefaultHomeNationality = "ID"
+
+var nationalityCode = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// RegistrationWindow is a period in which teams may register and sign players, inclusive of both ends.
+type RegistrationWindow struct {
+	OpensOn  time.Time
+	ClosesOn time.Time
+}
+
+// RegistrationRules limit when and which players the teams of a season can register.
+type RegistrationRules struct {
+	Windows           []RegistrationWindow // Empty to allow registrations at any time
+	MaxSquadSize      *int                 // Nil for no limit
+	MaxForeignPlayers *int                 // Nil for no quota
+	HomeNationality   string               // ISO 3166-1 alpha-2 code of the players who are not foreign
+}
+
+// SetRegistrationRules replaces the registration rules of the season. Windows are sorted by opening
+// date, may not overlap and must close by the end of the season.
+func (s *Season) SetRegistrationRules(rules RegistrationRules) (RegistrationRules, error) {
+	rules.HomeNationality = strings.ToUpper(strings.TrimSpace(rules.HomeNationality))
+	if rules.HomeNationality == "" {
+		rules.HomeNationality = DefaultHomeNationality
+	}
+	if !nationalityCode.MatchString(rules.HomeNationality) {
+		return RegistrationRules{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "home nationality must be a two-letter ISO 3166-1 country code")
+	}
+	if rules.MaxSquadSize != nil && *rules.MaxSquadSize < 1 {
+		return RegistrationRules{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "max squad size must be at least 1")
+	}
+	if rules.MaxForeignPlayers != nil && *rules.MaxForeignPlayers < 0 {
+		return RegistrationRules{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "max foreign players must not be negative")
+	}
+	if rules.MaxSquadSize != nil && rules.MaxForeignPlayers != nil && *rules.MaxForeignPlayers > *rules.MaxSquadSize {
+		return RegistrationRules{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "max foreign players must not exceed the max squad size")
+	}
+
+	windows := append([]RegistrationWindow(nil), rules.Windows...)
+	sort.Slice(windows, func(i, j int) bool { return windows[i].OpensOn.Before(windows[j].OpensOn) })
+
+	var details []derrors.Detail
+	for i, w := range windows {
+		field := fmt.Sprintf("windows[%d]", i)
+		switch {
+		case w.OpensOn.IsZero() || w.ClosesOn.IsZero():
+			details = append(details, derrors.Detail{Field: field, Message: "window opening and closing dates are required (YYYY-MM-DD)"})
+		case w.ClosesOn.Before(w.OpensOn):
+			details = append(details, derrors.Detail{Field: field, Message: "window must not close before it opens"})
+		case w.ClosesOn.Format("2006-01-02") > s.EndDate.Format("2006-01-02"):
+			details = append(details, derrors.Detail{Field: field, Message: fmt.Sprintf("window must close by the end of the season on %s", s.EndDate.Format("2006-01-02"))})
+		case i > 0 && !w.OpensOn.After(windows[i-1].ClosesOn):
+			details = append(details, derrors.Detail{Field: field, Message: fmt.Sprintf("window overlaps the one closing on %s", windows[i-1].ClosesOn.Format("2006-01-02"))})
+		}
+	}
+	if len(details) > 0 {
+		return RegistrationRules{}, derrors.WithDetails(ErrInvalidRegistrationWindows, derrors.ErrorCodeBadRequest, details, "%s", ErrInvalidRegistrationWindows.Error())
+	}
+
+	rules.Windows = windows
+	return rules, nil
+}
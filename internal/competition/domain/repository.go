@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// CompetitionRepository defines the port for competition persistence.
+type CompetitionRepository interface {
+	Create(ctx context.Context, competition *Competition) error
+	FindByID(ctx context.Context, id string) (*Competition, error)
+	FindAll(ctx context.Context) ([]Competition, error)
+	Update(ctx context.Context, competition *Competition) error
+	SoftDelete(ctx context.Context, id string) error
+	ExistsByName(ctx context.Context, name string, excludeID string) (bool, error)
+}
+
+// SeasonRepository defines the port for season persistence and team registration.
+type SeasonRepository interface {
+	Create(ctx context.Context, season *Season) error
+	FindByID(ctx context.Context, id string) (*Season, error)
+	FindByCompetitionID(ctx context.Context, competitionID string) ([]Season, error)
+	Update(ctx context.Context, season *Season) error
+	SoftDelete(ctx context.Context, id string) error
+	HasMatches(ctx context.Context, seasonID string) (bool, error)
+	RegisterTeam(ctx context.Context, team *SeasonTeam) error
+	UnregisterTeam(ctx context.Context, seasonID, teamID string) error
+	FindTeams(ctx context.Context, seasonID string) ([]SeasonTeam, error)
+	IsTeamRegistered(ctx context.Context, seasonID, teamID string) (bool, error)
+	TeamHasMatches(ctx context.Context, seasonID, teamID string) (bool, error)
+	// FindRegistrationRules returns the registration rules of the season, with its windows in order.
+	FindRegistrationRules(ctx context.Context, seasonID string) (*RegistrationRules, error)
+	// SaveRegistrationRules replaces the registration rules and windows of the season, in one transaction.
+	SaveRegistrationRules(ctx context.Context, seasonID string, rules RegistrationRules) error
+	// FindSquadPlayers returns the players of the teams registered in the season who are in their
+	// team's squad on the given day, by name.
+	FindSquadPlayers(ctx context.Context, seasonID string, on time.Time) ([]SquadPlayer, error)
+}
+
+// TeamRepository defines the port for looking up teams owned by the Club context.
+type TeamRepository interface {
+	ExistsByID(ctx context.Context, id string) (bool, error)
+}
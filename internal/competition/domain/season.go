@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+const (
+	maxSeasonNameLength = 100
+)
+
+type Season struct {
+	ID              string
+	CompetitionID   string
+	Name            string // e.g. "2025/2026"
+	StartDate       time.Time
+	EndDate         time.Time
+	CompetitionName string // Populated on read
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+}
+
+// SeasonTeam is a team registered to take part in a season.
+type SeasonTeam struct {
+	SeasonID     string
+	TeamID       string
+	TeamName     string // Populated on read
+	RegisteredAt time.Time
+}
+
+func NewSeason(competitionID, name string, startDate, endDate time.Time) (*Season, error) {
+	competitionID = strings.TrimSpace(competitionID)
+
+	if competitionID == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "competition ID is required")
+	}
+
+	season := &Season{
+		ID:            ulid.GenerateID(),
+		CompetitionID: competitionID,
+	}
+	if err := season.apply(name, startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	season.CreatedAt = season.UpdatedAt
+	return season, nil
+}
+
+func (s *Season) Update(name string, startDate, endDate time.Time) error {
+	return s.apply(name, startDate, endDate)
+}
+
+// Covers reports whether the given date falls within the season, inclusive of both ends.
+func (s *Season) Covers(date time.Time) bool {
+	day := date.Format("2006-01-02")
+	return day >= s.StartDate.Format("2006-01-02") && day <= s.EndDate.Format("2006-01-02")
+}
+
+func (s *Season) apply(name string, startDate, endDate time.Time) error {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "season name is required")
+	}
+	if len(name) > maxSeasonNameLength {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "season name must not exceed %d characters", maxSeasonNameLength)
+	}
+	if startDate.IsZero() || endDate.IsZero() {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "season start and end dates are required (YYYY-MM-DD)")
+	}
+	if !endDate.After(startDate) {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "season end date must be after its start date")
+	}
+
+	s.Name = name
+	s.StartDate = startDate
+	s.EndDate = endDate
+	s.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/infra/handler/request"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/infra/handler/response"
+	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	"github.com/gin-gonic/gin"
+)
+
+type CompetitionHandler struct {
+	service app.CompetitionServicePort
+}
+
+func NewCompetitionHandler(service app.CompetitionServicePort) *CompetitionHandler {
+	return &CompetitionHandler{service: service}
+}
+
+func (h *CompetitionHandler) Create(c *gin.Context) {
+	var req request.CreateCompetitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	id, err := h.service.Create(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
+}
+
+func (h *CompetitionHandler) GetAll(c *gin.Context) {
+	competitions, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromCompetitions(competitions)))
+}
+
+func (h *CompetitionHandler) GetByID(c *gin.Context) {
+	id := c.Param("id")
+
+	competition, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromCompetition(competition)))
+}
+
+func (h *CompetitionHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.UpdateCompetitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	if err := h.service.Update(c.Request.Context(), id, req.ToDomain()); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *CompetitionHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
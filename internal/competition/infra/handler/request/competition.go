@@ -0,0 +1,79 @@
+package request
+
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+)
+
+type CreateCompetitionRequest struct {
+	Name          string           `json:"name" binding:"required"`
+	Description   string           `json:"description"`
+	Format        string           `json:"format"` // league (default) or knockout
+	TwoLegged     bool             `json:"two_legged"`
+	AwayGoalsRule bool             `json:"away_goals_rule"`
+	Discipline    *DisciplineInput `json:"discipline"` // Defaults apply when omitted
+	AgeLimit      *AgeLimitInput   `json:"age_limit"`  // Open to all ages when omitted
+}
+
+// DisciplineInput sets every disciplinary rule at once. Omitted fields are taken as zero.
+type DisciplineInput struct {
+	RedCardBan      int `json:"red_card_ban"`
+	YellowCardLimit int `json:"yellow_card_limit"`
+	YellowCardBan   int `json:"yellow_card_ban"`
+}
+
+func (d *DisciplineInput) toDomain() domain.DisciplineRules {
+	if d == nil {
+		return domain.DisciplineRules{}
+	}
+	return domain.DisciplineRules{
+		RedCardBan:      d.RedCardBan,
+		YellowCardLimit: d.YellowCardLimit,
+		YellowCardBan:   d.YellowCardBan,
+	}
+}
+
+// AgeLimitInput restricts a competition to players no older than max_age on the cutoff date.
+type AgeLimitInput struct {
+	MaxAge     int    `json:"max_age"`     // e.g. 16 for an under-17 league
+	CutoffDate string `json:"cutoff_date"` // YYYY-MM-DD
+}
+
+func (a *AgeLimitInput) toDomain() *domain.AgeLimit {
+	if a == nil {
+		return nil
+	}
+	cutoffDate, _ := time.Parse("2006-01-02", a.CutoffDate)
+	return &domain.AgeLimit{MaxAge: a.MaxAge, CutoffDate: cutoffDate}
+}
+
+func (r CreateCompetitionRequest) ToDomain() *domain.Competition {
+	return &domain.Competition{
+		Name:        r.Name,
+		Description: r.Description,
+		Format:      domain.Format(r.Format),
+		Rules: domain.KnockoutRules{
+			TwoLegged:     r.TwoLegged,
+			AwayGoalsRule: r.AwayGoalsRule,
+		},
+		Discipline: r.Discipline.toDomain(),
+		AgeLimit:   r.AgeLimit.toDomain(),
+	}
+}
+
+type UpdateCompetitionRequest struct {
+	Name        string           `json:"name" binding:"required"`
+	Description string           `json:"description"`
+	Discipline  *DisciplineInput `json:"discipline"` // Left unchanged when omitted
+	AgeLimit    *AgeLimitInput   `json:"age_limit"`  // Left unchanged when omitted, lifted when empty
+}
+
+func (r UpdateCompetitionRequest) ToDomain() *domain.Competition {
+	return &domain.Competition{
+		Name:        r.Name,
+		Description: r.Description,
+		Discipline:  r.Discipline.toDomain(),
+		AgeLimit:    r.AgeLimit.toDomain(),
+	}
+}
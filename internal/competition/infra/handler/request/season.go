@@ -0,0 +1,71 @@
+package request
+
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+)
+
+type CreateSeasonRequest struct {
+	Name      string `json:"name" binding:"required"`
+	StartDate string `json:"start_date" binding:"required"` // YYYY-MM-DD
+	EndDate   string `json:"end_date" binding:"required"`   // YYYY-MM-DD
+}
+
+func (r CreateSeasonRequest) ToDomain(competitionID string) *domain.Season {
+	startDate, _ := time.Parse("2006-01-02", r.StartDate)
+	endDate, _ := time.Parse("2006-01-02", r.EndDate)
+	return &domain.Season{
+		CompetitionID: competitionID,
+		Name:          r.Name,
+		StartDate:     startDate,
+		EndDate:       endDate,
+	}
+}
+
+type UpdateSeasonRequest struct {
+	Name      string `json:"name" binding:"required"`
+	StartDate string `json:"start_date" binding:"required"` // YYYY-MM-DD
+	EndDate   string `json:"end_date" binding:"required"`   // YYYY-MM-DD
+}
+
+func (r UpdateSeasonRequest) ToDomain() *domain.Season {
+	startDate, _ := time.Parse("2006-01-02", r.StartDate)
+	endDate, _ := time.Parse("2006-01-02", r.EndDate)
+	return &domain.Season{
+		Name:      r.Name,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+}
+
+type RegisterTeamRequest struct {
+	TeamID string `json:"team_id" binding:"required"`
+}
+
+type RegistrationWindowInput struct {
+	OpensOn  string `json:"opens_on" binding:"required"`  // YYYY-MM-DD
+	ClosesOn string `json:"closes_on" binding:"required"` // YYYY-MM-DD
+}
+
+type RegistrationRulesRequest struct {
+	Windows           []RegistrationWindowInput `json:"windows"`             // Empty to allow registrations at any time
+	MaxSquadSize      *int                      `json:"max_squad_size"`      // Null for no limit
+	MaxForeignPlayers *int                      `json:"max_foreign_players"` // Null for no quota
+	HomeNationality   string                    `json:"home_nationality"`    // ISO 3166-1 alpha-2, defaults to ID
+}
+
+func (r RegistrationRulesRequest) ToDomain() domain.RegistrationRules {
+	windows := make([]domain.RegistrationWindow, len(r.Windows))
+	for i, w := range r.Windows {
+		opensOn, _ := time.Parse("2006-01-02", w.OpensOn)
+		closesOn, _ := time.Parse("2006-01-02", w.ClosesOn)
+		windows[i] = domain.RegistrationWindow{OpensOn: opensOn, ClosesOn: closesOn}
+	}
+	return domain.RegistrationRules{
+		Windows:           windows,
+		MaxSquadSize:      r.MaxSquadSize,
+		MaxForeignPlayers: r.MaxForeignPlayers,
+		HomeNationality:   r.HomeNationality,
+	}
+}
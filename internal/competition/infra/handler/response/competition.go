@@ -0,0 +1,60 @@
+package response
+
+import "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+
+type CompetitionResponse struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	Format        string             `json:"format"`
+	TwoLegged     bool               `json:"two_legged"`
+	AwayGoalsRule bool               `json:"away_goals_rule"`
+	Discipline    DisciplineResponse `json:"discipline"`
+	AgeLimit      *AgeLimitResponse  `json:"age_limit"` // Null for competitions open to all ages
+}
+
+type DisciplineResponse struct {
+	RedCardBan      int `json:"red_card_ban"`
+	YellowCardLimit int `json:"yellow_card_limit"`
+	YellowCardBan   int `json:"yellow_card_ban"`
+}
+
+type AgeLimitResponse struct {
+	MaxAge     int    `json:"max_age"`
+	CutoffDate string `json:"cutoff_date"`
+}
+
+func FromAgeLimit(limit *domain.AgeLimit) *AgeLimitResponse {
+	if limit == nil {
+		return nil
+	}
+	return &AgeLimitResponse{
+		MaxAge:     limit.MaxAge,
+		CutoffDate: limit.CutoffDate.Format("2006-01-02"),
+	}
+}
+
+func FromCompetition(competition *domain.Competition) CompetitionResponse {
+	return CompetitionResponse{
+		ID:            competition.ID,
+		Name:          competition.Name,
+		Description:   competition.Description,
+		Format:        string(competition.Format),
+		TwoLegged:     competition.Rules.TwoLegged,
+		AwayGoalsRule: competition.Rules.AwayGoalsRule,
+		Discipline: DisciplineResponse{
+			RedCardBan:      competition.Discipline.RedCardBan,
+			YellowCardLimit: competition.Discipline.YellowCardLimit,
+			YellowCardBan:   competition.Discipline.YellowCardBan,
+		},
+		AgeLimit: FromAgeLimit(competition.AgeLimit),
+	}
+}
+
+func FromCompetitions(competitions []domain.Competition) []CompetitionResponse {
+	result := make([]CompetitionResponse, len(competitions))
+	for i, c := range competitions {
+		result[i] = FromCompetition(&c)
+	}
+	return result
+}
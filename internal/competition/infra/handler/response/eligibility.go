@@ -0,0 +1,60 @@
+package response
+
+import "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+
+type PlayerEligibilityResponse struct {
+	PlayerID    string  `json:"player_id"`
+	Name        string  `json:"name"`
+	DateOfBirth *string `json:"date_of_birth"`
+	Age         *int    `json:"age"` // On the cutoff date
+	Eligible    bool    `json:"eligible"`
+	Reason      string  `json:"reason,omitempty"`
+}
+
+type TeamEligibilityResponse struct {
+	TeamID     string                      `json:"team_id"`
+	TeamName   string                      `json:"team_name"`
+	Ineligible int                         `json:"ineligible"`
+	Players    []PlayerEligibilityResponse `json:"players"`
+}
+
+type EligibilityReportResponse struct {
+	SeasonID string                    `json:"season_id"`
+	AgeLimit *AgeLimitResponse         `json:"age_limit"`
+	SquadsOn string                    `json:"squads_on"`
+	Teams    []TeamEligibilityResponse `json:"teams"`
+}
+
+func FromEligibilityReport(report *domain.EligibilityReport) EligibilityReportResponse {
+	teams := make([]TeamEligibilityResponse, len(report.Teams))
+	for i, t := range report.Teams {
+		players := make([]PlayerEligibilityResponse, len(t.Players))
+		for j, p := range t.Players {
+			var dob *string
+			if p.DateOfBirth != nil {
+				s := p.DateOfBirth.Format("2006-01-02")
+				dob = &s
+			}
+			players[j] = PlayerEligibilityResponse{
+				PlayerID:    p.PlayerID,
+				Name:        p.Name,
+				DateOfBirth: dob,
+				Age:         p.Age,
+				Eligible:    p.Eligible,
+				Reason:      p.Reason,
+			}
+		}
+		teams[i] = TeamEligibilityResponse{
+			TeamID:     t.TeamID,
+			TeamName:   t.TeamName,
+			Ineligible: t.Ineligible,
+			Players:    players,
+		}
+	}
+	return EligibilityReportResponse{
+		SeasonID: report.SeasonID,
+		AgeLimit: FromAgeLimit(&report.AgeLimit),
+		SquadsOn: report.SquadsOn.Format("2006-01-02"),
+		Teams:    teams,
+	}
+}
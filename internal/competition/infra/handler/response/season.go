@@ -0,0 +1,77 @@
+package response
+
+import "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+
+type SeasonResponse struct {
+	ID              string `json:"id"`
+	CompetitionID   string `json:"competition_id"`
+	CompetitionName string `json:"competition_name"`
+	Name            string `json:"name"`
+	StartDate       string `json:"start_date"`
+	EndDate         string `json:"end_date"`
+}
+
+func FromSeason(season *domain.Season) SeasonResponse {
+	return SeasonResponse{
+		ID:              season.ID,
+		CompetitionID:   season.CompetitionID,
+		CompetitionName: season.CompetitionName,
+		Name:            season.Name,
+		StartDate:       season.StartDate.Format("2006-01-02"),
+		EndDate:         season.EndDate.Format("2006-01-02"),
+	}
+}
+
+func FromSeasons(seasons []domain.Season) []SeasonResponse {
+	result := make([]SeasonResponse, len(seasons))
+	for i, s := range seasons {
+		result[i] = FromSeason(&s)
+	}
+	return result
+}
+
+type SeasonTeamResponse struct {
+	TeamID       string `json:"team_id"`
+	TeamName     string `json:"team_name"`
+	RegisteredAt string `json:"registered_at"`
+}
+
+func FromSeasonTeams(teams []domain.SeasonTeam) []SeasonTeamResponse {
+	result := make([]SeasonTeamResponse, len(teams))
+	for i, t := range teams {
+		result[i] = SeasonTeamResponse{
+			TeamID:       t.TeamID,
+			TeamName:     t.TeamName,
+			RegisteredAt: t.RegisteredAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return result
+}
+
+type RegistrationWindowResponse struct {
+	OpensOn  string `json:"opens_on"`
+	ClosesOn string `json:"closes_on"`
+}
+
+type RegistrationRulesResponse struct {
+	Windows           []RegistrationWindowResponse `json:"windows"`
+	MaxSquadSize      *int                         `json:"max_squad_size"`
+	MaxForeignPlayers *int                         `json:"max_foreign_players"`
+	HomeNationality   string                       `json:"home_nationality"`
+}
+
+func FromRegistrationRules(rules *domain.RegistrationRules) RegistrationRulesResponse {
+	windows := make([]RegistrationWindowResponse, len(rules.Windows))
+	for i, w := range rules.Windows {
+		windows[i] = RegistrationWindowResponse{
+			OpensOn:  w.OpensOn.Format("2006-01-02"),
+			ClosesOn: w.ClosesOn.Format("2006-01-02"),
+		}
+	}
+	return RegistrationRulesResponse{
+		Windows:           windows,
+		MaxSquadSize:      rules.MaxSquadSize,
+		MaxForeignPlayers: rules.MaxForeignPlayers,
+		HomeNationality:   rules.HomeNationality,
+	}
+}
@@ -0,0 +1,40 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// RegisterRoutes registers all Competition Context routes.
+// Write routes (POST, PUT, DELETE) are protected by the auth middleware.
+// Read routes (GET) are public.
+func RegisterRoutes(rg *gin.RouterGroup, competitionHandler *CompetitionHandler, seasonHandler *SeasonHandler, authMiddleware ...gin.HandlerFunc) {
+	// Competition routes
+	competitions := rg.Group("/competitions")
+	{
+		// Public (read-only)
+		competitions.GET("", competitionHandler.GetAll)
+		competitions.GET("/:id", competitionHandler.GetByID)
+		competitions.GET("/:id/seasons", seasonHandler.GetByCompetitionID)
+
+		// Protected (write) — middleware applied per-route
+		competitions.POST("", append(authMiddleware, competitionHandler.Create)...)
+		competitions.PUT("/:id", append(authMiddleware, competitionHandler.Update)...)
+		competitions.DELETE("/:id", append(authMiddleware, competitionHandler.Delete)...)
+		competitions.POST("/:id/seasons", append(authMiddleware, seasonHandler.Create)...)
+	}
+
+	// Season routes
+	seasons := rg.Group("/seasons")
+	{
+		// Public (read-only)
+		seasons.GET("/:id", seasonHandler.GetByID)
+		seasons.GET("/:id/teams", seasonHandler.GetTeams)
+		seasons.GET("/:id/registration-rules", seasonHandler.GetRegistrationRules)
+		seasons.GET("/:id/eligibility", seasonHandler.GetEligibility)
+
+		// Protected (write) — middleware applied per-route
+		seasons.PUT("/:id", append(authMiddleware, seasonHandler.Update)...)
+		seasons.DELETE("/:id", append(authMiddleware, seasonHandler.Delete)...)
+		seasons.POST("/:id/teams", append(authMiddleware, seasonHandler.RegisterTeam)...)
+		seasons.DELETE("/:id/teams/:team_id", append(authMiddleware, seasonHandler.UnregisterTeam)...)
+		seasons.PUT("/:id/registration-rules", append(authMiddleware, seasonHandler.SetRegistrationRules)...)
+	}
+}
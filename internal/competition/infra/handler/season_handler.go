@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/infra/handler/request"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/infra/handler/response"
+	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	"github.com/gin-gonic/gin"
+)
+
+type SeasonHandler struct {
+	service app.SeasonServicePort
+}
+
+func NewSeasonHandler(service app.SeasonServicePort) *SeasonHandler {
+	return &SeasonHandler{service: service}
+}
+
+func (h *SeasonHandler) Create(c *gin.Context) {
+	competitionID := c.Param("id")
+
+	var req request.CreateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	id, err := h.service.Create(c.Request.Context(), req.ToDomain(competitionID))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
+}
+
+func (h *SeasonHandler) GetByCompetitionID(c *gin.Context) {
+	competitionID := c.Param("id")
+
+	seasons, err := h.service.GetByCompetitionID(c.Request.Context(), competitionID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromSeasons(seasons)))
+}
+
+func (h *SeasonHandler) GetByID(c *gin.Context) {
+	id := c.Param("id")
+
+	season, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromSeason(season)))
+}
+
+func (h *SeasonHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.UpdateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	if err := h.service.Update(c.Request.Context(), id, req.ToDomain()); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *SeasonHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *SeasonHandler) GetTeams(c *gin.Context) {
+	seasonID := c.Param("id")
+
+	teams, err := h.service.GetTeams(c.Request.Context(), seasonID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromSeasonTeams(teams)))
+}
+
+func (h *SeasonHandler) RegisterTeam(c *gin.Context) {
+	seasonID := c.Param("id")
+
+	var req request.RegisterTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	if err := h.service.RegisterTeam(c.Request.Context(), seasonID, req.TeamID); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *SeasonHandler) UnregisterTeam(c *gin.Context) {
+	seasonID := c.Param("id")
+	teamID := c.Param("team_id")
+
+	if err := h.service.UnregisterTeam(c.Request.Context(), seasonID, teamID); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *SeasonHandler) GetRegistrationRules(c *gin.Context) {
+	seasonID := c.Param("id")
+
+	rules, err := h.service.GetRegistrationRules(c.Request.Context(), seasonID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromRegistrationRules(rules)))
+}
+
+func (h *SeasonHandler) SetRegistrationRules(c *gin.Context) {
+	seasonID := c.Param("id")
+
+	var req request.RegistrationRulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	rules, err := h.service.SetRegistrationRules(c.Request.Context(), seasonID, req.ToDomain())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromRegistrationRules(rules)))
+}
+
+func (h *SeasonHandler) GetEligibility(c *gin.Context) {
+	seasonID := c.Param("id")
+
+	report, err := h.service.GetEligibility(c.Request.Context(), seasonID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromEligibilityReport(report)))
+}
@@ -0,0 +1,36 @@
+package postgres
+
+const (
+	queryInsertCompetition = `
+		INSERT INTO competitions (id, name, description, format, two_legged, away_goals_rule, red_card_ban, yellow_card_limit, yellow_card_ban, max_age, age_cutoff_date, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	queryFindCompetitionByID = `
+		SELECT id, name, description, format, two_legged, away_goals_rule, red_card_ban, yellow_card_limit, yellow_card_ban, max_age, age_cutoff_date, created_at, updated_at, deleted_at
+		FROM competitions
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	queryFindAllCompetitions = `
+		SELECT id, name, description, format, two_legged, away_goals_rule, red_card_ban, yellow_card_limit, yellow_card_ban, max_age, age_cutoff_date, created_at, updated_at, deleted_at
+		FROM competitions
+		WHERE deleted_at IS NULL
+		ORDER BY name ASC
+	`
+
+	queryUpdateCompetition = `
+		UPDATE competitions
+		SET name = $1, description = $2, red_card_ban = $3, yellow_card_limit = $4, yellow_card_ban = $5, max_age = $6, age_cutoff_date = $7, updated_at = $8
+		WHERE id = $9 AND deleted_at IS NULL
+	`
+
+	querySoftDeleteCompetition = `UPDATE competitions SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	queryExistsCompetitionByName = `
+		SELECT EXISTS (
+			SELECT 1 FROM competitions
+			WHERE name = $1 AND id != $2 AND deleted_at IS NULL
+		)
+	`
+)
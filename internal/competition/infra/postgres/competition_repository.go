@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type competitionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCompetitionRepository(db *pgxpool.Pool) domain.CompetitionRepository {
+	return &competitionRepository{db: db}
+}
+
+func (r *competitionRepository) Create(ctx context.Context, competition *domain.Competition) error {
+	maxAge, cutoffDate := ageLimitColumns(competition.AgeLimit)
+	_, err := r.db.Exec(ctx, queryInsertCompetition,
+		competition.ID,
+		competition.Name,
+		competition.Description,
+		competition.Format,
+		competition.Rules.TwoLegged,
+		competition.Rules.AwayGoalsRule,
+		competition.Discipline.RedCardBan,
+		competition.Discipline.YellowCardLimit,
+		competition.Discipline.YellowCardBan,
+		maxAge,
+		cutoffDate,
+		competition.CreatedAt,
+		competition.UpdatedAt,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert competition")
+	}
+	return nil
+}
+
+func (r *competitionRepository) FindByID(ctx context.Context, id string) (*domain.Competition, error) {
+	var competition domain.Competition
+	var maxAge *int
+	var cutoffDate *time.Time
+	err := r.db.QueryRow(ctx, queryFindCompetitionByID, id).Scan(
+		&competition.ID,
+		&competition.Name,
+		&competition.Description,
+		&competition.Format,
+		&competition.Rules.TwoLegged,
+		&competition.Rules.AwayGoalsRule,
+		&competition.Discipline.RedCardBan,
+		&competition.Discipline.YellowCardLimit,
+		&competition.Discipline.YellowCardBan,
+		&maxAge,
+		&cutoffDate,
+		&competition.CreatedAt,
+		&competition.UpdatedAt,
+		&competition.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrCompetitionNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrCompetitionNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find competition")
+	}
+	competition.AgeLimit = ageLimit(maxAge, cutoffDate)
+	return &competition, nil
+}
+
+func (r *competitionRepository) FindAll(ctx context.Context) ([]domain.Competition, error) {
+	rows, err := r.db.Query(ctx, queryFindAllCompetitions)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query competitions")
+	}
+	defer rows.Close()
+
+	var competitions []domain.Competition
+	for rows.Next() {
+		var competition domain.Competition
+		var maxAge *int
+		var cutoffDate *time.Time
+		if err := rows.Scan(
+			&competition.ID,
+			&competition.Name,
+			&competition.Description,
+			&competition.Format,
+			&competition.Rules.TwoLegged,
+			&competition.Rules.AwayGoalsRule,
+			&competition.Discipline.RedCardBan,
+			&competition.Discipline.YellowCardLimit,
+			&competition.Discipline.YellowCardBan,
+			&maxAge,
+			&cutoffDate,
+			&competition.CreatedAt,
+			&competition.UpdatedAt,
+			&competition.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan competition row")
+		}
+		competition.AgeLimit = ageLimit(maxAge, cutoffDate)
+		competitions = append(competitions, competition)
+	}
+
+	return competitions, nil
+}
+
+func (r *competitionRepository) Update(ctx context.Context, competition *domain.Competition) error {
+	maxAge, cutoffDate := ageLimitColumns(competition.AgeLimit)
+	_, err := r.db.Exec(ctx, queryUpdateCompetition,
+		competition.Name,
+		competition.Description,
+		competition.Discipline.RedCardBan,
+		competition.Discipline.YellowCardLimit,
+		competition.Discipline.YellowCardBan,
+		maxAge,
+		cutoffDate,
+		competition.UpdatedAt,
+		competition.ID,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update competition")
+	}
+	return nil
+}
+
+func (r *competitionRepository) SoftDelete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, querySoftDeleteCompetition, id)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete competition")
+	}
+	return nil
+}
+
+func (r *competitionRepository) ExistsByName(ctx context.Context, name string, excludeID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsCompetitionByName, name, excludeID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check if competition exists by name")
+	}
+	return exists, nil
+}
+
+// ageLimitColumns splits an age limit into its nullable columns.
+func ageLimitColumns(limit *domain.AgeLimit) (*int, *time.Time) {
+	if limit == nil {
+		return nil, nil
+	}
+	return &limit.MaxAge, &limit.CutoffDate
+}
+
+func ageLimit(maxAge *int, cutoffDate *time.Time) *domain.AgeLimit {
+	if maxAge == nil || cutoffDate == nil {
+		return nil
+	}
+	return &domain.AgeLimit{MaxAge: *maxAge, CutoffDate: *cutoffDate}
+}
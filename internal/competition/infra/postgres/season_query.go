@@ -0,0 +1,105 @@
+package postgres
+
+const (
+	queryInsertSeason = `
+		INSERT INTO seasons (id, competition_id, name, start_date, end_date, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	queryFindSeasonByID = `
+		SELECT s.id, s.competition_id, s.name, s.start_date, s.end_date, c.name AS competition_name, s.created_at, s.updated_at, s.deleted_at
+		FROM seasons s
+		JOIN competitions c ON c.id = s.competition_id
+		WHERE s.id = $1 AND s.deleted_at IS NULL
+	`
+
+	queryFindSeasonsByCompetitionID = `
+		SELECT s.id, s.competition_id, s.name, s.start_date, s.end_date, c.name AS competition_name, s.created_at, s.updated_at, s.deleted_at
+		FROM seasons s
+		JOIN competitions c ON c.id = s.competition_id
+		WHERE s.competition_id = $1 AND s.deleted_at IS NULL
+		ORDER BY s.start_date DESC
+	`
+
+	queryUpdateSeason = `
+		UPDATE seasons
+		SET name = $1, start_date = $2, end_date = $3, updated_at = $4
+		WHERE id = $5 AND deleted_at IS NULL
+	`
+
+	querySoftDeleteSeason = `UPDATE seasons SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	queryExistsMatchInSeason = `
+		SELECT EXISTS (
+			SELECT 1 FROM matches
+			WHERE season_id = $1 AND deleted_at IS NULL
+		)
+	`
+
+	queryInsertSeasonTeam = `
+		INSERT INTO season_teams (season_id, team_id, registered_at)
+		VALUES ($1, $2, $3)
+	`
+
+	queryDeleteSeasonTeam = `DELETE FROM season_teams WHERE season_id = $1 AND team_id = $2`
+
+	queryFindSeasonTeams = `
+		SELECT st.season_id, st.team_id, t.name AS team_name, st.registered_at
+		FROM season_teams st
+		JOIN teams t ON t.id = st.team_id AND t.deleted_at IS NULL
+		WHERE st.season_id = $1
+		ORDER BY t.name ASC
+	`
+
+	queryIsTeamRegistered = `
+		SELECT EXISTS (
+			SELECT 1 FROM season_teams
+			WHERE season_id = $1 AND team_id = $2
+		)
+	`
+
+	queryExistsTeamMatchInSeason = `
+		SELECT EXISTS (
+			SELECT 1 FROM matches
+			WHERE season_id = $1 AND (home_team_id = $2 OR away_team_id = $2) AND deleted_at IS NULL
+		)
+	`
+
+	queryFindSeasonRegistrationRules = `
+		SELECT max_squad_size, max_foreign_players, home_nationality
+		FROM seasons
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	queryFindSeasonRegistrationWindows = `
+		SELECT opens_on, closes_on
+		FROM season_registration_windows
+		WHERE season_id = $1
+		ORDER BY opens_on
+	`
+
+	queryUpdateSeasonRegistrationRules = `
+		UPDATE seasons
+		SET max_squad_size = $1, max_foreign_players = $2, home_nationality = $3, updated_at = NOW()
+		WHERE id = $4 AND deleted_at IS NULL
+	`
+
+	queryDeleteSeasonRegistrationWindows = `DELETE FROM season_registration_windows WHERE season_id = $1`
+
+	queryInsertSeasonRegistrationWindow = `
+		INSERT INTO season_registration_windows (season_id, opens_on, closes_on)
+		VALUES ($1, $2, $3)
+	`
+
+	// A player is in the squad of a team from the day they joined it until the day they left
+	queryFindSeasonSquadPlayers = `
+		SELECT p.id, p.name, sp.team_id, p.date_of_birth
+		FROM season_teams st
+		JOIN player_spells sp ON sp.team_id = st.team_id
+		JOIN players p ON p.id = sp.player_id
+		WHERE st.season_id = $1
+		AND sp.joined_on <= $2::date
+		AND (sp.left_on IS NULL OR sp.left_on > $2::date)
+		ORDER BY p.name ASC
+	`
+)
@@ -0,0 +1,261 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type seasonRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSeasonRepository(db *pgxpool.Pool) domain.SeasonRepository {
+	return &seasonRepository{db: db}
+}
+
+func (r *seasonRepository) Create(ctx context.Context, season *domain.Season) error {
+	_, err := r.db.Exec(ctx, queryInsertSeason,
+		season.ID,
+		season.CompetitionID,
+		season.Name,
+		season.StartDate,
+		season.EndDate,
+		season.CreatedAt,
+		season.UpdatedAt,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert season")
+	}
+	return nil
+}
+
+func (r *seasonRepository) FindByID(ctx context.Context, id string) (*domain.Season, error) {
+	var season domain.Season
+	err := r.db.QueryRow(ctx, queryFindSeasonByID, id).Scan(
+		&season.ID,
+		&season.CompetitionID,
+		&season.Name,
+		&season.StartDate,
+		&season.EndDate,
+		&season.CompetitionName,
+		&season.CreatedAt,
+		&season.UpdatedAt,
+		&season.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrSeasonNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrSeasonNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find season")
+	}
+	return &season, nil
+}
+
+func (r *seasonRepository) FindByCompetitionID(ctx context.Context, competitionID string) ([]domain.Season, error) {
+	rows, err := r.db.Query(ctx, queryFindSeasonsByCompetitionID, competitionID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query seasons by competition")
+	}
+	defer rows.Close()
+
+	var seasons []domain.Season
+	for rows.Next() {
+		var season domain.Season
+		if err := rows.Scan(
+			&season.ID,
+			&season.CompetitionID,
+			&season.Name,
+			&season.StartDate,
+			&season.EndDate,
+			&season.CompetitionName,
+			&season.CreatedAt,
+			&season.UpdatedAt,
+			&season.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan season row")
+		}
+		seasons = append(seasons, season)
+	}
+
+	return seasons, nil
+}
+
+func (r *seasonRepository) Update(ctx context.Context, season *domain.Season) error {
+	_, err := r.db.Exec(ctx, queryUpdateSeason,
+		season.Name,
+		season.StartDate,
+		season.EndDate,
+		season.UpdatedAt,
+		season.ID,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update season")
+	}
+	return nil
+}
+
+func (r *seasonRepository) SoftDelete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, querySoftDeleteSeason, id)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete season")
+	}
+	return nil
+}
+
+func (r *seasonRepository) HasMatches(ctx context.Context, seasonID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsMatchInSeason, seasonID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check season matches")
+	}
+	return exists, nil
+}
+
+func (r *seasonRepository) RegisterTeam(ctx context.Context, team *domain.SeasonTeam) error {
+	_, err := r.db.Exec(ctx, queryInsertSeasonTeam,
+		team.SeasonID,
+		team.TeamID,
+		team.RegisteredAt,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to register team in season")
+	}
+	return nil
+}
+
+func (r *seasonRepository) UnregisterTeam(ctx context.Context, seasonID, teamID string) error {
+	_, err := r.db.Exec(ctx, queryDeleteSeasonTeam, seasonID, teamID)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to unregister team from season")
+	}
+	return nil
+}
+
+func (r *seasonRepository) FindTeams(ctx context.Context, seasonID string) ([]domain.SeasonTeam, error) {
+	rows, err := r.db.Query(ctx, queryFindSeasonTeams, seasonID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query season teams")
+	}
+	defer rows.Close()
+
+	var teams []domain.SeasonTeam
+	for rows.Next() {
+		var team domain.SeasonTeam
+		if err := rows.Scan(
+			&team.SeasonID,
+			&team.TeamID,
+			&team.TeamName,
+			&team.RegisteredAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan season team row")
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
+
+func (r *seasonRepository) IsTeamRegistered(ctx context.Context, seasonID, teamID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryIsTeamRegistered, seasonID, teamID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check season team registration")
+	}
+	return exists, nil
+}
+
+func (r *seasonRepository) TeamHasMatches(ctx context.Context, seasonID, teamID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsTeamMatchInSeason, seasonID, teamID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check team matches in season")
+	}
+	return exists, nil
+}
+
+func (r *seasonRepository) FindRegistrationRules(ctx context.Context, seasonID string) (*domain.RegistrationRules, error) {
+	var rules domain.RegistrationRules
+	err := r.db.QueryRow(ctx, queryFindSeasonRegistrationRules, seasonID).Scan(
+		&rules.MaxSquadSize,
+		&rules.MaxForeignPlayers,
+		&rules.HomeNationality,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrSeasonNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrSeasonNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find registration rules")
+	}
+
+	rows, err := r.db.Query(ctx, queryFindSeasonRegistrationWindows, seasonID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query registration windows")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var window domain.RegistrationWindow
+		if err := rows.Scan(&window.OpensOn, &window.ClosesOn); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan registration window row")
+		}
+		rules.Windows = append(rules.Windows, window)
+	}
+
+	return &rules, nil
+}
+
+func (r *seasonRepository) SaveRegistrationRules(ctx context.Context, seasonID string, rules domain.RegistrationRules) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, queryUpdateSeasonRegistrationRules, rules.MaxSquadSize, rules.MaxForeignPlayers, rules.HomeNationality, seasonID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update registration rules")
+	}
+
+	if _, err := tx.Exec(ctx, queryDeleteSeasonRegistrationWindows, seasonID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to delete registration windows")
+	}
+	for _, window := range rules.Windows {
+		if _, err := tx.Exec(ctx, queryInsertSeasonRegistrationWindow, seasonID, window.OpensOn, window.ClosesOn); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert registration window")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
+
+func (r *seasonRepository) FindSquadPlayers(ctx context.Context, seasonID string, on time.Time) ([]domain.SquadPlayer, error) {
+	rows, err := r.db.Query(ctx, queryFindSeasonSquadPlayers, seasonID, on)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query season squad players")
+	}
+	defer rows.Close()
+
+	var players []domain.SquadPlayer
+	for rows.Next() {
+		var player domain.SquadPlayer
+		if err := rows.Scan(
+			&player.ID,
+			&player.Name,
+			&player.TeamID,
+			&player.DateOfBirth,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan season squad player row")
+		}
+		players = append(players, player)
+	}
+
+	return players, nil
+}
@@ -0,0 +1,5 @@
+package postgres
+
+const (
+	queryExistsTeamByID = `SELECT EXISTS (SELECT 1 FROM teams WHERE id = $1 AND deleted_at IS NULL)`
+)
@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type teamRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTeamRepository(db *pgxpool.Pool) domain.TeamRepository {
+	return &teamRepository{db: db}
+}
+
+func (r *teamRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsTeamByID, id).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check if team exists")
+	}
+	return exists, nil
+}
@@ -0,0 +1,397 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/competition/domain/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/competition/domain/repository.go -destination=internal/competition/mock/repository_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/ZyoGo/ayo-indonesia-footbal/internal/competition/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompetitionRepository is a mock of CompetitionRepository interface.
+type MockCompetitionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompetitionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCompetitionRepositoryMockRecorder is the mock recorder for MockCompetitionRepository.
+type MockCompetitionRepositoryMockRecorder struct {
+	mock *MockCompetitionRepository
+}
+
+// NewMockCompetitionRepository creates a new mock instance.
+func NewMockCompetitionRepository(ctrl *gomock.Controller) *MockCompetitionRepository {
+	mock := &MockCompetitionRepository{ctrl: ctrl}
+	mock.recorder = &MockCompetitionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompetitionRepository) EXPECT() *MockCompetitionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCompetitionRepository) Create(ctx context.Context, competition *domain.Competition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, competition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCompetitionRepositoryMockRecorder) Create(ctx, competition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCompetitionRepository)(nil).Create), ctx, competition)
+}
+
+// ExistsByName mocks base method.
+func (m *MockCompetitionRepository) ExistsByName(ctx context.Context, name, excludeID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByName", ctx, name, excludeID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByName indicates an expected call of ExistsByName.
+func (mr *MockCompetitionRepositoryMockRecorder) ExistsByName(ctx, name, excludeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByName", reflect.TypeOf((*MockCompetitionRepository)(nil).ExistsByName), ctx, name, excludeID)
+}
+
+// FindAll mocks base method.
+func (m *MockCompetitionRepository) FindAll(ctx context.Context) ([]domain.Competition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Competition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCompetitionRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCompetitionRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockCompetitionRepository) FindByID(ctx context.Context, id string) (*domain.Competition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Competition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCompetitionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCompetitionRepository)(nil).FindByID), ctx, id)
+}
+
+// SoftDelete mocks base method.
+func (m *MockCompetitionRepository) SoftDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockCompetitionRepositoryMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockCompetitionRepository)(nil).SoftDelete), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockCompetitionRepository) Update(ctx context.Context, competition *domain.Competition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, competition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCompetitionRepositoryMockRecorder) Update(ctx, competition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCompetitionRepository)(nil).Update), ctx, competition)
+}
+
+// MockSeasonRepository is a mock of SeasonRepository interface.
+type MockSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSeasonRepositoryMockRecorder is the mock recorder for MockSeasonRepository.
+type MockSeasonRepositoryMockRecorder struct {
+	mock *MockSeasonRepository
+}
+
+// NewMockSeasonRepository creates a new mock instance.
+func NewMockSeasonRepository(ctrl *gomock.Controller) *MockSeasonRepository {
+	mock := &MockSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonRepository) EXPECT() *MockSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeasonRepository) Create(ctx context.Context, season *domain.Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, season)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeasonRepositoryMockRecorder) Create(ctx, season any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeasonRepository)(nil).Create), ctx, season)
+}
+
+// FindByCompetitionID mocks base method.
+func (m *MockSeasonRepository) FindByCompetitionID(ctx context.Context, competitionID string) ([]domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCompetitionID", ctx, competitionID)
+	ret0, _ := ret[0].([]domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCompetitionID indicates an expected call of FindByCompetitionID.
+func (mr *MockSeasonRepositoryMockRecorder) FindByCompetitionID(ctx, competitionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCompetitionID", reflect.TypeOf((*MockSeasonRepository)(nil).FindByCompetitionID), ctx, competitionID)
+}
+
+// FindByID mocks base method.
+func (m *MockSeasonRepository) FindByID(ctx context.Context, id string) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSeasonRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSeasonRepository)(nil).FindByID), ctx, id)
+}
+
+// FindRegistrationRules mocks base method.
+func (m *MockSeasonRepository) FindRegistrationRules(ctx context.Context, seasonID string) (*domain.RegistrationRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRegistrationRules", ctx, seasonID)
+	ret0, _ := ret[0].(*domain.RegistrationRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRegistrationRules indicates an expected call of FindRegistrationRules.
+func (mr *MockSeasonRepositoryMockRecorder) FindRegistrationRules(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRegistrationRules", reflect.TypeOf((*MockSeasonRepository)(nil).FindRegistrationRules), ctx, seasonID)
+}
+
+// FindSquadPlayers mocks base method.
+func (m *MockSeasonRepository) FindSquadPlayers(ctx context.Context, seasonID string, on time.Time) ([]domain.SquadPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSquadPlayers", ctx, seasonID, on)
+	ret0, _ := ret[0].([]domain.SquadPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSquadPlayers indicates an expected call of FindSquadPlayers.
+func (mr *MockSeasonRepositoryMockRecorder) FindSquadPlayers(ctx, seasonID, on any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSquadPlayers", reflect.TypeOf((*MockSeasonRepository)(nil).FindSquadPlayers), ctx, seasonID, on)
+}
+
+// FindTeams mocks base method.
+func (m *MockSeasonRepository) FindTeams(ctx context.Context, seasonID string) ([]domain.SeasonTeam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeams", ctx, seasonID)
+	ret0, _ := ret[0].([]domain.SeasonTeam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeams indicates an expected call of FindTeams.
+func (mr *MockSeasonRepositoryMockRecorder) FindTeams(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeams", reflect.TypeOf((*MockSeasonRepository)(nil).FindTeams), ctx, seasonID)
+}
+
+// HasMatches mocks base method.
+func (m *MockSeasonRepository) HasMatches(ctx context.Context, seasonID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMatches", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasMatches indicates an expected call of HasMatches.
+func (mr *MockSeasonRepositoryMockRecorder) HasMatches(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMatches", reflect.TypeOf((*MockSeasonRepository)(nil).HasMatches), ctx, seasonID)
+}
+
+// IsTeamRegistered mocks base method.
+func (m *MockSeasonRepository) IsTeamRegistered(ctx context.Context, seasonID, teamID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTeamRegistered", ctx, seasonID, teamID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTeamRegistered indicates an expected call of IsTeamRegistered.
+func (mr *MockSeasonRepositoryMockRecorder) IsTeamRegistered(ctx, seasonID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTeamRegistered", reflect.TypeOf((*MockSeasonRepository)(nil).IsTeamRegistered), ctx, seasonID, teamID)
+}
+
+// RegisterTeam mocks base method.
+func (m *MockSeasonRepository) RegisterTeam(ctx context.Context, team *domain.SeasonTeam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTeam", ctx, team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterTeam indicates an expected call of RegisterTeam.
+func (mr *MockSeasonRepositoryMockRecorder) RegisterTeam(ctx, team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTeam", reflect.TypeOf((*MockSeasonRepository)(nil).RegisterTeam), ctx, team)
+}
+
+// SaveRegistrationRules mocks base method.
+func (m *MockSeasonRepository) SaveRegistrationRules(ctx context.Context, seasonID string, rules domain.RegistrationRules) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRegistrationRules", ctx, seasonID, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRegistrationRules indicates an expected call of SaveRegistrationRules.
+func (mr *MockSeasonRepositoryMockRecorder) SaveRegistrationRules(ctx, seasonID, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRegistrationRules", reflect.TypeOf((*MockSeasonRepository)(nil).SaveRegistrationRules), ctx, seasonID, rules)
+}
+
+// SoftDelete mocks base method.
+func (m *MockSeasonRepository) SoftDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockSeasonRepositoryMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockSeasonRepository)(nil).SoftDelete), ctx, id)
+}
+
+// TeamHasMatches mocks base method.
+func (m *MockSeasonRepository) TeamHasMatches(ctx context.Context, seasonID, teamID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TeamHasMatches", ctx, seasonID, teamID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TeamHasMatches indicates an expected call of TeamHasMatches.
+func (mr *MockSeasonRepositoryMockRecorder) TeamHasMatches(ctx, seasonID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamHasMatches", reflect.TypeOf((*MockSeasonRepository)(nil).TeamHasMatches), ctx, seasonID, teamID)
+}
+
+// UnregisterTeam mocks base method.
+func (m *MockSeasonRepository) UnregisterTeam(ctx context.Context, seasonID, teamID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterTeam", ctx, seasonID, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterTeam indicates an expected call of UnregisterTeam.
+func (mr *MockSeasonRepositoryMockRecorder) UnregisterTeam(ctx, seasonID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterTeam", reflect.TypeOf((*MockSeasonRepository)(nil).UnregisterTeam), ctx, seasonID, teamID)
+}
+
+// Update mocks base method.
+func (m *MockSeasonRepository) Update(ctx context.Context, season *domain.Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, season)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSeasonRepositoryMockRecorder) Update(ctx, season any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSeasonRepository)(nil).Update), ctx, season)
+}
+
+// MockTeamRepository is a mock of TeamRepository interface.
+type MockTeamRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTeamRepositoryMockRecorder is the mock recorder for MockTeamRepository.
+type MockTeamRepositoryMockRecorder struct {
+	mock *MockTeamRepository
+}
+
+// NewMockTeamRepository creates a new mock instance.
+func NewMockTeamRepository(ctrl *gomock.Controller) *MockTeamRepository {
+	mock := &MockTeamRepository{ctrl: ctrl}
+	mock.recorder = &MockTeamRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamRepository) EXPECT() *MockTeamRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ExistsByID mocks base method.
+func (m *MockTeamRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByID", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByID indicates an expected call of ExistsByID.
+func (mr *MockTeamRepositoryMockRecorder) ExistsByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByID", reflect.TypeOf((*MockTeamRepository)(nil).ExistsByID), ctx, id)
+}
@@ -2,35 +2,101 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
 	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
 )
 
 type MatchService struct {
-	matchRepo  domain.MatchRepository
-	resultRepo domain.MatchResultRepository
-	reportRepo domain.ReportRepository
+	matchRepo      domain.MatchRepository
+	resultRepo     domain.MatchResultRepository
+	reportRepo     domain.ReportRepository
+	seasonRepo     domain.SeasonRepository
+	bracketRepo    domain.BracketRepository
+	squadRepo      domain.SquadRepository
+	disciplineRepo domain.DisciplineRepository
+	lineupRepo     domain.LineupRepository
+	liveRepo       domain.LiveEventRepository
+	liveBroker     domain.LiveEventBroker
+	venueRepo      domain.VenueRepository
+	officialRepo   domain.OfficialRepository
+	rules          domain.SchedulingRules
 }
 
 func NewMatchService(
 	matchRepo domain.MatchRepository,
 	resultRepo domain.MatchResultRepository,
 	reportRepo domain.ReportRepository,
+	seasonRepo domain.SeasonRepository,
+	bracketRepo domain.BracketRepository,
+	squadRepo domain.SquadRepository,
+	disciplineRepo domain.DisciplineRepository,
+	lineupRepo domain.LineupRepository,
+	liveRepo domain.LiveEventRepository,
+	liveBroker domain.LiveEventBroker,
+	venueRepo domain.VenueRepository,
+	officialRepo domain.OfficialRepository,
+	rules domain.SchedulingRules,
 ) MatchServicePort {
 	return &MatchService{
-		matchRepo:  matchRepo,
-		resultRepo: resultRepo,
-		reportRepo: reportRepo,
+		matchRepo:      matchRepo,
+		resultRepo:     resultRepo,
+		reportRepo:     reportRepo,
+		seasonRepo:     seasonRepo,
+		bracketRepo:    bracketRepo,
+		squadRepo:      squadRepo,
+		disciplineRepo: disciplineRepo,
+		lineupRepo:     lineupRepo,
+		liveRepo:       liveRepo,
+		liveBroker:     liveBroker,
+		venueRepo:      venueRepo,
+		officialRepo:   officialRepo,
+		rules:          rules,
 	}
 }
 
-func (s *MatchService) CreateMatch(ctx context.Context, match *domain.Match) (string, error) {
-	newMatch, err := domain.NewMatch(match.HomeTeamID, match.AwayTeamID, match.MatchDate, match.MatchTime, match.Stadium)
+func (s *MatchService) CreateMatch(ctx context.Context, match *domain.Match, force bool) (string, error) {
+	// Without a venue the match is played at the home team's venue
+	venueID := strings.TrimSpace(match.VenueID)
+	if venueID == "" && strings.TrimSpace(match.HomeTeamID) != "" {
+		homeVenueID, err := s.venueRepo.FindHomeVenueID(ctx, match.HomeTeamID)
+		if err != nil {
+			return "", err
+		}
+		if homeVenueID == "" {
+			return "", derrors.WrapErrorf(domain.ErrTeamWithoutVenue, derrors.ErrorCodeBadRequest, "home team has no home venue, a venue ID is required")
+		}
+		venueID = homeVenueID
+	}
+
+	// The kickoff time is on the clock at the venue
+	var timezone string
+	if venueID != "" {
+		venue, err := s.venueRepo.FindByID(ctx, venueID)
+		if err != nil {
+			return "", err
+		}
+		timezone = venue.Timezone
+	}
+
+	newMatch, err := domain.NewMatch(match.SeasonID, match.HomeTeamID, match.AwayTeamID, match.MatchDate, match.MatchTime, venueID, timezone)
 	if err != nil {
 		return "", err
 	}
 
+	if err := s.validateSeason(ctx, newMatch); err != nil {
+		return "", err
+	}
+
+	if err := s.checkConflicts(ctx, newMatch, force); err != nil {
+		return "", err
+	}
+
 	if err := s.matchRepo.Create(ctx, newMatch); err != nil {
 		return "", err
 	}
@@ -43,11 +109,24 @@ func (s *MatchService) GetMatchByID(ctx context.Context, id string) (*domain.Mat
 	if err != nil {
 		return nil, err
 	}
+
+	cards, err := s.resultRepo.FindCards(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	match.Cards = cards
+
+	officials, err := s.officialRepo.FindByMatchID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	match.Officials = officials
+
 	return match, nil
 }
 
-func (s *MatchService) GetAllMatches(ctx context.Context) ([]domain.Match, error) {
-	matches, err := s.matchRepo.FindAll(ctx)
+func (s *MatchService) GetAllMatches(ctx context.Context, filter domain.MatchFilter) ([]domain.Match, error) {
+	matches, err := s.matchRepo.FindAll(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +140,10 @@ func (s *MatchService) ReportResult(ctx context.Context, matchID string, result
 		return "", err
 	}
 
+	if !m.AcceptsResult() {
+		return "", derrors.WrapErrorf(domain.ErrMatchNotStarted, derrors.ErrorCodeBadRequest, "%s, match is %s", domain.ErrMatchNotStarted.Error(), m.Status)
+	}
+
 	// Check if result already reported
 	exists, err := s.resultRepo.ExistsByMatchID(ctx, matchID)
 	if err != nil {
@@ -71,18 +154,279 @@ func (s *MatchService) ReportResult(ctx context.Context, matchID string, result
 	}
 
 	// Construct valid result via domain factory
-	newResult, err := domain.NewMatchResult(matchID, m.HomeTeamID, m.AwayTeamID, result.HomeScore, result.AwayScore, result.Goals)
+	newResult, err := domain.NewMatchResult(matchID, m.HomeTeamID, m.AwayTeamID, result.HomeScore, result.AwayScore, result.Goals, result.Cards, result.DecidedBy, result.Shootout)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.checkScorers(ctx, m, newResult); err != nil {
+		return "", err
+	}
+
+	if err := s.checkSuspensions(ctx, m, newResult); err != nil {
+		return "", err
+	}
+
+	leg, err := s.checkKnockoutResult(ctx, m, newResult)
+	if err != nil {
+		return "", err
+	}
+
+	var advancement *domain.Advancement
+	if leg != nil {
+		if advancement, err = s.advanceKnockout(ctx, leg); err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.recordAppearances(ctx, newResult); err != nil {
+		return "", err
+	}
+
+	if err := s.resultRepo.Create(ctx, newResult, advancement); err != nil {
+		return "", err
+	}
+
+	return newResult.ID, nil
+}
+
+// AmendResult replaces the reported result of a match, keeping the previous one as a revision.
+// A knockout result can only be amended as long as the same team still goes through.
+func (s *MatchService) AmendResult(ctx context.Context, matchID string, result *domain.MatchResult, changedBy, reason string) (string, error) {
+	m, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return "", err
+	}
+
+	current, err := s.resultRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return "", err
+	}
+
+	revision, err := domain.NewResultRevision(current, domain.RevisionAmended, changedBy, reason)
+	if err != nil {
+		return "", err
+	}
+
+	newResult, err := domain.NewMatchResult(matchID, m.HomeTeamID, m.AwayTeamID, result.HomeScore, result.AwayScore, result.Goals, result.Cards, result.DecidedBy, result.Shootout)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.checkScorers(ctx, m, newResult); err != nil {
+		return "", err
+	}
+
+	if err := s.checkSuspensions(ctx, m, newResult); err != nil {
+		return "", err
+	}
+
+	leg, err := s.checkKnockoutResult(ctx, m, newResult)
 	if err != nil {
 		return "", err
 	}
+	var advancement *domain.Advancement
+	switch {
+	case leg != nil && leg.tie.WinnerTeamID != "":
+		winner, decided := leg.tie.Decide(leg.rules, leg.firstLeg, leg.secondLeg)
+		if !decided || winner != leg.tie.WinnerTeamID {
+			return "", derrors.WrapErrorf(domain.ErrTieAlreadyDecided, derrors.ErrorCodeBadRequest, "%s, the amended result cannot change who goes through", domain.ErrTieAlreadyDecided.Error())
+		}
+	case leg != nil:
+		if advancement, err = s.advanceKnockout(ctx, leg); err != nil {
+			return "", err
+		}
+	}
 
-	if err := s.resultRepo.Create(ctx, newResult); err != nil {
+	if err := s.recordAppearances(ctx, newResult); err != nil {
+		return "", err
+	}
+
+	if err := s.resultRepo.Amend(ctx, revision, newResult, advancement); err != nil {
 		return "", err
 	}
 
 	return newResult.ID, nil
 }
 
+// VoidResult throws away the reported result of a match, keeping it as a revision,
+// and returns the match to scheduled so it can be played again.
+func (s *MatchService) VoidResult(ctx context.Context, matchID, changedBy, reason string) (*domain.Match, error) {
+	m, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.resultRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Once a tie is decided its winner may already be playing the next round
+	if m.SeasonID != "" {
+		tie, err := s.bracketRepo.FindTieByMatchID(ctx, matchID)
+		if err != nil && !errors.Is(err, domain.ErrTieNotFound) {
+			return nil, err
+		}
+		if tie != nil && tie.WinnerTeamID != "" {
+			return nil, derrors.WrapErrorf(domain.ErrTieAlreadyDecided, derrors.ErrorCodeBadRequest, "%s, its results can no longer be voided", domain.ErrTieAlreadyDecided.Error())
+		}
+	}
+
+	revision, err := domain.NewResultRevision(current, domain.RevisionVoided, changedBy, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Reopen(); err != nil {
+		return nil, err
+	}
+
+	if err := s.resultRepo.Void(ctx, revision, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *MatchService) GetResultRevisions(ctx context.Context, matchID string) ([]domain.ResultRevision, error) {
+	if _, err := s.matchRepo.FindByID(ctx, matchID); err != nil {
+		return nil, err
+	}
+	return s.resultRepo.FindRevisions(ctx, matchID)
+}
+
+func (s *MatchService) StartMatch(ctx context.Context, id string) (*domain.Match, error) {
+	match, err := s.matchRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := match.Start(); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchRepo.Update(ctx, match); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+func (s *MatchService) PostponeMatch(ctx context.Context, id string, matchDate time.Time, matchTime, reason string, force bool) (*domain.Match, error) {
+	match, err := s.matchRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := match.Postpone(matchDate, matchTime, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSeasonDates(ctx, match); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkConflicts(ctx, match, force); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOfficials(ctx, match); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchRepo.Reschedule(ctx, match, change); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+func (s *MatchService) UpdateMatch(ctx context.Context, id string, matchDate time.Time, matchTime, venueID, reason string, force bool) (*domain.Match, error) {
+	match, err := s.matchRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a venue the match stays where it is
+	venueID = strings.TrimSpace(venueID)
+	if venueID == "" {
+		venueID = match.VenueID
+	}
+	venueName, timezone := match.VenueName, match.Timezone
+	if venueID != match.VenueID {
+		venue, err := s.venueRepo.FindByID(ctx, venueID)
+		if err != nil {
+			return nil, err
+		}
+		venueName, timezone = venue.Name, venue.Timezone
+	}
+
+	change, err := match.Reschedule(matchDate, matchTime, venueID, timezone, reason)
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return match, nil
+	}
+	change.NewVenueName = venueName
+	match.VenueName = venueName
+
+	if err := s.checkSeasonDates(ctx, match); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkConflicts(ctx, match, force); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOfficials(ctx, match); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchRepo.Reschedule(ctx, match, change); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+func (s *MatchService) GetMatchReschedules(ctx context.Context, id string) ([]domain.MatchReschedule, error) {
+	if _, err := s.matchRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.matchRepo.FindReschedules(ctx, id)
+}
+
+func (s *MatchService) CancelMatch(ctx context.Context, id, reason string) (*domain.Match, error) {
+	match, err := s.matchRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := match.Cancel(reason); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchRepo.Update(ctx, match); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+func (s *MatchService) AbandonMatch(ctx context.Context, id, reason string) (*domain.Match, error) {
+	match, err := s.matchRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := match.Abandon(reason); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchRepo.Update(ctx, match); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
 func (s *MatchService) GetMatchReport(ctx context.Context, matchID string) (*domain.MatchReportView, error) {
 	report, err := s.reportRepo.GetMatchReport(ctx, matchID)
 	if err != nil {
@@ -99,6 +443,651 @@ func (s *MatchService) GetAllMatchReports(ctx context.Context) ([]domain.MatchRe
 	return reports, nil
 }
 
+// GetPlayerSuspensions lists every suspension the player has earned, served or not.
+func (s *MatchService) GetPlayerSuspensions(ctx context.Context, playerID string) ([]domain.Suspension, error) {
+	cards, err := s.disciplineRepo.FindCardsByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.suspensionsFor(ctx, cards)
+}
+
+// GetSuspendedPlayers lists the suspensions that players of the team are still serving.
+func (s *MatchService) GetSuspendedPlayers(ctx context.Context, teamID string) ([]domain.Suspension, error) {
+	cards, err := s.disciplineRepo.FindCardsByTeam(ctx, teamID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	suspensions, err := s.suspensionsFor(ctx, cards)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]domain.Suspension, 0, len(suspensions))
+	for _, suspension := range suspensions {
+		if suspension.Active() {
+			active = append(active, suspension)
+		}
+	}
+	return active, nil
+}
+
+// SubmitLineup names a team's starting XI and bench for a match, replacing any lineup the team submitted before.
+// Every player must be registered with the team on the match date, and within the age limit of an age-group competition.
+func (s *MatchService) SubmitLineup(ctx context.Context, matchID string, lineup *domain.Lineup, submittedBy string) (*domain.Lineup, error) {
+	m, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	newLineup, err := domain.NewLineup(m, lineup.TeamID, lineup.Players, submittedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	playerIDs := make([]string, len(newLineup.Players))
+	for i, p := range newLineup.Players {
+		playerIDs[i] = p.PlayerID
+	}
+	squad, err := s.squadRepo.FindPlayers(ctx, playerIDs, m.MatchDate)
+	if err != nil {
+		return nil, err
+	}
+	if err := newLineup.CheckSquad(squad); err != nil {
+		return nil, err
+	}
+	limit, err := s.ageLimitOf(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	if limit != nil {
+		if err := newLineup.CheckEligibility(squad, *limit); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.lineupRepo.Save(ctx, newLineup); err != nil {
+		return nil, err
+	}
+
+	return newLineup, nil
+}
+
+func (s *MatchService) GetLineups(ctx context.Context, matchID string) ([]domain.Lineup, error) {
+	if _, err := s.matchRepo.FindByID(ctx, matchID); err != nil {
+		return nil, err
+	}
+	return s.lineupRepo.FindByMatchID(ctx, matchID)
+}
+
+// AddSubstitution records a player being replaced from the bench during a live match.
+func (s *MatchService) AddSubstitution(ctx context.Context, matchID string, sub *domain.Substitution) (string, error) {
+	m, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return "", err
+	}
+
+	lineups, err := s.lineupRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return "", err
+	}
+
+	var lineup *domain.Lineup
+	for i := range lineups {
+		if lineups[i].TeamID == sub.TeamID {
+			lineup = &lineups[i]
+		}
+	}
+	if lineup == nil {
+		return "", derrors.WrapErrorf(domain.ErrLineupNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrLineupNotFound.Error())
+	}
+
+	newSub, err := lineup.Substitute(m, sub.Minute, sub.PlayerOffID, sub.PlayerOnID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.lineupRepo.AddSubstitution(ctx, newSub, len(lineup.Substitutions)); err != nil {
+		return "", err
+	}
+
+	return newSub.ID, nil
+}
+
+// PostLiveEvent adds an event to the live feed of a match in progress and pushes it to everyone following the match.
+func (s *MatchService) PostLiveEvent(ctx context.Context, matchID string, event *domain.LiveEvent) (*domain.LiveEvent, error) {
+	m, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.liveRepo.FindByMatchID(ctx, matchID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent, err := domain.NewLiveEvent(m, history, *event)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.liveRepo.Create(ctx, newEvent); err != nil {
+		return nil, err
+	}
+	s.liveBroker.Publish(*newEvent)
+
+	return newEvent, nil
+}
+
+// GetLiveEvents returns the live feed of a match after the given sequence.
+func (s *MatchService) GetLiveEvents(ctx context.Context, matchID string, afterSequence int64) ([]domain.LiveEvent, error) {
+	if _, err := s.matchRepo.FindByID(ctx, matchID); err != nil {
+		return nil, err
+	}
+	return s.liveRepo.FindByMatchID(ctx, matchID, afterSequence)
+}
+
+// LiveFeedOver reports whether the live feed of a match has ended, so followers can stop waiting for events.
+func (s *MatchService) LiveFeedOver(ctx context.Context, matchID string) (bool, error) {
+	m, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return false, err
+	}
+
+	history, err := s.liveRepo.FindByMatchID(ctx, matchID, 0)
+	if err != nil {
+		return false, err
+	}
+
+	return domain.LiveFeedOver(m, history), nil
+}
+
+// SubscribeLiveEvents follows the live events of a match posted from now on.
+func (s *MatchService) SubscribeLiveEvents(matchID string) (<-chan domain.LiveEvent, func()) {
+	return s.liveBroker.Subscribe(matchID)
+}
+
 func (s *MatchService) DeleteMatch(ctx context.Context, id string) error {
+	if _, err := s.matchRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
 	return s.matchRepo.Delete(ctx, id)
 }
+
+// RestoreMatch brings back a deleted match along with the result and goals deleted with it,
+// so the match counts towards the standings again.
+func (s *MatchService) RestoreMatch(ctx context.Context, id string) (*domain.Match, error) {
+	_, err := s.matchRepo.FindByID(ctx, id)
+	if err == nil {
+		return nil, derrors.WrapErrorf(domain.ErrMatchNotDeleted, derrors.ErrorCodeBadRequest, "%s", domain.ErrMatchNotDeleted.Error())
+	}
+	if !errors.Is(err, domain.ErrMatchNotFound) {
+		return nil, err
+	}
+
+	if err := s.matchRepo.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.matchRepo.FindByID(ctx, id)
+}
+
+func (s *MatchService) GenerateFixtures(ctx context.Context, opts domain.FixtureOptions) ([]domain.Fixture, error) {
+	season, err := s.seasonRepo.FindByID(ctx, opts.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if season.IsKnockout() {
+		return nil, derrors.WrapErrorf(domain.ErrKnockoutSeason, derrors.ErrorCodeBadRequest, "%s", domain.ErrKnockoutSeason.Error())
+	}
+
+	teams, err := s.seasonRepo.FindTeams(ctx, opts.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err := domain.GenerateRoundRobin(teams, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fixtures are generated in round order, so the last one has the latest date
+	lastDate := fixtures[len(fixtures)-1].Match.MatchDate
+	if !season.Covers(opts.StartDate) || !season.Covers(lastDate) {
+		return nil, derrors.WrapErrorf(domain.ErrDateOutsideSeason, derrors.ErrorCodeBadRequest,
+			"fixtures run from %s to %s, outside season %s (%s to %s)",
+			opts.StartDate.Format("2006-01-02"), lastDate.Format("2006-01-02"),
+			season.Name, season.StartDate.Format("2006-01-02"), season.EndDate.Format("2006-01-02"))
+	}
+
+	if opts.DryRun {
+		return fixtures, nil
+	}
+
+	// Generating on top of existing matches would double up pairings
+	hasMatches, err := s.matchRepo.ExistsBySeasonID(ctx, opts.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if hasMatches {
+		return nil, derrors.WrapErrorf(domain.ErrSeasonHasFixtures, derrors.ErrorCodeDuplicate, "%s", domain.ErrSeasonHasFixtures.Error())
+	}
+
+	matches := make([]*domain.Match, len(fixtures))
+	for i, f := range fixtures {
+		matches[i] = f.Match
+	}
+	if err := s.matchRepo.CreateBatch(ctx, matches); err != nil {
+		return nil, err
+	}
+
+	return fixtures, nil
+}
+
+func (s *MatchService) DrawKnockout(ctx context.Context, opts domain.DrawOptions) (*domain.Bracket, error) {
+	season, err := s.seasonRepo.FindByID(ctx, opts.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if !season.IsKnockout() {
+		return nil, derrors.WrapErrorf(domain.ErrNotKnockoutSeason, derrors.ErrorCodeBadRequest, "%s", domain.ErrNotKnockoutSeason.Error())
+	}
+	if !season.Covers(opts.StartDate) {
+		return nil, derrors.WrapErrorf(domain.ErrDateOutsideSeason, derrors.ErrorCodeBadRequest, "start date must be between %s and %s", season.StartDate.Format("2006-01-02"), season.EndDate.Format("2006-01-02"))
+	}
+
+	drawn, err := s.bracketRepo.ExistsBySeasonID(ctx, opts.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if drawn {
+		return nil, derrors.WrapErrorf(domain.ErrBracketAlreadyDrawn, derrors.ErrorCodeDuplicate, "%s", domain.ErrBracketAlreadyDrawn.Error())
+	}
+
+	teams, err := s.seasonRepo.FindTeams(ctx, opts.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch opts.Method {
+	case domain.DrawSeeded:
+		if len(opts.SeedOrder) > 0 {
+			if teams, err = orderBySeed(teams, opts.SeedOrder); err != nil {
+				return nil, err
+			}
+		}
+	case domain.DrawRandom:
+		rand.Shuffle(len(teams), func(i, j int) { teams[i], teams[j] = teams[j], teams[i] })
+	}
+
+	bracket, matches, err := domain.NewBracket(teams, opts, season.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.bracketRepo.Create(ctx, bracket, matches); err != nil {
+		return nil, err
+	}
+
+	return s.bracketRepo.FindBySeasonID(ctx, opts.SeasonID)
+}
+
+func (s *MatchService) GetBracket(ctx context.Context, competitionID, seasonID string) (*domain.Bracket, error) {
+	var (
+		season *domain.Season
+		err    error
+	)
+	// Without an explicit season the most recent one is shown
+	if seasonID == "" {
+		season, err = s.seasonRepo.FindLatestByCompetitionID(ctx, competitionID)
+	} else {
+		season, err = s.seasonRepo.FindByID(ctx, seasonID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if season.CompetitionID != competitionID {
+		return nil, derrors.WrapErrorf(domain.ErrSeasonNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrSeasonNotFound.Error())
+	}
+	if !season.IsKnockout() {
+		return nil, derrors.WrapErrorf(domain.ErrNotKnockoutSeason, derrors.ErrorCodeBadRequest, "%s", domain.ErrNotKnockoutSeason.Error())
+	}
+
+	return s.bracketRepo.FindBySeasonID(ctx, season.ID)
+}
+
+// knockoutLeg is a reported match together with the tie it belongs to and the results of both legs.
+type knockoutLeg struct {
+	tie       *domain.Tie
+	rules     domain.KnockoutRules
+	firstLeg  *domain.MatchResult
+	secondLeg *domain.MatchResult
+}
+
+// checkScorers verifies the goal scorers and assists against the squads of both teams on the match date.
+func (s *MatchService) checkScorers(ctx context.Context, m *domain.Match, result *domain.MatchResult) error {
+	if len(result.Goals) == 0 {
+		return nil
+	}
+
+	playerIDs := make([]string, 0, len(result.Goals))
+	for _, g := range result.Goals {
+		playerIDs = append(playerIDs, g.PlayerID)
+		if g.AssistPlayerID != "" {
+			playerIDs = append(playerIDs, g.AssistPlayerID)
+		}
+	}
+
+	squad, err := s.squadRepo.FindPlayers(ctx, playerIDs, m.MatchDate)
+	if err != nil {
+		return err
+	}
+	if err := result.CheckScorers(squad, m.HomeTeamID, m.AwayTeamID); err != nil {
+		return err
+	}
+
+	limit, err := s.ageLimitOf(ctx, m)
+	if err != nil {
+		return err
+	}
+	if limit == nil {
+		return nil
+	}
+	return result.CheckEligibility(squad, *limit)
+}
+
+// ageLimitOf returns the age limit of the competition the match is played in, or nil when it has none.
+func (s *MatchService) ageLimitOf(ctx context.Context, m *domain.Match) (*domain.AgeLimit, error) {
+	if m.SeasonID == "" {
+		return nil, nil
+	}
+	season, err := s.seasonRepo.FindByID(ctx, m.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	return season.AgeLimit, nil
+}
+
+// recordAppearances works out from the lineups who played in the match and for how long.
+func (s *MatchService) recordAppearances(ctx context.Context, result *domain.MatchResult) error {
+	lineups, err := s.lineupRepo.FindByMatchID(ctx, result.MatchID)
+	if err != nil {
+		return err
+	}
+	result.RecordAppearances(lineups)
+	return nil
+}
+
+// checkSuspensions rejects goals scored by players who are banned from the match.
+func (s *MatchService) checkSuspensions(ctx context.Context, m *domain.Match, result *domain.MatchResult) error {
+	if m.SeasonID == "" || len(result.Goals) == 0 {
+		return nil
+	}
+
+	banned := make(map[string]bool)
+	for _, teamID := range []string{m.HomeTeamID, m.AwayTeamID} {
+		cards, err := s.disciplineRepo.FindCardsByTeam(ctx, teamID, m.SeasonID)
+		if err != nil {
+			return err
+		}
+		suspensions, err := s.suspensionsFor(ctx, cards)
+		if err != nil {
+			return err
+		}
+		for _, suspension := range suspensions {
+			if suspension.Covers(m.ID) {
+				banned[suspension.PlayerID] = true
+			}
+		}
+	}
+
+	var details []derrors.Detail
+	for i, g := range result.Goals {
+		if banned[g.PlayerID] {
+			details = append(details, derrors.Detail{
+				Field:   fmt.Sprintf("goals[%d].player_id", i),
+				Message: fmt.Sprintf("player %s is suspended for this match", g.PlayerID),
+			})
+		}
+	}
+	if len(details) > 0 {
+		return derrors.WithDetails(domain.ErrScorerSuspended, derrors.ErrorCodeBadRequest, details, "%s", domain.ErrScorerSuspended.Error())
+	}
+	return nil
+}
+
+// suspensionsFor works out the suspensions earned by the cards, one season and team at a time,
+// using the disciplinary rules of the season's competition. Cards from matches outside a season do not count.
+func (s *MatchService) suspensionsFor(ctx context.Context, cards []domain.BookedCard) ([]domain.Suspension, error) {
+	type seasonTeam struct{ seasonID, teamID string }
+	var order []seasonTeam
+	groups := make(map[seasonTeam][]domain.BookedCard)
+	for _, c := range cards {
+		if c.SeasonID == "" {
+			continue
+		}
+		key := seasonTeam{c.SeasonID, c.TeamID}
+		if _, seen := groups[key]; !seen {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], c)
+	}
+
+	suspensions := make([]domain.Suspension, 0)
+	for _, key := range order {
+		season, err := s.seasonRepo.FindByID(ctx, key.seasonID)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := s.matchRepo.FindAll(ctx, domain.MatchFilter{SeasonID: key.seasonID, TeamID: key.teamID})
+		if err != nil {
+			return nil, err
+		}
+		suspensions = append(suspensions, domain.ComputeSuspensions(season.Discipline, groups[key], matches)...)
+	}
+	return suspensions, nil
+}
+
+// checkKnockoutResult finds the knockout tie a result belongs to, if any, and checks the result
+// against the rules of the competition. Only knockout ties go to extra time or penalties.
+func (s *MatchService) checkKnockoutResult(ctx context.Context, m *domain.Match, result *domain.MatchResult) (*knockoutLeg, error) {
+	// Matches scheduled before seasons existed can never be part of a cup tie
+	var leg *knockoutLeg
+	if m.SeasonID != "" {
+		var err error
+		leg, err = s.findKnockoutLeg(ctx, m, result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if leg == nil {
+		if result.DecidedBy != domain.DecidedInRegulation {
+			return nil, derrors.WrapErrorf(domain.ErrNotKnockoutMatch, derrors.ErrorCodeBadRequest, "%s", domain.ErrNotKnockoutMatch.Error())
+		}
+		return nil, nil
+	}
+
+	if err := leg.tie.CheckResult(leg.rules, m.ID, leg.firstLeg, leg.secondLeg); err != nil {
+		return nil, err
+	}
+	return leg, nil
+}
+
+// findKnockoutLeg returns the tie the match is a leg of, or nil for matches outside a bracket.
+func (s *MatchService) findKnockoutLeg(ctx context.Context, match *domain.Match, result *domain.MatchResult) (*knockoutLeg, error) {
+	tie, err := s.bracketRepo.FindTieByMatchID(ctx, match.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTieNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	season, err := s.seasonRepo.FindByID(ctx, tie.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	legs := map[string]*domain.MatchResult{match.ID: result}
+	for _, legID := range []string{tie.FirstLegMatchID, tie.SecondLegMatchID} {
+		if legID == "" || legs[legID] != nil {
+			continue
+		}
+		legResult, err := s.resultRepo.FindByMatchID(ctx, legID)
+		if err != nil {
+			if errors.Is(err, domain.ErrMatchResultNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		legs[legID] = legResult
+	}
+
+	return &knockoutLeg{
+		tie:       tie,
+		rules:     season.Rules,
+		firstLeg:  legs[tie.FirstLegMatchID],
+		secondLeg: legs[tie.SecondLegMatchID],
+	}, nil
+}
+
+// advanceKnockout works out how the winner of a finished cup tie moves into the next round of the
+// bracket, to be saved together with the result that decided it. Ties still level advance nobody.
+func (s *MatchService) advanceKnockout(ctx context.Context, leg *knockoutLeg) (*domain.Advancement, error) {
+	winner, decided := leg.tie.Decide(leg.rules, leg.firstLeg, leg.secondLeg)
+	if !decided {
+		return nil, nil
+	}
+
+	bracket, err := s.bracketRepo.FindBySeasonID(ctx, leg.tie.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.seasonRepo.FindTeams(ctx, leg.tie.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	ties, matches, err := bracket.RecordWinner(leg.tie.ID, winner, leg.rules, teams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Advancement{Ties: ties, Matches: matches}, nil
+}
+
+// orderBySeed reorders the registered teams to follow the requested seed order.
+func orderBySeed(teams []domain.SeasonTeam, seedOrder []string) ([]domain.SeasonTeam, error) {
+	invalid := derrors.WrapErrorf(domain.ErrInvalidSeedOrder, derrors.ErrorCodeBadRequest, "%s", domain.ErrInvalidSeedOrder.Error())
+	if len(seedOrder) != len(teams) {
+		return nil, invalid
+	}
+
+	byID := make(map[string]domain.SeasonTeam, len(teams))
+	for _, t := range teams {
+		byID[t.TeamID] = t
+	}
+
+	ordered := make([]domain.SeasonTeam, 0, len(teams))
+	for _, id := range seedOrder {
+		team, ok := byID[id]
+		if !ok {
+			return nil, invalid
+		}
+		delete(byID, id)
+		ordered = append(ordered, team)
+	}
+	return ordered, nil
+}
+
+// checkSeasonDates ensures a moved match still falls within its season.
+// Matches scheduled before seasons existed have no dates to respect.
+func (s *MatchService) checkSeasonDates(ctx context.Context, match *domain.Match) error {
+	if match.SeasonID == "" {
+		return nil
+	}
+
+	season, err := s.seasonRepo.FindByID(ctx, match.SeasonID)
+	if err != nil {
+		return err
+	}
+	if !season.Covers(match.MatchDate) {
+		return derrors.WrapErrorf(domain.ErrDateOutsideSeason, derrors.ErrorCodeBadRequest, "match date must be between %s and %s", season.StartDate.Format("2006-01-02"), season.EndDate.Format("2006-01-02"))
+	}
+	return nil
+}
+
+// checkConflicts ensures the match does not clash with the matches around it: neither team may play
+// twice at once or without enough rest, and the venue may not host two matches at once.
+// Forcing the schedule skips the check.
+func (s *MatchService) checkConflicts(ctx context.Context, match *domain.Match, force bool) error {
+	if force {
+		return nil
+	}
+
+	from, to := s.rules.ConflictWindow(match)
+	nearby, err := s.matchRepo.FindNearby(ctx, match, from, to)
+	if err != nil {
+		return err
+	}
+
+	conflicts := s.rules.ScheduleConflicts(match, nearby)
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	details := make([]derrors.Detail, len(conflicts))
+	for i, c := range conflicts {
+		details[i] = c.Detail()
+	}
+	return derrors.WithDetails(domain.ErrScheduleConflict, derrors.ErrorCodeBadRequest, details, "%s", domain.ErrScheduleConflict.Error())
+}
+
+// checkOfficials ensures every official of a moved match is free on its new day, as when they were assigned.
+// Unlike clashes between matches this cannot be forced; the official has to be unassigned first.
+func (s *MatchService) checkOfficials(ctx context.Context, match *domain.Match) error {
+	officials, err := s.officialRepo.FindByMatchID(ctx, match.ID)
+	if err != nil {
+		return err
+	}
+	if len(officials) == 0 {
+		return nil
+	}
+
+	from, to := match.Day()
+	sameDay := make(map[string][]domain.Match, len(officials))
+	for _, o := range officials {
+		matches, err := s.officialRepo.FindMatches(ctx, o.OfficialID, from, to)
+		if err != nil {
+			return err
+		}
+		sameDay[o.OfficialID] = matches
+	}
+
+	return match.CheckOfficials(officials, sameDay)
+}
+
+// validateSeason ensures the match falls within its season and that both teams take part in it.
+func (s *MatchService) validateSeason(ctx context.Context, match *domain.Match) error {
+	season, err := s.seasonRepo.FindByID(ctx, match.SeasonID)
+	if err != nil {
+		return err
+	}
+
+	if !season.Covers(match.MatchDate) {
+		return derrors.WrapErrorf(domain.ErrDateOutsideSeason, derrors.ErrorCodeBadRequest, "match date must be between %s and %s", season.StartDate.Format("2006-01-02"), season.EndDate.Format("2006-01-02"))
+	}
+
+	for _, teamID := range []string{match.HomeTeamID, match.AwayTeamID} {
+		registered, err := s.seasonRepo.IsTeamRegistered(ctx, season.ID, teamID)
+		if err != nil {
+			return err
+		}
+		if !registered {
+			return derrors.WrapErrorf(domain.ErrTeamNotInSeason, derrors.ErrorCodeBadRequest, "team %s is not registered in season %s", teamID, season.Name)
+		}
+	}
+
+	return nil
+}
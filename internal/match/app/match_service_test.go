@@ -3,6 +3,8 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,18 +19,128 @@ func setupMatchService(t *testing.T) (
 	*mockDomain.MockMatchRepository,
 	*mockDomain.MockMatchResultRepository,
 	*mockDomain.MockReportRepository,
+) {
+	svc, mockMatchRepo, mockResultRepo, mockReportRepo, _ := setupMatchServiceWithSeason(t)
+	return svc, mockMatchRepo, mockResultRepo, mockReportRepo
+}
+
+func setupMatchServiceWithSeason(t *testing.T) (
+	*MatchService,
+	*mockDomain.MockMatchRepository,
+	*mockDomain.MockMatchResultRepository,
+	*mockDomain.MockReportRepository,
+	*mockDomain.MockSeasonRepository,
 ) {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	mockMatchRepo := mockDomain.NewMockMatchRepository(ctrl)
 	mockResultRepo := mockDomain.NewMockMatchResultRepository(ctrl)
 	mockReportRepo := mockDomain.NewMockReportRepository(ctrl)
+	mockSeasonRepo := mockDomain.NewMockSeasonRepository(ctrl)
+	svc := &MatchService{
+		matchRepo:      mockMatchRepo,
+		resultRepo:     mockResultRepo,
+		reportRepo:     mockReportRepo,
+		seasonRepo:     mockSeasonRepo,
+		squadRepo:      mockDomain.NewMockSquadRepository(ctrl),
+		disciplineRepo: mockDomain.NewMockDisciplineRepository(ctrl),
+		lineupRepo:     mockDomain.NewMockLineupRepository(ctrl),
+		liveRepo:       mockDomain.NewMockLiveEventRepository(ctrl),
+		liveBroker:     mockDomain.NewMockLiveEventBroker(ctrl),
+		venueRepo:      mockDomain.NewMockVenueRepository(ctrl),
+		officialRepo:   mockDomain.NewMockOfficialRepository(ctrl),
+		rules:          domain.SchedulingRules{MatchDuration: 2 * time.Hour, MinRestDays: 2},
+	}
+	return svc, mockMatchRepo, mockResultRepo, mockReportRepo, mockSeasonRepo
+}
+
+func setupKnockoutService(t *testing.T) (
+	*MatchService,
+	*mockDomain.MockMatchRepository,
+	*mockDomain.MockMatchResultRepository,
+	*mockDomain.MockSeasonRepository,
+	*mockDomain.MockBracketRepository,
+) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockMatchRepo := mockDomain.NewMockMatchRepository(ctrl)
+	mockResultRepo := mockDomain.NewMockMatchResultRepository(ctrl)
+	mockSeasonRepo := mockDomain.NewMockSeasonRepository(ctrl)
+	mockBracketRepo := mockDomain.NewMockBracketRepository(ctrl)
 	svc := &MatchService{
-		matchRepo:  mockMatchRepo,
-		resultRepo: mockResultRepo,
-		reportRepo: mockReportRepo,
+		matchRepo:      mockMatchRepo,
+		resultRepo:     mockResultRepo,
+		seasonRepo:     mockSeasonRepo,
+		bracketRepo:    mockBracketRepo,
+		squadRepo:      mockDomain.NewMockSquadRepository(ctrl),
+		disciplineRepo: mockDomain.NewMockDisciplineRepository(ctrl),
+		lineupRepo:     mockDomain.NewMockLineupRepository(ctrl),
+		venueRepo:      mockDomain.NewMockVenueRepository(ctrl),
+		officialRepo:   mockDomain.NewMockOfficialRepository(ctrl),
+		rules:          domain.SchedulingRules{MatchDuration: 2 * time.Hour, MinRestDays: 2},
+	}
+	return svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo
+}
+
+// expectScorersInSquad makes every goal scorer a squad member of the team the goal is credited to,
+// with no cards shown to either team so nobody is suspended.
+func expectScorersInSquad(svc *MatchService, goals []domain.Goal) *gomock.Call {
+	squad := make([]domain.SquadPlayer, len(goals))
+	for i, g := range goals {
+		squad[i] = domain.SquadPlayer{ID: g.PlayerID, TeamID: g.TeamID}
+	}
+	svc.disciplineRepo.(*mockDomain.MockDisciplineRepository).EXPECT().FindCardsByTeam(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(gomock.Any(), gomock.Any(), gomock.Any()).Return(squad, nil)
+}
+
+// expectVenue makes the venue exist in Western Indonesian Time.
+func expectVenue(svc *MatchService, venueID, name string) *gomock.Call {
+	return svc.venueRepo.(*mockDomain.MockVenueRepository).EXPECT().FindByID(gomock.Any(), venueID).
+		Return(&domain.Venue{ID: venueID, Name: name, Timezone: domain.DefaultTimezone}, nil)
+}
+
+// expectNoConflicts leaves the calendar around the match empty.
+func expectNoConflicts(mockMatchRepo *mockDomain.MockMatchRepository) *gomock.Call {
+	return mockMatchRepo.EXPECT().FindNearby(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+}
+
+// expectNoOfficials leaves the match without officials, so moving it never keeps one busy twice in a day.
+func expectNoOfficials(svc *MatchService) *gomock.Call {
+	return svc.officialRepo.(*mockDomain.MockOfficialRepository).EXPECT().FindByMatchID(gomock.Any(), gomock.Any()).Return(nil, nil)
+}
+
+// expectNoLineups leaves both teams without a lineup, so no appearances are recorded with the result.
+func expectNoLineups(svc *MatchService) *gomock.Call {
+	return svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(gomock.Any(), gomock.Any()).Return(nil, nil)
+}
+
+// upcomingMatchDate returns a kickoff date safely in the future so date validation never goes stale.
+func upcomingMatchDate() time.Time {
+	d := time.Now().AddDate(0, 0, 7)
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// onCalendar sets the kickoff of a match from its date and time in Western Indonesian Time, as the repository does on read.
+func onCalendar(m domain.Match) domain.Match {
+	_ = m.SetKickoff(wibKickoff(m.MatchDate, m.MatchTime), domain.DefaultTimezone)
+	return m
+}
+
+// wibKickoff returns the moment of kickoff at the HH:MM time on the date in Western Indonesian Time.
+func wibKickoff(date time.Time, hhmm string) time.Time {
+	loc, _ := time.LoadLocation(domain.DefaultTimezone)
+	t, _ := time.Parse("15:04", hhmm)
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+}
+
+// activeSeason returns a season that covers upcomingMatchDate.
+func activeSeason() *domain.Season {
+	return &domain.Season{
+		ID:        "season-1",
+		Name:      "2026/2027",
+		StartDate: time.Now().AddDate(0, -1, 0),
+		EndDate:   time.Now().AddDate(0, 6, 0),
 	}
-	return svc, mockMatchRepo, mockResultRepo, mockReportRepo
 }
 
 func assertMatchErrorCode(t *testing.T, err error, expectedCode derrors.ErrorCode) {
@@ -47,19 +159,26 @@ func assertMatchErrorCode(t *testing.T, err error, expectedCode derrors.ErrorCod
 // ---------------------------------------------------------------------------
 
 func TestMatchService_CreateMatch_Success(t *testing.T) {
-	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
 	ctx := context.Background()
 	input := &domain.Match{
+		SeasonID:   "season-1",
 		HomeTeamID: "team-1",
 		AwayTeamID: "team-2",
-		MatchDate:  time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		MatchDate:  upcomingMatchDate(),
 		MatchTime:  "19:30",
-		Stadium:    "Gelora Bung Karno",
+		VenueID:    "venue-gbk",
 	}
 
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", "team-1").Return(true, nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", "team-2").Return(true, nil)
+	expectNoConflicts(mockMatchRepo)
 	mockMatchRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 
-	id, err := svc.CreateMatch(ctx, input)
+	id, err := svc.CreateMatch(ctx, input, false)
 
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
@@ -73,14 +192,17 @@ func TestMatchService_CreateMatch_SameTeamError(t *testing.T) {
 	svc, _, _, _ := setupMatchService(t)
 	ctx := context.Background()
 	input := &domain.Match{
+		SeasonID:   "season-1",
 		HomeTeamID: "team-1",
 		AwayTeamID: "team-1",
-		MatchDate:  time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		MatchDate:  upcomingMatchDate(),
 		MatchTime:  "19:30",
-		Stadium:    "Gelora Bung Karno",
+		VenueID:    "venue-gbk",
 	}
 
-	id, err := svc.CreateMatch(ctx, input)
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	id, err := svc.CreateMatch(ctx, input, false)
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -95,14 +217,17 @@ func TestMatchService_CreateMatch_InvalidTime(t *testing.T) {
 	svc, _, _, _ := setupMatchService(t)
 	ctx := context.Background()
 	input := &domain.Match{
+		SeasonID:   "season-1",
 		HomeTeamID: "team-1",
 		AwayTeamID: "team-2",
-		MatchDate:  time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		MatchDate:  upcomingMatchDate(),
 		MatchTime:  "25:00",
-		Stadium:    "Gelora Bung Karno",
+		VenueID:    "venue-gbk",
 	}
 
-	id, err := svc.CreateMatch(ctx, input)
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	id, err := svc.CreateMatch(ctx, input, false)
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -117,13 +242,14 @@ func TestMatchService_CreateMatch_EmptyHomeTeam(t *testing.T) {
 	svc, _, _, _ := setupMatchService(t)
 	ctx := context.Background()
 	input := &domain.Match{
+		SeasonID:   "season-1",
 		HomeTeamID: "",
 		AwayTeamID: "team-2",
-		MatchDate:  time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		MatchDate:  upcomingMatchDate(),
 		MatchTime:  "19:30",
 	}
 
-	id, err := svc.CreateMatch(ctx, input)
+	id, err := svc.CreateMatch(ctx, input, false)
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -138,13 +264,17 @@ func TestMatchService_CreateMatch_PastDate(t *testing.T) {
 	svc, _, _, _ := setupMatchService(t)
 	ctx := context.Background()
 	input := &domain.Match{
+		SeasonID:   "season-1",
 		HomeTeamID: "team-1",
 		AwayTeamID: "team-2",
 		MatchDate:  time.Now().AddDate(0, 0, -1), // yesterday
 		MatchTime:  "19:00",
+		VenueID:    "venue-gbk",
 	}
 
-	id, err := svc.CreateMatch(ctx, input)
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	id, err := svc.CreateMatch(ctx, input, false)
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -156,19 +286,25 @@ func TestMatchService_CreateMatch_PastDate(t *testing.T) {
 }
 
 func TestMatchService_CreateMatch_RepoError(t *testing.T) {
-	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
 	ctx := context.Background()
 	input := &domain.Match{
+		SeasonID:   "season-1",
 		HomeTeamID: "team-1",
 		AwayTeamID: "team-2",
-		MatchDate:  time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		MatchDate:  upcomingMatchDate(),
 		MatchTime:  "19:30",
-		Stadium:    "Gelora Bung Karno",
+		VenueID:    "venue-gbk",
 	}
 
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", gomock.Any()).Return(true, nil).Times(2)
+	expectNoConflicts(mockMatchRepo)
 	mockMatchRepo.EXPECT().Create(ctx, gomock.Any()).Return(derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to create match"))
 
-	id, err := svc.CreateMatch(ctx, input)
+	id, err := svc.CreateMatch(ctx, input, false)
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -179,16 +315,318 @@ func TestMatchService_CreateMatch_RepoError(t *testing.T) {
 	}
 }
 
+func TestMatchService_CreateMatch_MissingSeason(t *testing.T) {
+	svc, _, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  upcomingMatchDate(),
+		MatchTime:  "19:30",
+		VenueID:    "venue-gbk",
+	}
+
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	id, err := svc.CreateMatch(ctx, input, false)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
+func TestMatchService_CreateMatch_SeasonNotFound(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		SeasonID:   "missing",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  upcomingMatchDate(),
+		MatchTime:  "19:30",
+		VenueID:    "venue-gbk",
+	}
+
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "missing").Return(nil, derrors.WrapErrorf(domain.ErrSeasonNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrSeasonNotFound.Error()))
+
+	id, err := svc.CreateMatch(ctx, input, false)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrSeasonNotFound) {
+		t.Fatalf("expected ErrSeasonNotFound, got: %v", err)
+	}
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
+func TestMatchService_CreateMatch_DateOutsideSeason(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  time.Now().AddDate(1, 0, 0),
+		MatchTime:  "19:30",
+		VenueID:    "venue-gbk",
+	}
+
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+
+	id, err := svc.CreateMatch(ctx, input, false)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrDateOutsideSeason) {
+		t.Fatalf("expected ErrDateOutsideSeason, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
+func TestMatchService_CreateMatch_TeamNotInSeason(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-9",
+		MatchDate:  upcomingMatchDate(),
+		MatchTime:  "19:30",
+		VenueID:    "venue-gbk",
+	}
+
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", "team-1").Return(true, nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", "team-9").Return(false, nil)
+
+	id, err := svc.CreateMatch(ctx, input, false)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrTeamNotInSeason) {
+		t.Fatalf("expected ErrTeamNotInSeason, got: %v", err)
+	}
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
+func TestMatchService_CreateMatch_KickoffInVenueTimezone(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	matchDate := upcomingMatchDate()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  matchDate,
+		MatchTime:  "19:30",
+		VenueID:    "venue-mandala",
+	}
+
+	svc.venueRepo.(*mockDomain.MockVenueRepository).EXPECT().FindByID(ctx, "venue-mandala").
+		Return(&domain.Venue{ID: "venue-mandala", Name: "Stadion Mandala", Timezone: "Asia/Jayapura"}, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", gomock.Any()).Return(true, nil).Times(2)
+	expectNoConflicts(mockMatchRepo)
+	mockMatchRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, match *domain.Match) error {
+		// 19:30 in Eastern Indonesian Time (UTC+9) is 10:30 UTC
+		want := time.Date(matchDate.Year(), matchDate.Month(), matchDate.Day(), 10, 30, 0, 0, time.UTC)
+		if !match.KickoffAt.Equal(want) || match.Timezone != "Asia/Jayapura" {
+			t.Fatalf("expected kickoff at %s in Asia/Jayapura, got %s in %q", want, match.KickoffAt.UTC(), match.Timezone)
+		}
+		if match.MatchTime != "19:30" {
+			t.Fatalf("expected kickoff at 19:30 on the venue clock, got %q", match.MatchTime)
+		}
+		return nil
+	})
+
+	_, err := svc.CreateMatch(ctx, input, false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestMatchService_CreateMatch_DefaultsToHomeVenue(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  upcomingMatchDate(),
+		MatchTime:  "19:30",
+	}
+
+	svc.venueRepo.(*mockDomain.MockVenueRepository).EXPECT().FindHomeVenueID(ctx, "team-1").Return("venue-gbk", nil)
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", gomock.Any()).Return(true, nil).Times(2)
+	expectNoConflicts(mockMatchRepo)
+	mockMatchRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, match *domain.Match) error {
+		if match.VenueID != "venue-gbk" {
+			t.Fatalf("expected match at the home team's venue, got %q", match.VenueID)
+		}
+		return nil
+	})
+
+	_, err := svc.CreateMatch(ctx, input, false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestMatchService_CreateMatch_HomeTeamWithoutVenue(t *testing.T) {
+	svc, _, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  upcomingMatchDate(),
+		MatchTime:  "19:30",
+	}
+
+	svc.venueRepo.(*mockDomain.MockVenueRepository).EXPECT().FindHomeVenueID(ctx, "team-1").Return("", nil)
+
+	_, err := svc.CreateMatch(ctx, input, false)
+
+	if !errors.Is(err, domain.ErrTeamWithoutVenue) {
+		t.Fatalf("expected ErrTeamWithoutVenue, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_CreateMatch_VenueNotFound(t *testing.T) {
+	svc, _, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  upcomingMatchDate(),
+		MatchTime:  "19:30",
+		VenueID:    "missing",
+	}
+
+	svc.venueRepo.(*mockDomain.MockVenueRepository).EXPECT().FindByID(ctx, "missing").
+		Return(nil, derrors.WrapErrorf(domain.ErrVenueNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrVenueNotFound.Error()))
+
+	_, err := svc.CreateMatch(ctx, input, false)
+
+	if !errors.Is(err, domain.ErrVenueNotFound) {
+		t.Fatalf("expected ErrVenueNotFound, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+func TestMatchService_CreateMatch_Conflicts(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	matchDate := upcomingMatchDate()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  matchDate,
+		MatchTime:  "19:30",
+		VenueID:    "venue-gbk",
+	}
+	nearby := []domain.Match{
+		// team-1 kicks off an hour later elsewhere
+		onCalendar(domain.Match{ID: "match-2", HomeTeamID: "team-3", AwayTeamID: "team-1", MatchDate: matchDate, MatchTime: "20:30", VenueID: "venue-jis", Status: domain.StatusScheduled}),
+		// The venue is taken until 20:00
+		onCalendar(domain.Match{ID: "match-3", HomeTeamID: "team-4", AwayTeamID: "team-5", MatchDate: matchDate, MatchTime: "18:00", VenueID: "venue-gbk", Status: domain.StatusScheduled}),
+		// The venue is free again in time for a late kickoff
+		onCalendar(domain.Match{ID: "match-4", HomeTeamID: "team-6", AwayTeamID: "team-7", MatchDate: matchDate, MatchTime: "21:30", VenueID: "venue-gbk", Status: domain.StatusScheduled}),
+		// Cancelled matches are not played
+		onCalendar(domain.Match{ID: "match-5", HomeTeamID: "team-2", AwayTeamID: "team-8", MatchDate: matchDate, MatchTime: "19:30", VenueID: "venue-gbk", Status: domain.StatusCancelled}),
+	}
+
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", gomock.Any()).Return(true, nil).Times(2)
+	kickoff := wibKickoff(matchDate, "19:30")
+	mockMatchRepo.EXPECT().FindNearby(ctx, gomock.Any(), kickoff.AddDate(0, 0, -2), kickoff.AddDate(0, 0, 2)).Return(nearby, nil)
+
+	_, err := svc.CreateMatch(ctx, input, false)
+
+	if !errors.Is(err, domain.ErrScheduleConflict) {
+		t.Fatalf("expected ErrScheduleConflict, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+	var dErr *derrors.Error
+	errors.As(err, &dErr)
+	details := dErr.Details()
+	if len(details) != 2 {
+		t.Fatalf("expected 2 conflicts, got %+v", details)
+	}
+	if details[0].Field != "home_team_id" || !strings.Contains(details[0].Message, string(domain.ConflictTeamDoubleBooked)) {
+		t.Fatalf("expected team-1 to be double-booked, got %+v", details[0])
+	}
+	if details[1].Field != "venue_id" || !strings.Contains(details[1].Message, "match-3") {
+		t.Fatalf("expected the venue to overlap with match-3, got %+v", details[1])
+	}
+}
+
+func TestMatchService_CreateMatch_Force(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	input := &domain.Match{
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  upcomingMatchDate(),
+		MatchTime:  "19:30",
+		VenueID:    "venue-gbk",
+	}
+
+	expectVenue(svc, "venue-gbk", "Gelora Bung Karno")
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().IsTeamRegistered(ctx, "season-1", gomock.Any()).Return(true, nil).Times(2)
+	mockMatchRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	if _, err := svc.CreateMatch(ctx, input, true); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // GetMatchByID
 // ---------------------------------------------------------------------------
 
 func TestMatchService_GetMatchByID_Success(t *testing.T) {
-	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
 	ctx := context.Background()
 	expected := &domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2"}
 
 	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(expected, nil)
+	mockResultRepo.EXPECT().FindCards(ctx, "match-1").Return([]domain.Card{
+		{PlayerID: "player-3", TeamID: "team-2", Type: domain.CardYellow, Minute: 20},
+	}, nil)
+	svc.officialRepo.(*mockDomain.MockOfficialRepository).EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.MatchOfficial{
+		{MatchID: "match-1", OfficialID: "official-1", OfficialName: "Thoriq Alkatiri", Role: domain.RoleReferee},
+	}, nil)
 
 	match, err := svc.GetMatchByID(ctx, "match-1")
 
@@ -201,6 +639,12 @@ func TestMatchService_GetMatchByID_Success(t *testing.T) {
 	if match.ID != "match-1" {
 		t.Fatalf("expected match.ID = %q, got %q", "match-1", match.ID)
 	}
+	if len(match.Cards) != 1 {
+		t.Fatalf("expected 1 card, got %d", len(match.Cards))
+	}
+	if len(match.Officials) != 1 || match.Officials[0].Role != domain.RoleReferee {
+		t.Fatalf("expected the referee to be listed, got %+v", match.Officials)
+	}
 }
 
 func TestMatchService_GetMatchByID_NotFound(t *testing.T) {
@@ -234,9 +678,9 @@ func TestMatchService_GetAllMatches_Success(t *testing.T) {
 		{ID: "match-2", HomeTeamID: "team-3", AwayTeamID: "team-4"},
 	}
 
-	mockMatchRepo.EXPECT().FindAll(ctx).Return(expected, nil)
+	mockMatchRepo.EXPECT().FindAll(ctx, domain.MatchFilter{}).Return(expected, nil)
 
-	matches, err := svc.GetAllMatches(ctx)
+	matches, err := svc.GetAllMatches(ctx, domain.MatchFilter{})
 
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
@@ -250,9 +694,9 @@ func TestMatchService_GetAllMatches_RepoError(t *testing.T) {
 	svc, mockMatchRepo, _, _ := setupMatchService(t)
 	ctx := context.Background()
 
-	mockMatchRepo.EXPECT().FindAll(ctx).Return(nil, derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to fetch matches"))
+	mockMatchRepo.EXPECT().FindAll(ctx, domain.MatchFilter{}).Return(nil, derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to fetch matches"))
 
-	matches, err := svc.GetAllMatches(ctx)
+	matches, err := svc.GetAllMatches(ctx, domain.MatchFilter{})
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -281,10 +725,12 @@ func TestMatchService_ReportResult_Success(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
-	mockResultRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).Return(nil)
 
+	expectScorersInSquad(svc, result.Goals)
 	id, err := svc.ReportResult(ctx, matchID, result)
 
 	if err != nil {
@@ -295,63 +741,339 @@ func TestMatchService_ReportResult_Success(t *testing.T) {
 	}
 }
 
-func TestMatchService_ReportResult_MatchNotFound(t *testing.T) {
-	svc, mockMatchRepo, _, _ := setupMatchService(t)
+func TestMatchService_ReportResult_ScorersNotInSquad(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
 	ctx := context.Background()
-	matchID := "nonexistent"
-	result := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
-		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10},
-	}}
+	matchDate := time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC)
+	result := &domain.MatchResult{
+		HomeScore: 2,
+		AwayScore: 1,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 15},
+			{PlayerID: "player-2", TeamID: "team-1", GoalMinute: 45},
+			{PlayerID: "player-9", TeamID: "team-2", GoalMinute: 70},
+		},
+	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(nil, domain.ErrMatchNotFound)
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", MatchDate: matchDate, Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), matchDate).Return([]domain.SquadPlayer{
+		{ID: "player-1", Name: "Budi", TeamID: "team-1"},
+		{ID: "player-2", Name: "Andi", TeamID: "team-2"},
+	}, nil)
 
-	id, err := svc.ReportResult(ctx, matchID, result)
+	_, err := svc.ReportResult(ctx, "match-1", result)
 
-	if err == nil {
-		t.Fatal("expected error, got nil")
+	if !errors.Is(err, domain.ErrScorerNotInSquad) {
+		t.Fatalf("expected ErrScorerNotInSquad, got: %v", err)
 	}
-	if !errors.Is(err, domain.ErrMatchNotFound) {
-		t.Fatalf("expected ErrMatchNotFound, got: %v", err)
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+
+	var derr *derrors.Error
+	if !errors.As(err, &derr) {
+		t.Fatalf("expected *derrors.Error, got %T", err)
 	}
-	if id != "" {
-		t.Fatalf("expected empty ID on error, got %q", id)
+	details := derr.Details()
+	if len(details) != 2 {
+		t.Fatalf("expected 2 error details, got %d: %v", len(details), details)
+	}
+	if details[0].Field != "goals[1].player_id" || details[1].Field != "goals[2].player_id" {
+		t.Fatalf("expected details for goals 1 and 2, got %q and %q", details[0].Field, details[1].Field)
 	}
 }
 
-func TestMatchService_ReportResult_AlreadyExists(t *testing.T) {
+func TestMatchService_ReportResult_GoallessSkipsSquadCheck(t *testing.T) {
 	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
 	ctx := context.Background()
-	matchID := "match-1"
-	result := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
-		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10},
-	}}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
-	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(true, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).Return(nil)
 
-	id, err := svc.ReportResult(ctx, matchID, result)
+	_, err := svc.ReportResult(ctx, "match-1", &domain.MatchResult{HomeScore: 0, AwayScore: 0})
 
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if !errors.Is(err, domain.ErrResultAlreadyExists) {
-		t.Fatalf("expected ErrResultAlreadyExists, got: %v", err)
-	}
-	assertMatchErrorCode(t, err, derrors.ErrorCodeDuplicate)
-	if id != "" {
-		t.Fatalf("expected empty ID on error, got %q", id)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
 	}
 }
 
-func TestMatchService_ReportResult_ExistsCheckRepoError(t *testing.T) {
+func TestMatchService_ReportResult_OwnGoalCountsForOpponent(t *testing.T) {
 	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
 	ctx := context.Background()
-	matchID := "match-1"
-	result := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-3", TeamID: "team-1", Type: domain.GoalOwnGoal, GoalMinute: 55},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, []string{"player-3"}, gomock.Any()).Return([]domain.SquadPlayer{
+		{ID: "player-3", Name: "Rizky", TeamID: "team-2"},
+	}, nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).Return(nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestMatchService_ReportResult_OwnGoalByScoringTeam(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", Type: domain.GoalOwnGoal, GoalMinute: 55},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), gomock.Any()).Return([]domain.SquadPlayer{
+		{ID: "player-1", Name: "Budi", TeamID: "team-1"},
+	}, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if !errors.Is(err, domain.ErrScorerNotInSquad) {
+		t.Fatalf("expected ErrScorerNotInSquad, got: %v", err)
+	}
+}
+
+func TestMatchService_ReportResult_OwnGoalWithAssist(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-3", TeamID: "team-1", Type: domain.GoalOwnGoal, AssistPlayerID: "player-1", GoalMinute: 55},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_AssistFromOpponent(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", Type: domain.GoalHeader, AssistPlayerID: "player-3", GoalMinute: 20},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, []string{"player-1", "player-3"}, gomock.Any()).Return([]domain.SquadPlayer{
+		{ID: "player-1", Name: "Budi", TeamID: "team-1"},
+		{ID: "player-3", Name: "Rizky", TeamID: "team-2"},
+	}, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	var derr *derrors.Error
+	if !errors.As(err, &derr) {
+		t.Fatalf("expected *derrors.Error, got %T", err)
+	}
+	details := derr.Details()
+	if len(details) != 1 || details[0].Field != "goals[0].assist_player_id" {
+		t.Fatalf("expected a single detail for the assist, got %v", details)
+	}
+}
+
+func TestMatchService_ReportResult_UnknownGoalType(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", Type: "bicycle_kick", GoalMinute: 20},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_WithCards(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 30},
+		},
+		Cards: []domain.Card{
+			{PlayerID: "player-3", TeamID: "team-2", Type: domain.CardYellow, Minute: 20, Reason: "Dissent"},
+			{PlayerID: "player-3", TeamID: "team-2", Type: domain.CardSecondYellow, Minute: 70, Reason: "Late tackle"},
+			{PlayerID: "player-1", TeamID: "team-1", Type: domain.CardYellow, Minute: 31, Reason: "Excessive celebration"},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	expectScorersInSquad(svc, result.Goals)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).DoAndReturn(func(_ context.Context, saved *domain.MatchResult, _ *domain.Advancement) error {
+		if len(saved.Cards) != 3 {
+			t.Fatalf("expected 3 cards to be saved, got %d", len(saved.Cards))
+		}
+		for _, c := range saved.Cards {
+			if c.ID == "" || c.ResultID != saved.ID {
+				t.Fatalf("expected card to be linked to result %s, got %+v", saved.ID, c)
+			}
+		}
+		return nil
+	})
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestMatchService_ReportResult_ScorerSentOff(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 80},
+		},
+		Cards: []domain.Card{
+			{PlayerID: "player-1", TeamID: "team-1", Type: domain.CardRed, Minute: 60, Reason: "Violent conduct"},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if !errors.Is(err, domain.ErrSentOffPlayerScored) {
+		t.Fatalf("expected ErrSentOffPlayerScored, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_SecondYellowWithoutFirst(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		Goals: []domain.Goal{},
+		Cards: []domain.Card{
+			{PlayerID: "player-3", TeamID: "team-2", Type: domain.CardSecondYellow, Minute: 70},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_CardAfterSendingOff(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{
+		Goals: []domain.Goal{},
+		Cards: []domain.Card{
+			{PlayerID: "player-3", TeamID: "team-2", Type: domain.CardYellow, Minute: 85},
+			{PlayerID: "player-3", TeamID: "team-2", Type: domain.CardRed, Minute: 40},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_MatchNotFound(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	matchID := "nonexistent"
+	result := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(nil, domain.ErrMatchNotFound)
+
+	id, err := svc.ReportResult(ctx, matchID, result)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got: %v", err)
+	}
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
+func TestMatchService_ReportResult_AlreadyExists(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	matchID := "match-1"
+	result := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(true, nil)
+
+	id, err := svc.ReportResult(ctx, matchID, result)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrResultAlreadyExists) {
+		t.Fatalf("expected ErrResultAlreadyExists, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeDuplicate)
+	if id != "" {
+		t.Fatalf("expected empty ID on error, got %q", id)
+	}
+}
+
+func TestMatchService_ReportResult_ExistsCheckRepoError(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	matchID := "match-1"
+	result := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
 		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10},
 	}}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to check result"))
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -379,7 +1101,7 @@ func TestMatchService_ReportResult_ScoreMismatch(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -403,7 +1125,7 @@ func TestMatchService_ReportResult_NegativeScore(t *testing.T) {
 		Goals:     []domain.Goal{},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -429,7 +1151,7 @@ func TestMatchService_ReportResult_InvalidGoalMinute(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -455,7 +1177,7 @@ func TestMatchService_ReportResult_GoalMinuteExceedsMax(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -481,7 +1203,7 @@ func TestMatchService_ReportResult_MissingPlayerID(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -507,7 +1229,7 @@ func TestMatchService_ReportResult_MissingTeamID(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -531,9 +1253,10 @@ func TestMatchService_ReportResult_DrawWithNoGoals(t *testing.T) {
 		Goals:     []domain.Goal{},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
-	mockResultRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).Return(nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
 
@@ -559,7 +1282,7 @@ func TestMatchService_ReportResult_HomeScoreMismatch(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -586,7 +1309,7 @@ func TestMatchService_ReportResult_AwayScoreMismatch(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -613,7 +1336,7 @@ func TestMatchService_ReportResult_InvalidGoalTeamID(t *testing.T) {
 		},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
 
 	id, err := svc.ReportResult(ctx, matchID, result)
@@ -637,9 +1360,10 @@ func TestMatchService_ReportResult_SaveRepoError(t *testing.T) {
 		Goals:     []domain.Goal{},
 	}
 
-	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", Stadium: "Gelora Bung Karno"}, nil)
+	mockMatchRepo.EXPECT().FindByID(ctx, matchID).Return(&domain.Match{ID: matchID, HomeTeamID: "team-1", AwayTeamID: "team-2", VenueID: "venue-gbk", Status: domain.StatusLive}, nil)
 	mockResultRepo.EXPECT().ExistsByMatchID(ctx, matchID).Return(false, nil)
-	mockResultRepo.EXPECT().Create(ctx, gomock.Any()).Return(derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to save result"))
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).Return(derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to save result"))
 
 	id, err := svc.ReportResult(ctx, matchID, result)
 
@@ -746,3 +1470,2338 @@ func TestMatchService_GetAllMatchReports_RepoError(t *testing.T) {
 		t.Fatalf("expected nil reports on error, got %d items", len(reports))
 	}
 }
+
+// ---------------------------------------------------------------------------
+// GenerateFixtures
+// ---------------------------------------------------------------------------
+
+func seasonTeams(n int) []domain.SeasonTeam {
+	teams := make([]domain.SeasonTeam, n)
+	for i := range teams {
+		id := fmt.Sprintf("team-%d", i+1)
+		teams[i] = domain.SeasonTeam{TeamID: id, TeamName: "Team " + id, HomeVenueID: "venue-" + id, HomeVenueName: "Stadium " + id}
+	}
+	return teams
+}
+
+func fixtureOptions(dryRun, doubleRound bool) domain.FixtureOptions {
+	return domain.FixtureOptions{
+		SeasonID:     "season-1",
+		StartDate:    upcomingMatchDate(),
+		IntervalDays: 7,
+		MatchTime:    "19:00",
+		DoubleRound:  doubleRound,
+		DryRun:       dryRun,
+	}
+}
+
+func TestMatchService_GenerateFixtures_DryRun(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(4), nil)
+
+	fixtures, err := svc.GenerateFixtures(ctx, fixtureOptions(true, false))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 4 teams play 3 rounds of 2 matches
+	if len(fixtures) != 6 {
+		t.Fatalf("expected 6 fixtures, got %d", len(fixtures))
+	}
+	if fixtures[len(fixtures)-1].Round != 3 {
+		t.Fatalf("expected last round to be 3, got %d", fixtures[len(fixtures)-1].Round)
+	}
+}
+
+func TestMatchService_GenerateFixtures_SingleRoundRobin_BalancesHomeAndAway(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	teams := seasonTeams(5)
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(teams, nil)
+
+	fixtures, err := svc.GenerateFixtures(ctx, fixtureOptions(true, false))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 5 teams: every pairing once, one team rests each round
+	if len(fixtures) != 10 {
+		t.Fatalf("expected 10 fixtures, got %d", len(fixtures))
+	}
+
+	home := map[string]int{}
+	away := map[string]int{}
+	pairings := map[string]bool{}
+	for _, f := range fixtures {
+		m := f.Match
+		home[m.HomeTeamID]++
+		away[m.AwayTeamID]++
+		if m.VenueID != "venue-"+m.HomeTeamID || m.VenueName != "Stadium "+m.HomeTeamID {
+			t.Fatalf("expected match at home venue of %s, got %q (%s)", m.HomeTeamID, m.VenueID, m.VenueName)
+		}
+		key := m.HomeTeamID + "|" + m.AwayTeamID
+		if m.AwayTeamID < m.HomeTeamID {
+			key = m.AwayTeamID + "|" + m.HomeTeamID
+		}
+		if pairings[key] {
+			t.Fatalf("pairing %s scheduled twice", key)
+		}
+		pairings[key] = true
+	}
+	for _, team := range teams {
+		if home[team.TeamID] != away[team.TeamID] {
+			t.Fatalf("expected %s to have equal home and away games, got %d home and %d away", team.TeamID, home[team.TeamID], away[team.TeamID])
+		}
+	}
+}
+
+func TestMatchService_GenerateFixtures_DoubleRoundRobin_Persists(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(4), nil)
+	mockMatchRepo.EXPECT().ExistsBySeasonID(ctx, "season-1").Return(false, nil)
+	mockMatchRepo.EXPECT().CreateBatch(ctx, gomock.Len(12)).Return(nil)
+
+	fixtures, err := svc.GenerateFixtures(ctx, fixtureOptions(false, true))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(fixtures) != 12 {
+		t.Fatalf("expected 12 fixtures, got %d", len(fixtures))
+	}
+
+	legs := map[string]int{}
+	for _, f := range fixtures {
+		legs[f.Match.HomeTeamID+"|"+f.Match.AwayTeamID]++
+	}
+	for key, count := range legs {
+		if count != 1 {
+			t.Fatalf("expected %s to be played once at each ground, got %d", key, count)
+		}
+	}
+
+	last := fixtures[len(fixtures)-1]
+	expectedDate := upcomingMatchDate().AddDate(0, 0, 5*7)
+	if last.Round != 6 || !last.Match.MatchDate.Equal(expectedDate) {
+		t.Fatalf("expected last fixture in round 6 on %s, got round %d on %s", expectedDate.Format("2006-01-02"), last.Round, last.Match.MatchDate.Format("2006-01-02"))
+	}
+}
+
+func TestMatchService_GenerateFixtures_SeasonAlreadyHasMatches(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(4), nil)
+	mockMatchRepo.EXPECT().ExistsBySeasonID(ctx, "season-1").Return(true, nil)
+
+	_, err := svc.GenerateFixtures(ctx, fixtureOptions(false, false))
+
+	if !errors.Is(err, domain.ErrSeasonHasFixtures) {
+		t.Fatalf("expected ErrSeasonHasFixtures, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeDuplicate)
+}
+
+func TestMatchService_GenerateFixtures_RunsPastSeasonEnd(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	opts := fixtureOptions(true, true)
+	opts.IntervalDays = 60
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(6), nil)
+
+	_, err := svc.GenerateFixtures(ctx, opts)
+
+	if !errors.Is(err, domain.ErrDateOutsideSeason) {
+		t.Fatalf("expected ErrDateOutsideSeason, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_GenerateFixtures_TeamWithoutVenue(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	teams := seasonTeams(3)
+	teams[1].HomeVenueID = ""
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(teams, nil)
+
+	_, err := svc.GenerateFixtures(ctx, fixtureOptions(true, false))
+
+	if !errors.Is(err, domain.ErrTeamWithoutVenue) {
+		t.Fatalf("expected ErrTeamWithoutVenue, got: %v", err)
+	}
+}
+
+func TestMatchService_GenerateFixtures_NotEnoughTeams(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(1), nil)
+
+	_, err := svc.GenerateFixtures(ctx, fixtureOptions(true, false))
+
+	if !errors.Is(err, domain.ErrNotEnoughTeams) {
+		t.Fatalf("expected ErrNotEnoughTeams, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_GenerateFixtures_KnockoutSeason(t *testing.T) {
+	svc, _, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil)
+
+	_, err := svc.GenerateFixtures(ctx, fixtureOptions(true, false))
+
+	if !errors.Is(err, domain.ErrKnockoutSeason) {
+		t.Fatalf("expected ErrKnockoutSeason, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// DrawKnockout
+// ---------------------------------------------------------------------------
+
+func cupSeason(rules domain.KnockoutRules) *domain.Season {
+	season := activeSeason()
+	season.CompetitionID = "cup-1"
+	season.Format = domain.FormatKnockout
+	season.Rules = rules
+	return season
+}
+
+func drawOptions(method domain.DrawMethod) domain.DrawOptions {
+	return domain.DrawOptions{
+		SeasonID:          "season-1",
+		Method:            method,
+		StartDate:         upcomingMatchDate(),
+		RoundIntervalDays: 14,
+		LegIntervalDays:   7,
+		MatchTime:         "19:00",
+	}
+}
+
+func TestMatchService_DrawKnockout_Seeded_GivesTopSeedsByes(t *testing.T) {
+	svc, _, _, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	teams := seasonTeams(6)
+
+	var saved *domain.Bracket
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil)
+	mockBracketRepo.EXPECT().ExistsBySeasonID(ctx, "season-1").Return(false, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(teams, nil)
+	mockBracketRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Len(2)).DoAndReturn(
+		func(_ context.Context, b *domain.Bracket, _ []*domain.Match) error {
+			saved = b
+			return nil
+		})
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").DoAndReturn(
+		func(_ context.Context, _ string) (*domain.Bracket, error) { return saved, nil })
+
+	bracket, err := svc.DrawKnockout(ctx, drawOptions(domain.DrawSeeded))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 6 teams are padded to an 8-team bracket: quarter-finals, semi-finals and final
+	if bracket.Rounds != 3 || len(bracket.Ties) != 7 {
+		t.Fatalf("expected 3 rounds and 7 ties, got %d rounds and %d ties", bracket.Rounds, len(bracket.Ties))
+	}
+	if bracket.RoundName(1) != "Quarter-finals" || bracket.RoundName(3) != "Final" {
+		t.Fatalf("unexpected round names %q and %q", bracket.RoundName(1), bracket.RoundName(3))
+	}
+
+	// Seeds 1 and 2 receive byes and wait in opposite halves of the semi-finals
+	semiTop, semiBottom := bracket.Ties[4], bracket.Ties[5]
+	if semiTop.HomeTeamID != "team-1" || semiBottom.HomeTeamID != "team-2" {
+		t.Fatalf("expected top seeds to advance through byes, got %q and %q", semiTop.HomeTeamID, semiBottom.HomeTeamID)
+	}
+	if bracket.Ties[0].WinnerTeamID != "team-1" {
+		t.Fatalf("expected bye tie to be won by team-1, got %q", bracket.Ties[0].WinnerTeamID)
+	}
+	if bracket.Ties[1].HomeTeamID != "team-4" || bracket.Ties[1].AwayTeamID != "team-5" {
+		t.Fatalf("expected seed 4 to face seed 5, got %q vs %q", bracket.Ties[1].HomeTeamID, bracket.Ties[1].AwayTeamID)
+	}
+}
+
+func TestMatchService_DrawKnockout_TwoLegged_SchedulesBothLegs(t *testing.T) {
+	svc, _, _, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+
+	var legs []*domain.Match
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{TwoLegged: true}), nil)
+	mockBracketRepo.EXPECT().ExistsBySeasonID(ctx, "season-1").Return(false, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(4), nil)
+	mockBracketRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *domain.Bracket, matches []*domain.Match) error {
+			legs = matches
+			return nil
+		})
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(&domain.Bracket{}, nil)
+
+	_, err := svc.DrawKnockout(ctx, drawOptions(domain.DrawRandom))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(legs) != 4 {
+		t.Fatalf("expected 2 semi-finals of 2 legs each, got %d matches", len(legs))
+	}
+	first, second := legs[0], legs[1]
+	if first.HomeTeamID != second.AwayTeamID || first.VenueID == second.VenueID {
+		t.Fatal("expected second leg to be played at the other team's ground")
+	}
+	if !second.MatchDate.Equal(first.MatchDate.AddDate(0, 0, 7)) {
+		t.Fatalf("expected second leg 7 days after the first, got %s and %s", first.MatchDate.Format("2006-01-02"), second.MatchDate.Format("2006-01-02"))
+	}
+}
+
+func TestMatchService_DrawKnockout_LeagueSeason(t *testing.T) {
+	svc, _, _, mockSeasonRepo, _ := setupKnockoutService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+
+	_, err := svc.DrawKnockout(ctx, drawOptions(domain.DrawSeeded))
+
+	if !errors.Is(err, domain.ErrNotKnockoutSeason) {
+		t.Fatalf("expected ErrNotKnockoutSeason, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_DrawKnockout_AlreadyDrawn(t *testing.T) {
+	svc, _, _, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil)
+	mockBracketRepo.EXPECT().ExistsBySeasonID(ctx, "season-1").Return(true, nil)
+
+	_, err := svc.DrawKnockout(ctx, drawOptions(domain.DrawSeeded))
+
+	if !errors.Is(err, domain.ErrBracketAlreadyDrawn) {
+		t.Fatalf("expected ErrBracketAlreadyDrawn, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeDuplicate)
+}
+
+func TestMatchService_DrawKnockout_InvalidSeedOrder(t *testing.T) {
+	svc, _, _, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	opts := drawOptions(domain.DrawSeeded)
+	opts.SeedOrder = []string{"team-1", "team-2", "team-2", "team-9"}
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil)
+	mockBracketRepo.EXPECT().ExistsBySeasonID(ctx, "season-1").Return(false, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(4), nil)
+
+	_, err := svc.DrawKnockout(ctx, opts)
+
+	if !errors.Is(err, domain.ErrInvalidSeedOrder) {
+		t.Fatalf("expected ErrInvalidSeedOrder, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Knockout advancement
+// ---------------------------------------------------------------------------
+
+// twoLeggedSemis returns a bracket of two two-legged semi-finals feeding the final.
+// advances matches the advancement of a decided tie that changes the given number of ties and
+// schedules the given number of next-round matches.
+type advances struct{ ties, matches int }
+
+func (a advances) Matches(x any) bool {
+	advancement, ok := x.(*domain.Advancement)
+	return ok && advancement != nil && len(advancement.Ties) == a.ties && len(advancement.Matches) == a.matches
+}
+
+func (a advances) String() string {
+	return fmt.Sprintf("advances %d ties and schedules %d matches", a.ties, a.matches)
+}
+
+func twoLeggedSemis() *domain.Bracket {
+	return &domain.Bracket{
+		SeasonID:          "season-1",
+		StartDate:         upcomingMatchDate(),
+		RoundIntervalDays: 14,
+		LegIntervalDays:   7,
+		MatchTime:         "19:00",
+		Rounds:            2,
+		Ties: []*domain.Tie{
+			{ID: "tie-1", SeasonID: "season-1", Round: 1, Slot: 0, HomeTeamID: "team-1", AwayTeamID: "team-2", FirstLegMatchID: "leg-1", SecondLegMatchID: "leg-2"},
+			{ID: "tie-2", SeasonID: "season-1", Round: 1, Slot: 1, HomeTeamID: "team-3", AwayTeamID: "team-4", FirstLegMatchID: "leg-3", SecondLegMatchID: "leg-4"},
+			{ID: "tie-3", SeasonID: "season-1", Round: 2, Slot: 0},
+		},
+	}
+}
+
+func TestMatchService_ReportResult_AdvancesTieOnAwayGoals(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := twoLeggedSemis()
+	rules := domain.KnockoutRules{TwoLegged: true, AwayGoalsRule: true}
+
+	// First leg: team-1 2-1 team-2. Second leg: team-2 1-0 team-1. Aggregate 2-2, team-2 scored away.
+	secondLeg := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
+		{PlayerID: "player-2", TeamID: "team-2", GoalMinute: 60},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "leg-2").Return(&domain.Match{ID: "leg-2", SeasonID: "season-1", HomeTeamID: "team-2", AwayTeamID: "team-1", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "leg-2").Return(false, nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), advances{ties: 2, matches: 0}).Return(nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "leg-2").Return(bracket.Ties[0], nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(rules), nil).Times(2)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "leg-1").Return(&domain.MatchResult{MatchID: "leg-1", HomeScore: 2, AwayScore: 1}, nil)
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(bracket, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(4), nil)
+
+	expectScorersInSquad(svc, secondLeg.Goals)
+	_, err := svc.ReportResult(ctx, "leg-2", secondLeg)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if bracket.Ties[0].WinnerTeamID != "team-2" {
+		t.Fatalf("expected team-2 to win on away goals, got %q", bracket.Ties[0].WinnerTeamID)
+	}
+	if bracket.Ties[2].HomeTeamID != "team-2" {
+		t.Fatalf("expected team-2 to take the home slot of the final, got %q", bracket.Ties[2].HomeTeamID)
+	}
+}
+
+func TestMatchService_ReportResult_SchedulesNextTieWhenBothTeamsKnown(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := twoLeggedSemis()
+	bracket.Ties[0].WinnerTeamID = "team-1"
+	bracket.Ties[2].HomeTeamID = "team-1"
+	rules := domain.KnockoutRules{TwoLegged: true}
+
+	result := &domain.MatchResult{HomeScore: 0, AwayScore: 2, Goals: []domain.Goal{
+		{PlayerID: "player-3", TeamID: "team-3", GoalMinute: 10},
+		{PlayerID: "player-3", TeamID: "team-3", GoalMinute: 80},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "leg-4").Return(&domain.Match{ID: "leg-4", SeasonID: "season-1", HomeTeamID: "team-4", AwayTeamID: "team-3", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "leg-4").Return(false, nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), advances{ties: 2, matches: 2}).Return(nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "leg-4").Return(bracket.Ties[1], nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(rules), nil).Times(2)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "leg-3").Return(&domain.MatchResult{MatchID: "leg-3", HomeScore: 1, AwayScore: 1}, nil)
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(bracket, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(4), nil)
+
+	expectScorersInSquad(svc, result.Goals)
+	_, err := svc.ReportResult(ctx, "leg-4", result)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	final := bracket.Ties[2]
+	if final.AwayTeamID != "team-3" || final.FirstLegMatchID == "" || final.SecondLegMatchID == "" {
+		t.Fatalf("expected final to be scheduled against team-3, got away %q with legs %q/%q", final.AwayTeamID, final.FirstLegMatchID, final.SecondLegMatchID)
+	}
+}
+
+func TestMatchService_ReportResult_BracketErrorSavesNothing(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := twoLeggedSemis()
+	rules := domain.KnockoutRules{TwoLegged: true, AwayGoalsRule: true}
+	secondLeg := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
+		{PlayerID: "player-2", TeamID: "team-2", GoalMinute: 60},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "leg-2").Return(&domain.Match{ID: "leg-2", SeasonID: "season-1", HomeTeamID: "team-2", AwayTeamID: "team-1", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "leg-2").Return(false, nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "leg-2").Return(bracket.Ties[0], nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(rules), nil).Times(2)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "leg-1").Return(&domain.MatchResult{MatchID: "leg-1", HomeScore: 2, AwayScore: 1}, nil)
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(nil, derrors.WrapErrorf(errors.New("db error"), derrors.ErrorCodeInternal, "failed to find bracket"))
+
+	expectScorersInSquad(svc, secondLeg.Goals)
+	_, err := svc.ReportResult(ctx, "leg-2", secondLeg)
+
+	// The result is not saved without its advancement, so reporting it again can succeed
+	assertMatchErrorCode(t, err, derrors.ErrorCodeInternal)
+}
+
+func TestMatchService_ReportResult_KnockoutTieCannotEndLevel(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	tie := &domain.Tie{ID: "tie-1", SeasonID: "season-1", Round: 1, HomeTeamID: "team-1", AwayTeamID: "team-2", FirstLegMatchID: "leg-1"}
+	result := &domain.MatchResult{HomeScore: 1, AwayScore: 1, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10},
+		{PlayerID: "player-2", TeamID: "team-2", GoalMinute: 20},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "leg-1").Return(&domain.Match{ID: "leg-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "leg-1").Return(false, nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "leg-1").Return(tie, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil).Times(2)
+
+	expectScorersInSquad(svc, result.Goals)
+	_, err := svc.ReportResult(ctx, "leg-1", result)
+
+	if !errors.Is(err, domain.ErrTieUndecided) {
+		t.Fatalf("expected ErrTieUndecided, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_LeagueMatchSkipsBracket(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	result := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).Return(nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "match-1").Return(nil, derrors.WrapErrorf(domain.ErrTieNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrTieNotFound.Error()))
+
+	expectScorersInSquad(svc, result.Goals)
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// GetBracket
+// ---------------------------------------------------------------------------
+
+func TestMatchService_GetBracket_LatestSeason(t *testing.T) {
+	svc, _, _, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindLatestByCompetitionID(ctx, "cup-1").Return(cupSeason(domain.KnockoutRules{}), nil)
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(twoLeggedSemis(), nil)
+
+	bracket, err := svc.GetBracket(ctx, "cup-1", "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(bracket.Ties) != 3 {
+		t.Fatalf("expected 3 ties, got %d", len(bracket.Ties))
+	}
+}
+
+func TestMatchService_GetBracket_SeasonOfAnotherCompetition(t *testing.T) {
+	svc, _, _, mockSeasonRepo, _ := setupKnockoutService(t)
+	ctx := context.Background()
+
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil)
+
+	_, err := svc.GetBracket(ctx, "other-cup", "season-1")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+// ---------------------------------------------------------------------------
+// Extra time and penalties
+// ---------------------------------------------------------------------------
+
+// shootout builds alternating kicks, first team first, with a different taker for every kick of a team.
+func shootout(firstTeamID, secondTeamID string, scored ...bool) []domain.ShootoutKick {
+	kicks := make([]domain.ShootoutKick, len(scored))
+	for i, s := range scored {
+		teamID := firstTeamID
+		if i%2 == 1 {
+			teamID = secondTeamID
+		}
+		kicks[i] = domain.ShootoutKick{
+			Order:    i + 1,
+			PlayerID: fmt.Sprintf("%s-kicker-%d", teamID, i/2+1),
+			TeamID:   teamID,
+			Scored:   s,
+		}
+	}
+	return kicks
+}
+
+func levelFinal(decidedBy domain.DecidedBy, kicks []domain.ShootoutKick) *domain.MatchResult {
+	return &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 1,
+		DecidedBy: decidedBy,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 30},
+			{PlayerID: "player-2", TeamID: "team-2", GoalMinute: 105},
+		},
+		Shootout: kicks,
+	}
+}
+
+// expectSingleLegFinal sets up a one-off final between team-1 and team-2 that has not been played yet.
+func expectSingleLegFinal(ctx context.Context, mockMatchRepo *mockDomain.MockMatchRepository, mockResultRepo *mockDomain.MockMatchResultRepository, mockSeasonRepo *mockDomain.MockSeasonRepository, mockBracketRepo *mockDomain.MockBracketRepository) *domain.Bracket {
+	bracket := &domain.Bracket{
+		SeasonID: "season-1",
+		Rounds:   1,
+		Ties: []*domain.Tie{
+			{ID: "final", SeasonID: "season-1", Round: 1, HomeTeamID: "team-1", AwayTeamID: "team-2", FirstLegMatchID: "final-1"},
+		},
+	}
+	mockMatchRepo.EXPECT().FindByID(ctx, "final-1").Return(&domain.Match{ID: "final-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "final-1").Return(false, nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "final-1").Return(bracket.Ties[0], nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil).Times(2)
+	return bracket
+}
+
+func TestMatchService_ReportResult_FinalWonOnPenalties(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := expectSingleLegFinal(ctx, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo)
+
+	// team-2 misses two of its four kicks while team-1 scores all four: 4-2 with a kick to spare
+	kicks := shootout("team-1", "team-2", true, true, true, false, true, true, true, false)
+
+	var saved *domain.MatchResult
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), advances{ties: 1, matches: 0}).DoAndReturn(func(_ context.Context, r *domain.MatchResult, _ *domain.Advancement) error {
+		saved = r
+		return nil
+	})
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(bracket, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(2), nil)
+
+	expectScorersInSquad(svc, levelFinal(domain.DecidedOnPenalties, kicks).Goals)
+	_, err := svc.ReportResult(ctx, "final-1", levelFinal(domain.DecidedOnPenalties, kicks))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if saved.HomePenalties != 4 || saved.AwayPenalties != 2 {
+		t.Fatalf("expected 4-2 on penalties, got %d-%d", saved.HomePenalties, saved.AwayPenalties)
+	}
+	if saved.Status() != "Home Win" {
+		t.Fatalf("expected the shootout winner to win the match, got %q", saved.Status())
+	}
+	if bracket.Ties[0].WinnerTeamID != "team-1" {
+		t.Fatalf("expected team-1 to lift the cup, got %q", bracket.Ties[0].WinnerTeamID)
+	}
+}
+
+func TestMatchService_ReportResult_ShootoutSuddenDeath(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := expectSingleLegFinal(ctx, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo)
+
+	// Five each all scored, then team-2 starts sudden death and misses while team-1 scores
+	kicks := shootout("team-2", "team-1", true, true, true, true, true, true, true, true, true, true, false, true)
+
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Not(gomock.Nil())).Return(nil)
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(bracket, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(2), nil)
+
+	expectScorersInSquad(svc, levelFinal(domain.DecidedOnPenalties, kicks).Goals)
+	_, err := svc.ReportResult(ctx, "final-1", levelFinal(domain.DecidedOnPenalties, kicks))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if bracket.Ties[0].WinnerTeamID != "team-1" {
+		t.Fatalf("expected team-1 to win 6-5 in sudden death, got %q", bracket.Ties[0].WinnerTeamID)
+	}
+}
+
+func TestMatchService_ReportResult_ShootoutKickAfterDecided(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _, _ := setupKnockoutService(t)
+	ctx := context.Background()
+
+	// team-2 misses its first three while team-1 scores three: decided after six kicks
+	kicks := shootout("team-1", "team-2", true, false, true, false, true, false, true)
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "final-1").Return(&domain.Match{ID: "final-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "final-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "final-1", levelFinal(domain.DecidedOnPenalties, kicks))
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_ShootoutNotAlternating(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _, _ := setupKnockoutService(t)
+	ctx := context.Background()
+	kicks := shootout("team-1", "team-2", true, true, true, false, true, true, true, false)
+	kicks[1].TeamID = "team-1"
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "final-1").Return(&domain.Match{ID: "final-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "final-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "final-1", levelFinal(domain.DecidedOnPenalties, kicks))
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_ShootoutKickerRepeatsTooSoon(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _, _ := setupKnockoutService(t)
+	ctx := context.Background()
+	kicks := shootout("team-1", "team-2", true, true, true, false, true, true, true, false)
+	kicks[2].PlayerID = kicks[0].PlayerID
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "final-1").Return(&domain.Match{ID: "final-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "final-1").Return(false, nil)
+
+	_, err := svc.ReportResult(ctx, "final-1", levelFinal(domain.DecidedOnPenalties, kicks))
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_ShootoutNotNeeded(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	expectSingleLegFinal(ctx, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo)
+
+	result := levelFinal(domain.DecidedOnPenalties, shootout("team-1", "team-2", true, true, true, false, true, true, true, false))
+	result.HomeScore = 2
+	result.Goals = append(result.Goals, domain.Goal{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 118})
+
+	expectScorersInSquad(svc, result.Goals)
+	_, err := svc.ReportResult(ctx, "final-1", result)
+
+	if !errors.Is(err, domain.ErrShootoutNotNeeded) {
+		t.Fatalf("expected ErrShootoutNotNeeded, got: %v", err)
+	}
+}
+
+func TestMatchService_ReportResult_ExtraTimeWinner(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := expectSingleLegFinal(ctx, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo)
+
+	result := levelFinal(domain.DecidedInExtraTime, nil)
+	result.AwayScore = 2
+	result.Goals = append(result.Goals, domain.Goal{PlayerID: "player-2", TeamID: "team-2", GoalMinute: 117})
+
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Not(gomock.Nil())).Return(nil)
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(bracket, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(2), nil)
+
+	expectScorersInSquad(svc, result.Goals)
+	_, err := svc.ReportResult(ctx, "final-1", result)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if bracket.Ties[0].WinnerTeamID != "team-2" {
+		t.Fatalf("expected team-2 to win after extra time, got %q", bracket.Ties[0].WinnerTeamID)
+	}
+}
+
+func TestMatchService_ReportResult_PenaltiesInFirstLeg(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := twoLeggedSemis()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "leg-1").Return(&domain.Match{ID: "leg-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "leg-1").Return(false, nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "leg-1").Return(bracket.Ties[0], nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{TwoLegged: true}), nil).Times(2)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "leg-2").Return(nil, derrors.WrapErrorf(domain.ErrMatchResultNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrMatchResultNotFound.Error()))
+
+	result := levelFinal(domain.DecidedOnPenalties, shootout("team-1", "team-2", true, true, true, false, true, true, true, false))
+	expectScorersInSquad(svc, result.Goals)
+	_, err := svc.ReportResult(ctx, "leg-1", result)
+
+	if !errors.Is(err, domain.ErrNotDecidingLeg) {
+		t.Fatalf("expected ErrNotDecidingLeg, got: %v", err)
+	}
+}
+
+func TestMatchService_ReportResult_PenaltiesInLeagueMatch(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+
+	result := levelFinal(domain.DecidedOnPenalties, shootout("team-1", "team-2", true, true, true, false, true, true, true, false))
+	expectScorersInSquad(svc, result.Goals)
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if !errors.Is(err, domain.ErrNotKnockoutMatch) {
+		t.Fatalf("expected ErrNotKnockoutMatch, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+// ---------------------------------------------------------------------------
+// Suspensions
+// ---------------------------------------------------------------------------
+
+// seasonFixtures returns four matches of team-1 a week apart, the first two already played.
+func seasonFixtures() []domain.Match {
+	start := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+	matches := make([]domain.Match, 4)
+	for i := range matches {
+		matches[i] = onCalendar(domain.Match{
+			ID:         fmt.Sprintf("match-%d", i+1),
+			SeasonID:   "season-1",
+			HomeTeamID: "team-1",
+			AwayTeamID: fmt.Sprintf("team-%d", i+2),
+			MatchDate:  start.AddDate(0, 0, 7*i),
+			MatchTime:  "19:00",
+			Status:     domain.StatusScheduled,
+		})
+	}
+	matches[0].Status = domain.StatusFinished
+	matches[1].Status = domain.StatusFinished
+	// Listed newest first, as the repository returns them
+	return []domain.Match{matches[3], matches[2], matches[1], matches[0]}
+}
+
+func disciplinedSeason(rules domain.DisciplineRules) *domain.Season {
+	season := activeSeason()
+	season.Discipline = rules
+	return season
+}
+
+func booked(playerID, matchID string, cardType domain.CardType, minute int) domain.BookedCard {
+	return domain.BookedCard{
+		Card:     domain.Card{PlayerID: playerID, TeamID: "team-1", Type: cardType, Minute: minute},
+		MatchID:  matchID,
+		SeasonID: "season-1",
+	}
+}
+
+func TestMatchService_GetSuspendedPlayers_YellowCardAccumulation(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	mockDisciplineRepo := svc.disciplineRepo.(*mockDomain.MockDisciplineRepository)
+
+	mockDisciplineRepo.EXPECT().FindCardsByTeam(ctx, "team-1", "").Return([]domain.BookedCard{
+		booked("player-1", "match-1", domain.CardYellow, 10),
+		booked("player-1", "match-2", domain.CardYellow, 80),
+		booked("player-2", "match-1", domain.CardRed, 30),
+	}, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(disciplinedSeason(domain.DisciplineRules{RedCardBan: 1, YellowCardLimit: 2, YellowCardBan: 1}), nil)
+	mockMatchRepo.EXPECT().FindAll(ctx, domain.MatchFilter{SeasonID: "season-1", TeamID: "team-1"}).Return(seasonFixtures(), nil)
+
+	suspensions, err := svc.GetSuspendedPlayers(ctx, "team-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// player-2 already sat out match-2, so only player-1 is still suspended
+	if len(suspensions) != 1 {
+		t.Fatalf("expected 1 active suspension, got %d: %+v", len(suspensions), suspensions)
+	}
+	if suspensions[0].PlayerID != "player-1" || suspensions[0].Reason != domain.SuspensionYellowCards {
+		t.Fatalf("expected player-1 to be suspended for yellow cards, got %+v", suspensions[0])
+	}
+	if !suspensions[0].Covers("match-3") {
+		t.Fatalf("expected the ban to cover match-3, got %v", suspensions[0].MatchIDs)
+	}
+}
+
+func TestMatchService_GetPlayerSuspensions_SecondYellow(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	mockDisciplineRepo := svc.disciplineRepo.(*mockDomain.MockDisciplineRepository)
+
+	mockDisciplineRepo.EXPECT().FindCardsByPlayer(ctx, "player-1").Return([]domain.BookedCard{
+		booked("player-1", "match-2", domain.CardYellow, 20),
+		booked("player-1", "match-2", domain.CardSecondYellow, 60),
+		booked("player-1", "match-1", domain.CardYellow, 15),
+	}, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(disciplinedSeason(domain.DisciplineRules{RedCardBan: 2, YellowCardLimit: 2, YellowCardBan: 1}), nil)
+	mockMatchRepo.EXPECT().FindAll(ctx, domain.MatchFilter{SeasonID: "season-1", TeamID: "team-1"}).Return(seasonFixtures(), nil)
+
+	suspensions, err := svc.GetPlayerSuspensions(ctx, "player-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// The yellow that led to the second yellow does not count towards the limit
+	if len(suspensions) != 1 {
+		t.Fatalf("expected only the sending off to earn a ban, got %+v", suspensions)
+	}
+	got := suspensions[0]
+	if got.Reason != domain.SuspensionSentOff || got.Length != 2 || got.Served != 0 {
+		t.Fatalf("expected an unserved two-match ban for being sent off, got %+v", got)
+	}
+	if !got.Covers("match-3") || !got.Covers("match-4") {
+		t.Fatalf("expected the ban to cover match-3 and match-4, got %v", got.MatchIDs)
+	}
+}
+
+func TestMatchService_ReportResult_SuspendedScorer(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	mockDisciplineRepo := svc.disciplineRepo.(*mockDomain.MockDisciplineRepository)
+	result := &domain.MatchResult{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-2", TeamID: "team-1", GoalMinute: 70},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-3").Return(&domain.Match{ID: "match-3", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-4", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-3").Return(false, nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), gomock.Any()).Return([]domain.SquadPlayer{
+		{ID: "player-2", TeamID: "team-1"},
+	}, nil)
+	mockDisciplineRepo.EXPECT().FindCardsByTeam(ctx, "team-1", "season-1").Return([]domain.BookedCard{
+		booked("player-2", "match-2", domain.CardRed, 30),
+	}, nil)
+	mockDisciplineRepo.EXPECT().FindCardsByTeam(ctx, "team-4", "season-1").Return(nil, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(disciplinedSeason(domain.DisciplineRules{RedCardBan: 1, YellowCardLimit: 5, YellowCardBan: 1}), nil).Times(2)
+	mockMatchRepo.EXPECT().FindAll(ctx, domain.MatchFilter{SeasonID: "season-1", TeamID: "team-1"}).Return(seasonFixtures(), nil)
+
+	_, err := svc.ReportResult(ctx, "match-3", result)
+
+	if !errors.Is(err, domain.ErrScorerSuspended) {
+		t.Fatalf("expected ErrScorerSuspended, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_ReportResult_IneligibleScorer(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	season := youthSeason()
+	result := &domain.MatchResult{
+		HomeScore: 2,
+		AwayScore: 0,
+		Goals: []domain.Goal{
+			{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 10, AssistPlayerID: "player-2"},
+			{PlayerID: "player-2", TeamID: "team-1", GoalMinute: 70},
+		},
+	}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(&domain.Match{ID: "match-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusLive}, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), gomock.Any()).Return([]domain.SquadPlayer{
+		{ID: "player-1", TeamID: "team-1", DateOfBirth: agedOnCutoff(season, 15)},
+		{ID: "player-2", TeamID: "team-1", DateOfBirth: agedOnCutoff(season, 18)},
+	}, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(season, nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if !errors.Is(err, domain.ErrPlayerIneligible) {
+		t.Fatalf("expected ErrPlayerIneligible, got: %v", err)
+	}
+	var dErr *derrors.Error
+	errors.As(err, &dErr)
+	details := dErr.Details()
+	if len(details) != 2 || details[0].Field != "goals[0].assist_player_id" || details[1].Field != "goals[1].player_id" {
+		t.Fatalf("expected the assist and the goal of player-2 to be reported, got %+v", details)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Match lifecycle
+// ---------------------------------------------------------------------------
+
+func scheduledMatch(matchDate time.Time) *domain.Match {
+	match := onCalendar(domain.Match{
+		ID:         "match-1",
+		SeasonID:   "season-1",
+		HomeTeamID: "team-1",
+		AwayTeamID: "team-2",
+		MatchDate:  matchDate,
+		MatchTime:  "19:00",
+		VenueID:    "venue-gbk",
+		Status:     domain.StatusScheduled,
+	})
+	return &match
+}
+
+func TestMatchService_ReportResult_MatchNotStarted(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+
+	_, err := svc.ReportResult(ctx, "match-1", &domain.MatchResult{HomeScore: 0, AwayScore: 0})
+
+	if !errors.Is(err, domain.ErrMatchNotStarted) {
+		t.Fatalf("expected ErrMatchNotStarted, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_StartMatch_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(time.Now()), nil)
+	mockMatchRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+	match, err := svc.StartMatch(ctx, "match-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if match.Status != domain.StatusLive {
+		t.Fatalf("expected live match, got %q", match.Status)
+	}
+}
+
+func TestMatchService_StartMatch_BeforeMatchDay(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+
+	_, err := svc.StartMatch(ctx, "match-1")
+
+	if !errors.Is(err, domain.ErrMatchNotDue) {
+		t.Fatalf("expected ErrMatchNotDue, got: %v", err)
+	}
+}
+
+func TestMatchService_StartMatch_AlreadyFinished(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	match := scheduledMatch(time.Now())
+	match.Status = domain.StatusFinished
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+
+	_, err := svc.StartMatch(ctx, "match-1")
+
+	if !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected ErrInvalidTransition, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_PostponeMatch_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	newDate := upcomingMatchDate().AddDate(0, 0, 14)
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	expectNoConflicts(mockMatchRepo)
+	expectNoOfficials(svc)
+	mockMatchRepo.EXPECT().Reschedule(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+	match, err := svc.PostponeMatch(ctx, "match-1", newDate, "", "Waterlogged pitch", false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if match.Status != domain.StatusPostponed || match.StatusReason != "Waterlogged pitch" {
+		t.Fatalf("expected postponed match with reason, got %q (%q)", match.Status, match.StatusReason)
+	}
+	if !match.MatchDate.Equal(newDate) || match.MatchTime != "19:00" {
+		t.Fatalf("expected new date with the original kickoff time, got %s %s", match.MatchDate.Format("2006-01-02"), match.MatchTime)
+	}
+}
+
+func TestMatchService_PostponeMatch_OutsideSeason(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+
+	_, err := svc.PostponeMatch(ctx, "match-1", time.Now().AddDate(1, 0, 0), "20:00", "", false)
+
+	if !errors.Is(err, domain.ErrDateOutsideSeason) {
+		t.Fatalf("expected ErrDateOutsideSeason, got: %v", err)
+	}
+}
+
+func TestMatchService_PostponeMatch_RestDays(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	newDate := upcomingMatchDate().AddDate(0, 0, 14)
+	other := *scheduledMatch(newDate.AddDate(0, 0, 1))
+	other.ID, other.HomeTeamID, other.AwayTeamID, other.VenueID = "match-2", "team-2", "team-3", "venue-jis"
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	kickoff := wibKickoff(newDate, "19:00")
+	mockMatchRepo.EXPECT().FindNearby(ctx, gomock.Any(), kickoff.AddDate(0, 0, -2), kickoff.AddDate(0, 0, 2)).Return([]domain.Match{other}, nil)
+
+	_, err := svc.PostponeMatch(ctx, "match-1", newDate, "", "Waterlogged pitch", false)
+
+	if !errors.Is(err, domain.ErrScheduleConflict) {
+		t.Fatalf("expected ErrScheduleConflict, got: %v", err)
+	}
+	var dErr *derrors.Error
+	errors.As(err, &dErr)
+	if len(dErr.Details()) != 1 || dErr.Details()[0].Field != "away_team_id" {
+		t.Fatalf("expected the away team to lack rest, got %+v", dErr.Details())
+	}
+}
+
+func TestMatchService_CancelMatch_Live(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	match := scheduledMatch(time.Now())
+	match.Status = domain.StatusLive
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+
+	_, err := svc.CancelMatch(ctx, "match-1", "Security concerns")
+
+	if !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected ErrInvalidTransition, got: %v", err)
+	}
+}
+
+func TestMatchService_AbandonMatch_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	match := scheduledMatch(time.Now())
+	match.Status = domain.StatusLive
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockMatchRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+	abandoned, err := svc.AbandonMatch(ctx, "match-1", "Floodlight failure")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if abandoned.Status != domain.StatusAbandoned {
+		t.Fatalf("expected abandoned match, got %q", abandoned.Status)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// UpdateMatch
+// ---------------------------------------------------------------------------
+
+func TestMatchService_UpdateMatch_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	originalDate := upcomingMatchDate()
+	newDate := originalDate.AddDate(0, 0, 3)
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(originalDate), nil)
+	expectVenue(svc, "venue-jis", "Jakarta International Stadium")
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	expectNoConflicts(mockMatchRepo)
+	expectNoOfficials(svc)
+	mockMatchRepo.EXPECT().Reschedule(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, match *domain.Match, change *domain.MatchReschedule) error {
+			if change.MatchID != match.ID || change.Reason != "Broadcast slot moved" {
+				t.Fatalf("unexpected change record: %+v", change)
+			}
+			if !change.PreviousKickoffAt.Equal(wibKickoff(originalDate, "19:00")) || change.PreviousVenueID != "venue-gbk" {
+				t.Fatalf("expected previous schedule to be kept, got %s at %s", change.PreviousKickoffAt, change.PreviousVenueID)
+			}
+			if change.NewVenueID != "venue-jis" {
+				t.Fatalf("expected new venue to be recorded, got %q", change.NewVenueID)
+			}
+			return nil
+		})
+
+	match, err := svc.UpdateMatch(ctx, "match-1", newDate, "15:30", "venue-jis", "Broadcast slot moved", false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !match.MatchDate.Equal(newDate) || match.MatchTime != "15:30" || match.VenueID != "venue-jis" {
+		t.Fatalf("expected match to be moved, got %s %s at %s", match.MatchDate.Format("2006-01-02"), match.MatchTime, match.VenueID)
+	}
+	if match.VenueName != "Jakarta International Stadium" {
+		t.Fatalf("expected venue name of the new venue, got %q", match.VenueName)
+	}
+	if match.Status != domain.StatusScheduled {
+		t.Fatalf("expected status to stay scheduled, got %q", match.Status)
+	}
+}
+
+func TestMatchService_UpdateMatch_NothingChanged(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	matchDate := upcomingMatchDate()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(matchDate), nil)
+
+	match, err := svc.UpdateMatch(ctx, "match-1", matchDate, "19:00", "venue-gbk", "Confirming kickoff", false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if match.MatchTime != "19:00" {
+		t.Fatalf("expected match to be unchanged, got %s", match.MatchTime)
+	}
+}
+
+func TestMatchService_UpdateMatch_KeepsVenue(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	expectNoConflicts(mockMatchRepo)
+	expectNoOfficials(svc)
+	mockMatchRepo.EXPECT().Reschedule(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+	match, err := svc.UpdateMatch(ctx, "match-1", upcomingMatchDate(), "15:30", "", "Broadcast slot moved", false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if match.VenueID != "venue-gbk" {
+		t.Fatalf("expected match to stay at its venue, got %q", match.VenueID)
+	}
+}
+
+func TestMatchService_UpdateMatch_VenueNotFound(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	svc.venueRepo.(*mockDomain.MockVenueRepository).EXPECT().FindByID(ctx, "missing").
+		Return(nil, derrors.WrapErrorf(domain.ErrVenueNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrVenueNotFound.Error()))
+
+	_, err := svc.UpdateMatch(ctx, "match-1", upcomingMatchDate(), "15:30", "missing", "Pitch unplayable", false)
+
+	if !errors.Is(err, domain.ErrVenueNotFound) {
+		t.Fatalf("expected ErrVenueNotFound, got: %v", err)
+	}
+}
+
+func TestMatchService_UpdateMatch_FinishedMatch(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	match := scheduledMatch(time.Now())
+	match.Status = domain.StatusFinished
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+
+	_, err := svc.UpdateMatch(ctx, "match-1", upcomingMatchDate(), "20:00", "venue-gbk", "Replay", false)
+
+	if !errors.Is(err, domain.ErrMatchLocked) {
+		t.Fatalf("expected ErrMatchLocked, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_UpdateMatch_MissingReason(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+
+	_, err := svc.UpdateMatch(ctx, "match-1", upcomingMatchDate(), "20:00", "venue-gbk", "  ", false)
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_UpdateMatch_InvalidTime(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+
+	_, err := svc.UpdateMatch(ctx, "match-1", upcomingMatchDate(), "25:00", "venue-gbk", "Broadcast slot moved", false)
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_UpdateMatch_OutsideSeason(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+
+	_, err := svc.UpdateMatch(ctx, "match-1", time.Now().AddDate(1, 0, 0), "20:00", "venue-gbk", "Stadium renovation", false)
+
+	if !errors.Is(err, domain.ErrDateOutsideSeason) {
+		t.Fatalf("expected ErrDateOutsideSeason, got: %v", err)
+	}
+}
+
+func TestMatchService_UpdateMatch_VenueOverlap(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	matchDate := upcomingMatchDate()
+	other := *scheduledMatch(matchDate)
+	other.ID, other.HomeTeamID, other.AwayTeamID, other.VenueID, other.MatchTime = "match-2", "team-3", "team-4", "venue-jis", "16:30"
+	other = onCalendar(other)
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(matchDate), nil)
+	expectVenue(svc, "venue-jis", "Jakarta International Stadium")
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockMatchRepo.EXPECT().FindNearby(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Match{other}, nil)
+
+	_, err := svc.UpdateMatch(ctx, "match-1", matchDate, "15:30", "venue-jis", "Pitch unplayable", false)
+
+	if !errors.Is(err, domain.ErrScheduleConflict) {
+		t.Fatalf("expected ErrScheduleConflict, got: %v", err)
+	}
+	var dErr *derrors.Error
+	errors.As(err, &dErr)
+	if len(dErr.Details()) != 1 || dErr.Details()[0].Field != "venue_id" {
+		t.Fatalf("expected a single venue overlap, got %+v", dErr.Details())
+	}
+}
+
+func TestMatchService_UpdateMatch_ForceIgnoresConflicts(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	expectNoOfficials(svc)
+	mockMatchRepo.EXPECT().Reschedule(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := svc.UpdateMatch(ctx, "match-1", upcomingMatchDate(), "15:30", "", "League decision", true)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestMatchService_UpdateMatch_OfficialBusyOnNewDay(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	newDate := upcomingMatchDate().AddDate(0, 0, 3)
+	mockOfficialRepo := svc.officialRepo.(*mockDomain.MockOfficialRepository)
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	expectNoConflicts(mockMatchRepo)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.MatchOfficial{
+		{MatchID: "match-1", OfficialID: "official-1", OfficialName: "Thoriq Alkatiri", Role: domain.RoleReferee},
+	}, nil)
+	mockOfficialRepo.EXPECT().FindMatches(ctx, "official-1", gomock.Any(), gomock.Any()).Return([]domain.Match{
+		{ID: "match-9", MatchDate: newDate, MatchTime: "16:00", Timezone: "Asia/Jakarta", Status: domain.StatusScheduled},
+	}, nil)
+
+	_, err := svc.UpdateMatch(ctx, "match-1", newDate, "19:00", "", "Broadcast slot moved", false)
+
+	if !errors.Is(err, domain.ErrOfficialUnavailable) {
+		t.Fatalf("expected ErrOfficialUnavailable, got: %v", err)
+	}
+	var dErr *derrors.Error
+	if !errors.As(err, &dErr) || len(dErr.Details()) != 1 {
+		t.Fatalf("expected one detail naming the other match, got: %v", err)
+	}
+}
+
+func TestMatchService_PostponeMatch_OfficialBusyOnNewDay(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	newDate := upcomingMatchDate().AddDate(0, 0, 14)
+	mockOfficialRepo := svc.officialRepo.(*mockDomain.MockOfficialRepository)
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.MatchOfficial{
+		{MatchID: "match-1", OfficialID: "official-1", OfficialName: "Thoriq Alkatiri", Role: domain.RoleReferee},
+	}, nil)
+	mockOfficialRepo.EXPECT().FindMatches(ctx, "official-1", gomock.Any(), gomock.Any()).Return([]domain.Match{
+		{ID: "match-9", MatchDate: newDate, MatchTime: "16:00", Timezone: "Asia/Jakarta", Status: domain.StatusScheduled},
+	}, nil)
+
+	// Forcing the schedule does not free the official
+	_, err := svc.PostponeMatch(ctx, "match-1", newDate, "", "Waterlogged pitch", true)
+
+	if !errors.Is(err, domain.ErrOfficialUnavailable) {
+		t.Fatalf("expected ErrOfficialUnavailable, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_GetMatchReschedules_NotFound(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "missing").Return(nil, matchNotFound())
+
+	_, err := svc.GetMatchReschedules(ctx, "missing")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+func TestMatchService_GetMatchReschedules_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockMatchRepo.EXPECT().FindReschedules(ctx, "match-1").Return([]domain.MatchReschedule{
+		{ID: "change-1", MatchID: "match-1", Reason: "Waterlogged pitch"},
+	}, nil)
+
+	reschedules, err := svc.GetMatchReschedules(ctx, "match-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(reschedules) != 1 || reschedules[0].Reason != "Waterlogged pitch" {
+		t.Fatalf("expected one reschedule, got %+v", reschedules)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// DeleteMatch / RestoreMatch
+// ---------------------------------------------------------------------------
+
+func matchNotFound() error {
+	return derrors.WrapErrorf(domain.ErrMatchNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrMatchNotFound.Error())
+}
+
+func TestMatchService_DeleteMatch_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	mockMatchRepo.EXPECT().Delete(ctx, "match-1").Return(nil)
+
+	if err := svc.DeleteMatch(ctx, "match-1"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestMatchService_DeleteMatch_NotFound(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "missing").Return(nil, matchNotFound())
+
+	err := svc.DeleteMatch(ctx, "missing")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+func TestMatchService_RestoreMatch_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	match := scheduledMatch(time.Now())
+	match.Status = domain.StatusFinished
+
+	gomock.InOrder(
+		mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(nil, matchNotFound()),
+		mockMatchRepo.EXPECT().Restore(ctx, "match-1").Return(nil),
+		mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil),
+	)
+
+	restored, err := svc.RestoreMatch(ctx, "match-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if restored.ID != "match-1" || restored.Status != domain.StatusFinished {
+		t.Fatalf("expected finished match-1 to be restored, got %s (%q)", restored.ID, restored.Status)
+	}
+}
+
+func TestMatchService_RestoreMatch_NotDeleted(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+
+	_, err := svc.RestoreMatch(ctx, "match-1")
+
+	if !errors.Is(err, domain.ErrMatchNotDeleted) {
+		t.Fatalf("expected ErrMatchNotDeleted, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_RestoreMatch_NeverExisted(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "missing").Return(nil, matchNotFound())
+	mockMatchRepo.EXPECT().Restore(ctx, "missing").Return(matchNotFound())
+
+	_, err := svc.RestoreMatch(ctx, "missing")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+// ---------------------------------------------------------------------------
+// AmendResult / VoidResult
+// ---------------------------------------------------------------------------
+
+// finishedMatch returns a played league match with a 2-1 result.
+func finishedMatch() (*domain.Match, *domain.MatchResult) {
+	match := scheduledMatch(time.Now().AddDate(0, 0, -1))
+	match.SeasonID = ""
+	match.Status = domain.StatusFinished
+	result := &domain.MatchResult{ID: "result-1", MatchID: "match-1", HomeScore: 2, AwayScore: 1, DecidedBy: domain.DecidedInRegulation, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 15},
+		{PlayerID: "player-2", TeamID: "team-1", GoalMinute: 45},
+		{PlayerID: "player-3", TeamID: "team-2", GoalMinute: 70},
+	}}
+	return match, result
+}
+
+func TestMatchService_AmendResult_Success(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	match, current := finishedMatch()
+	corrected := &domain.MatchResult{HomeScore: 2, AwayScore: 1, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 15},
+		{PlayerID: "player-4", TeamID: "team-1", GoalMinute: 45},
+		{PlayerID: "player-3", TeamID: "team-2", GoalMinute: 71},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(current, nil)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Amend(ctx, gomock.Any(), gomock.Any(), gomock.Nil()).
+		DoAndReturn(func(_ context.Context, revision *domain.ResultRevision, result *domain.MatchResult, _ *domain.Advancement) error {
+			if revision.Action != domain.RevisionAmended || revision.Result.ID != "result-1" {
+				t.Fatalf("expected the current result to be kept as an amended revision, got %s of %s", revision.Action, revision.Result.ID)
+			}
+			if revision.ChangedBy != "admin" || revision.Reason != "Wrong scorer" {
+				t.Fatalf("expected revision by admin with reason, got %q (%q)", revision.ChangedBy, revision.Reason)
+			}
+			if result.ID == "result-1" || result.Goals[1].PlayerID != "player-4" {
+				t.Fatalf("expected a new result with the corrected scorer, got %+v", result)
+			}
+			return nil
+		})
+
+	expectScorersInSquad(svc, corrected.Goals)
+	id, err := svc.AmendResult(ctx, "match-1", corrected, "admin", "Wrong scorer")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if id == "" || id == "result-1" {
+		t.Fatalf("expected the ID of the new result, got %q", id)
+	}
+}
+
+func TestMatchService_AmendResult_NoResult(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	match, current := finishedMatch()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "match-1").
+		Return(nil, derrors.WrapErrorf(domain.ErrMatchResultNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrMatchResultNotFound.Error()))
+
+	_, err := svc.AmendResult(ctx, "match-1", current, "admin", "Wrong scorer")
+
+	if !errors.Is(err, domain.ErrMatchResultNotFound) {
+		t.Fatalf("expected ErrMatchResultNotFound, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+func TestMatchService_AmendResult_MissingReason(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	match, current := finishedMatch()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(current, nil)
+
+	_, err := svc.AmendResult(ctx, "match-1", &domain.MatchResult{}, "admin", " ")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_AmendResult_InvalidScore(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	match, current := finishedMatch()
+	corrected := &domain.MatchResult{HomeScore: 3, AwayScore: 1, Goals: current.Goals}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(current, nil)
+
+	_, err := svc.AmendResult(ctx, "match-1", corrected, "admin", "Missed a goal")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+// decidedFinal returns a single-leg final won 1-0 by team-1.
+func decidedFinal() (*domain.Match, *domain.MatchResult, *domain.Tie) {
+	match := &domain.Match{ID: "final-1", SeasonID: "season-1", HomeTeamID: "team-1", AwayTeamID: "team-2", Status: domain.StatusFinished}
+	result := &domain.MatchResult{ID: "result-1", MatchID: "final-1", HomeScore: 1, AwayScore: 0, DecidedBy: domain.DecidedInRegulation, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 30},
+	}}
+	tie := &domain.Tie{ID: "tie-1", SeasonID: "season-1", Round: 1, HomeTeamID: "team-1", AwayTeamID: "team-2", FirstLegMatchID: "final-1", WinnerTeamID: "team-1"}
+	return match, result, tie
+}
+
+func TestMatchService_AmendResult_KeepsTieWinner(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	match, current, tie := decidedFinal()
+	corrected := &domain.MatchResult{HomeScore: 1, AwayScore: 0, Goals: []domain.Goal{
+		{PlayerID: "player-1", TeamID: "team-1", GoalMinute: 35},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "final-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "final-1").Return(current, nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "final-1").Return(tie, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil).Times(2)
+	expectNoLineups(svc)
+	mockResultRepo.EXPECT().Amend(ctx, gomock.Any(), gomock.Any(), gomock.Nil()).Return(nil)
+
+	expectScorersInSquad(svc, corrected.Goals)
+	_, err := svc.AmendResult(ctx, "final-1", corrected, "admin", "Goal was in the 35th minute")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestMatchService_AmendResult_CannotChangeTieWinner(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	match, current, tie := decidedFinal()
+	corrected := &domain.MatchResult{HomeScore: 0, AwayScore: 1, Goals: []domain.Goal{
+		{PlayerID: "player-2", TeamID: "team-2", GoalMinute: 30},
+	}}
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "final-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "final-1").Return(current, nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "final-1").Return(tie, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(cupSeason(domain.KnockoutRules{}), nil).Times(2)
+
+	expectScorersInSquad(svc, corrected.Goals)
+	_, err := svc.AmendResult(ctx, "final-1", corrected, "admin", "Goal credited to the wrong side")
+
+	if !errors.Is(err, domain.ErrTieAlreadyDecided) {
+		t.Fatalf("expected ErrTieAlreadyDecided, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_VoidResult_Success(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	match, current := finishedMatch()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(current, nil)
+	mockResultRepo.EXPECT().Void(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, revision *domain.ResultRevision, m *domain.Match) error {
+			if revision.Action != domain.RevisionVoided || revision.Result.ID != "result-1" {
+				t.Fatalf("expected the current result to be kept as a voided revision, got %s of %s", revision.Action, revision.Result.ID)
+			}
+			if m.Status != domain.StatusScheduled {
+				t.Fatalf("expected match to be reopened, got %q", m.Status)
+			}
+			return nil
+		})
+
+	voided, err := svc.VoidResult(ctx, "match-1", "admin", "Played with an ineligible player")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if voided.Status != domain.StatusScheduled {
+		t.Fatalf("expected scheduled match, got %q", voided.Status)
+	}
+}
+
+func TestMatchService_VoidResult_DecidedTie(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	match, current, tie := decidedFinal()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "final-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindByMatchID(ctx, "final-1").Return(current, nil)
+	mockBracketRepo.EXPECT().FindTieByMatchID(ctx, "final-1").Return(tie, nil)
+
+	_, err := svc.VoidResult(ctx, "final-1", "admin", "Pitch invasion")
+
+	if !errors.Is(err, domain.ErrTieAlreadyDecided) {
+		t.Fatalf("expected ErrTieAlreadyDecided, got: %v", err)
+	}
+}
+
+func TestMatchService_GetResultRevisions_Success(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	match, current := finishedMatch()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockResultRepo.EXPECT().FindRevisions(ctx, "match-1").Return([]domain.ResultRevision{
+		{ID: "revision-1", MatchID: "match-1", Action: domain.RevisionAmended, Result: *current, ChangedBy: "admin", Reason: "Wrong scorer"},
+	}, nil)
+
+	revisions, err := svc.GetResultRevisions(ctx, "match-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(revisions) != 1 || revisions[0].Result.HomeScore != 2 {
+		t.Fatalf("expected one revision of the 2-1 result, got %+v", revisions)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Lineups and substitutions
+// ---------------------------------------------------------------------------
+
+// teamSheet names a starting XI with a goalkeeper first and the given number of substitutes,
+// all registered with the team under jersey numbers 1 and up.
+func teamSheet(teamID string, bench int) ([]domain.LineupPlayer, []domain.SquadPlayer) {
+	var players []domain.LineupPlayer
+	var squad []domain.SquadPlayer
+	for i := 1; i <= 11+bench; i++ {
+		id := fmt.Sprintf("%s-player-%d", teamID, i)
+		position := "CM"
+		if i == 1 {
+			position = "GK"
+		}
+		players = append(players, domain.LineupPlayer{PlayerID: id, Starter: i <= 11})
+		squad = append(squad, domain.SquadPlayer{ID: id, Name: fmt.Sprintf("Player %d", i), TeamID: teamID, Position: position, JerseyNumber: i})
+	}
+	return players, squad
+}
+
+// liveLineup returns a live match and the home team's lineup with seven substitutes and no substitutions yet.
+func liveLineup() (*domain.Match, domain.Lineup) {
+	m := scheduledMatch(time.Now())
+	m.Status = domain.StatusLive
+	players, _ := teamSheet("team-1", 7)
+	return m, domain.Lineup{ID: "lineup-1", MatchID: "match-1", TeamID: "team-1", Players: players}
+}
+
+func TestMatchService_SubmitLineup_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	players, squad := teamSheet("team-1", 7)
+	// Bench listed first to check the team sheet is put in order
+	input := append(players[11:], players[:11]...)
+	input[0].JerseyNumber = 40
+
+	var saved *domain.Lineup
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Len(18), upcomingMatchDate()).Return(squad, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(activeSeason(), nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, l *domain.Lineup) error {
+		saved = l
+		return nil
+	})
+
+	lineup, err := svc.SubmitLineup(ctx, "match-1", &domain.Lineup{TeamID: "team-1", Players: input}, "admin@ayo.id")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if saved != lineup || len(lineup.Starters()) != 11 || len(lineup.Bench()) != 7 {
+		t.Fatalf("expected 11 starters and 7 substitutes to be saved, got %+v", lineup)
+	}
+	if first := lineup.Players[0]; first.Position != "GK" || first.PlayerName != "Player 1" || first.JerseyNumber != 1 {
+		t.Fatalf("expected the goalkeeper first with the registered name and number, got %+v", first)
+	}
+	if sub := lineup.Bench()[0]; sub.JerseyNumber != 40 {
+		t.Fatalf("expected the chosen jersey number to be kept, got %d", sub.JerseyNumber)
+	}
+}
+
+func TestMatchService_SubmitLineup_AfterKickoff(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, _ := liveLineup()
+	players, _ := teamSheet("team-1", 0)
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+
+	_, err := svc.SubmitLineup(ctx, "match-1", &domain.Lineup{TeamID: "team-1", Players: players}, "admin@ayo.id")
+
+	if !errors.Is(err, domain.ErrLineupLocked) {
+		t.Fatalf("expected ErrLineupLocked, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_SubmitLineup_TooFewStarters(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	players, _ := teamSheet("team-1", 3)
+	players[10].Starter = false
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+
+	_, err := svc.SubmitLineup(ctx, "match-1", &domain.Lineup{TeamID: "team-1", Players: players}, "admin@ayo.id")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_SubmitLineup_PlayerNotInSquad(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	players, squad := teamSheet("team-1", 2)
+	// The first substitute plays for the opponent and the second has left the club
+	squad[11].TeamID = "team-2"
+	squad = squad[:12]
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), gomock.Any()).Return(squad, nil)
+
+	_, err := svc.SubmitLineup(ctx, "match-1", &domain.Lineup{TeamID: "team-1", Players: players}, "admin@ayo.id")
+
+	if !errors.Is(err, domain.ErrPlayerNotInSquad) {
+		t.Fatalf("expected ErrPlayerNotInSquad, got: %v", err)
+	}
+	var dErr *derrors.Error
+	errors.As(err, &dErr)
+	details := dErr.Details()
+	if len(details) != 2 || details[0].Field != "bench[0].player_id" || details[1].Field != "bench[1].player_id" {
+		t.Fatalf("expected both substitutes to be reported, got %+v", details)
+	}
+}
+
+// youthSeason returns an under-17 season, whose players must be at most 16 on the cutoff date.
+func youthSeason() *domain.Season {
+	season := activeSeason()
+	season.AgeLimit = &domain.AgeLimit{MaxAge: 16, CutoffDate: time.Now().AddDate(0, 6, 0)}
+	return season
+}
+
+// agedOnCutoff returns a date of birth that makes a player the given number of years old on the cutoff date of the season.
+func agedOnCutoff(season *domain.Season, years int) *time.Time {
+	dob := season.AgeLimit.CutoffDate.AddDate(-years, -1, 0)
+	return &dob
+}
+
+func TestMatchService_SubmitLineup_IneligiblePlayers(t *testing.T) {
+	svc, mockMatchRepo, _, _, mockSeasonRepo := setupMatchServiceWithSeason(t)
+	ctx := context.Background()
+	season := youthSeason()
+	players, squad := teamSheet("team-1", 2)
+	for i := range squad {
+		squad[i].DateOfBirth = agedOnCutoff(season, 16)
+	}
+	// The first substitute is too old and the second has no date of birth on record
+	squad[11].DateOfBirth = agedOnCutoff(season, 17)
+	squad[12].DateOfBirth = nil
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), gomock.Any()).Return(squad, nil)
+	mockSeasonRepo.EXPECT().FindByID(ctx, "season-1").Return(season, nil)
+
+	_, err := svc.SubmitLineup(ctx, "match-1", &domain.Lineup{TeamID: "team-1", Players: players}, "admin@ayo.id")
+
+	if !errors.Is(err, domain.ErrPlayerIneligible) {
+		t.Fatalf("expected ErrPlayerIneligible, got: %v", err)
+	}
+	var dErr *derrors.Error
+	errors.As(err, &dErr)
+	details := dErr.Details()
+	if len(details) != 2 || details[0].Field != "bench[0].player_id" || details[1].Field != "bench[1].player_id" {
+		t.Fatalf("expected both substitutes to be reported, got %+v", details)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+}
+
+func TestMatchService_SubmitLineup_DuplicateJersey(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	players, squad := teamSheet("team-1", 1)
+	players[11].JerseyNumber = 9
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), gomock.Any()).Return(squad, nil)
+
+	_, err := svc.SubmitLineup(ctx, "match-1", &domain.Lineup{TeamID: "team-1", Players: players}, "admin@ayo.id")
+
+	if !errors.Is(err, domain.ErrDuplicateJersey) {
+		t.Fatalf("expected ErrDuplicateJersey, got: %v", err)
+	}
+}
+
+func TestMatchService_SubmitLineup_GoalkeeperCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions map[int]string
+	}{
+		{name: "no goalkeeper", positions: map[int]string{0: "CB"}},
+		{name: "two goalkeepers", positions: map[int]string{5: "GK"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mockMatchRepo, _, _ := setupMatchService(t)
+			ctx := context.Background()
+			players, squad := teamSheet("team-1", 0)
+			for i, position := range tt.positions {
+				squad[i].Position = position
+			}
+
+			mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+			svc.squadRepo.(*mockDomain.MockSquadRepository).EXPECT().FindPlayers(ctx, gomock.Any(), gomock.Any()).Return(squad, nil)
+
+			_, err := svc.SubmitLineup(ctx, "match-1", &domain.Lineup{TeamID: "team-1", Players: players}, "admin@ayo.id")
+
+			if !errors.Is(err, domain.ErrLineupGoalkeeper) {
+				t.Fatalf("expected ErrLineupGoalkeeper, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestMatchService_AddSubstitution_Success(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, lineup := liveLineup()
+	// A substitute who came on can be replaced in turn
+	lineup.Substitutions = []domain.Substitution{{Minute: 46, PlayerOffID: "team-1-player-9", PlayerOnID: "team-1-player-12"}}
+
+	var saved *domain.Substitution
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.Lineup{lineup}, nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().AddSubstitution(ctx, gomock.Any(), 1).DoAndReturn(func(_ context.Context, sub *domain.Substitution, _ int) error {
+		saved = sub
+		return nil
+	})
+
+	id, err := svc.AddSubstitution(ctx, "match-1", &domain.Substitution{TeamID: "team-1", Minute: 70, PlayerOffID: "team-1-player-12", PlayerOnID: "team-1-player-13"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if id == "" || saved.LineupID != "lineup-1" || saved.Minute != 70 {
+		t.Fatalf("expected the substitution to be saved against the lineup, got %+v", saved)
+	}
+}
+
+func TestMatchService_AddSubstitution_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		previous    int // Substitutions already made, each at minute 60
+		sub         domain.Substitution
+		expectedErr error
+	}{
+		{name: "player off already substituted", previous: 1, sub: domain.Substitution{Minute: 70, PlayerOffID: "team-1-player-2", PlayerOnID: "team-1-player-18"}},
+		{name: "player on already used", previous: 1, sub: domain.Substitution{Minute: 70, PlayerOffID: "team-1-player-3", PlayerOnID: "team-1-player-12"}},
+		{name: "player on is a starter", sub: domain.Substitution{Minute: 70, PlayerOffID: "team-1-player-3", PlayerOnID: "team-1-player-4"}},
+		{name: "earlier than the last substitution", previous: 1, sub: domain.Substitution{Minute: 55, PlayerOffID: "team-1-player-3", PlayerOnID: "team-1-player-13"}},
+		{name: "no substitutions left", previous: 5, sub: domain.Substitution{Minute: 80, PlayerOffID: "team-1-player-8", PlayerOnID: "team-1-player-18"}, expectedErr: domain.ErrSubstitutionLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mockMatchRepo, _, _ := setupMatchService(t)
+			ctx := context.Background()
+			m, lineup := liveLineup()
+			for i := 0; i < tt.previous; i++ {
+				lineup.Substitutions = append(lineup.Substitutions, domain.Substitution{
+					Minute:      60,
+					PlayerOffID: fmt.Sprintf("team-1-player-%d", i+2),
+					PlayerOnID:  fmt.Sprintf("team-1-player-%d", i+12),
+				})
+			}
+			tt.sub.TeamID = "team-1"
+
+			mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+			svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.Lineup{lineup}, nil)
+
+			_, err := svc.AddSubstitution(ctx, "match-1", &tt.sub)
+
+			assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected %v, got: %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestMatchService_AddSubstitution_NoLineup(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, lineup := liveLineup()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.Lineup{lineup}, nil)
+
+	_, err := svc.AddSubstitution(ctx, "match-1", &domain.Substitution{TeamID: "team-2", Minute: 60, PlayerOffID: "team-2-player-2", PlayerOnID: "team-2-player-12"})
+
+	if !errors.Is(err, domain.ErrLineupNotFound) {
+		t.Fatalf("expected ErrLineupNotFound, got: %v", err)
+	}
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+func TestMatchService_AddSubstitution_LineupChangedMeanwhile(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, lineup := liveLineup()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.Lineup{lineup}, nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().AddSubstitution(ctx, gomock.Any(), 0).
+		Return(derrors.WrapErrorf(domain.ErrSubstitutionClash, derrors.ErrorCodeDuplicate, "%s", domain.ErrSubstitutionClash.Error()))
+
+	_, err := svc.AddSubstitution(ctx, "match-1", &domain.Substitution{TeamID: "team-1", Minute: 60, PlayerOffID: "team-1-player-2", PlayerOnID: "team-1-player-12"})
+
+	if !errors.Is(err, domain.ErrSubstitutionClash) {
+		t.Fatalf("expected ErrSubstitutionClash, got: %v", err)
+	}
+}
+
+func TestMatchService_AddSubstitution_MatchNotLive(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	_, lineup := liveLineup()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.Lineup{lineup}, nil)
+
+	_, err := svc.AddSubstitution(ctx, "match-1", &domain.Substitution{TeamID: "team-1", Minute: 60, PlayerOffID: "team-1-player-2", PlayerOnID: "team-1-player-12"})
+
+	if !errors.Is(err, domain.ErrMatchNotLive) {
+		t.Fatalf("expected ErrMatchNotLive, got: %v", err)
+	}
+}
+
+func TestMatchService_ReportResult_RecordsAppearances(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, lineup := liveLineup()
+	m.SeasonID = ""
+	lineup.Substitutions = []domain.Substitution{
+		{Minute: 60, PlayerOffID: "team-1-player-9", PlayerOnID: "team-1-player-12"},
+		// Stoppage time counts as full time
+		{Minute: 93, PlayerOffID: "team-1-player-10", PlayerOnID: "team-1-player-13"},
+	}
+	result := &domain.MatchResult{
+		HomeScore: 0,
+		AwayScore: 0,
+		Goals:     []domain.Goal{},
+		Cards: []domain.Card{
+			{PlayerID: "team-1-player-5", TeamID: "team-1", Type: domain.CardRed, Minute: 70},
+		},
+	}
+
+	var saved *domain.MatchResult
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	mockResultRepo.EXPECT().ExistsByMatchID(ctx, "match-1").Return(false, nil)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.Lineup{lineup}, nil)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Nil()).DoAndReturn(func(_ context.Context, r *domain.MatchResult, _ *domain.Advancement) error {
+		saved = r
+		return nil
+	})
+
+	_, err := svc.ReportResult(ctx, "match-1", result)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	minutes := make(map[string]int)
+	for _, a := range saved.Appearances {
+		if a.ResultID != saved.ID || a.TeamID != "team-1" {
+			t.Fatalf("expected appearance to be linked to result %s for team-1, got %+v", saved.ID, a)
+		}
+		minutes[a.PlayerID] = a.Minutes()
+	}
+	expected := map[string]int{
+		"team-1-player-1":  90,
+		"team-1-player-5":  70,
+		"team-1-player-9":  60,
+		"team-1-player-10": 90,
+		"team-1-player-12": 30,
+		"team-1-player-13": 0,
+	}
+	if len(saved.Appearances) != 13 {
+		t.Fatalf("expected 11 starters and 2 substitutes to appear, got %d", len(saved.Appearances))
+	}
+	for playerID, want := range expected {
+		if minutes[playerID] != want {
+			t.Fatalf("expected %s to play %d minutes, got %d", playerID, want, minutes[playerID])
+		}
+	}
+	if _, played := minutes["team-1-player-14"]; played {
+		t.Fatal("expected unused substitutes to make no appearance")
+	}
+}
+
+func TestMatchService_ReportResult_ExtraTimeAppearances(t *testing.T) {
+	svc, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo := setupKnockoutService(t)
+	ctx := context.Background()
+	bracket := expectSingleLegFinal(ctx, mockMatchRepo, mockResultRepo, mockSeasonRepo, mockBracketRepo)
+	_, lineup := liveLineup()
+	lineup.MatchID = "final-1"
+	lineup.Substitutions = []domain.Substitution{{Minute: 100, PlayerOffID: "team-1-player-9", PlayerOnID: "team-1-player-12"}}
+	result := levelFinal(domain.DecidedInExtraTime, nil)
+	result.HomeScore = 2
+	result.Goals = append(result.Goals, domain.Goal{PlayerID: "home-scorer", TeamID: "team-1", GoalMinute: 110})
+
+	var saved *domain.MatchResult
+	expectScorersInSquad(svc, result.Goals)
+	svc.lineupRepo.(*mockDomain.MockLineupRepository).EXPECT().FindByMatchID(ctx, "final-1").Return([]domain.Lineup{lineup}, nil)
+	mockResultRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Not(gomock.Nil())).DoAndReturn(func(_ context.Context, r *domain.MatchResult, _ *domain.Advancement) error {
+		saved = r
+		return nil
+	})
+	mockBracketRepo.EXPECT().FindBySeasonID(ctx, "season-1").Return(bracket, nil)
+	mockSeasonRepo.EXPECT().FindTeams(ctx, "season-1").Return(seasonTeams(2), nil)
+
+	_, err := svc.ReportResult(ctx, "final-1", result)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for _, a := range saved.Appearances {
+		if a.PlayerID == "team-1-player-1" && a.Minutes() != 120 {
+			t.Fatalf("expected starters to play 120 minutes after extra time, got %d", a.Minutes())
+		}
+		if a.PlayerID == "team-1-player-12" && a.Minutes() != 20 {
+			t.Fatalf("expected the substitute to play 20 minutes, got %d", a.Minutes())
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Live feed
+// ---------------------------------------------------------------------------
+
+// liveFeed returns a live match between team-1 and team-2 and a feed that kicked off and saw a home goal.
+func liveFeed() (*domain.Match, []domain.LiveEvent) {
+	m := scheduledMatch(time.Now())
+	m.Status = domain.StatusLive
+	return m, []domain.LiveEvent{
+		{Sequence: 1, MatchID: "match-1", Type: domain.LiveKickoff, Minute: 0},
+		{Sequence: 2, MatchID: "match-1", Type: domain.LiveGoal, Minute: 12, TeamID: "team-1", HomeScore: 1},
+	}
+}
+
+func TestMatchService_PostLiveEvent_Goal(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, history := liveFeed()
+
+	var published domain.LiveEvent
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(history, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	svc.liveBroker.(*mockDomain.MockLiveEventBroker).EXPECT().Publish(gomock.Any()).Do(func(e domain.LiveEvent) {
+		published = e
+	})
+
+	event, err := svc.PostLiveEvent(ctx, "match-1", &domain.LiveEvent{Type: domain.LiveGoal, Minute: 40, TeamID: "team-2", PlayerID: "player-9"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if event.Sequence != 3 || event.HomeScore != 1 || event.AwayScore != 1 {
+		t.Fatalf("expected the third event at 1-1, got %+v", event)
+	}
+	if published.ID != event.ID {
+		t.Fatalf("expected the event to be pushed to followers, got %+v", published)
+	}
+}
+
+func TestMatchService_PostLiveEvent_FirstKickoff(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, _ := liveFeed()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(nil, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	svc.liveBroker.(*mockDomain.MockLiveEventBroker).EXPECT().Publish(gomock.Any())
+
+	event, err := svc.PostLiveEvent(ctx, "match-1", &domain.LiveEvent{Type: domain.LiveKickoff})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if event.Sequence != 1 || event.HomeScore != 0 || event.AwayScore != 0 {
+		t.Fatalf("expected the first event at 0-0, got %+v", event)
+	}
+}
+
+func TestMatchService_PostLiveEvent_OutOfOrder(t *testing.T) {
+	halfTime := domain.LiveEvent{Sequence: 3, Type: domain.LiveHalfTime, Minute: 45, HomeScore: 1}
+	fullTime := domain.LiveEvent{Sequence: 3, Type: domain.LiveFullTime, Minute: 90, HomeScore: 1}
+	tests := []struct {
+		name  string
+		extra []domain.LiveEvent
+		event domain.LiveEvent
+	}{
+		{name: "kicking off again", event: domain.LiveEvent{Type: domain.LiveKickoff, Minute: 20}},
+		{name: "going back in time", event: domain.LiveEvent{Type: domain.LiveCard, Minute: 10, TeamID: "team-2", PlayerID: "player-9", CardType: domain.CardYellow}},
+		{name: "play without kicking off after half-time", extra: []domain.LiveEvent{halfTime}, event: domain.LiveEvent{Type: domain.LiveGoal, Minute: 50, TeamID: "team-1"}},
+		{name: "second half-time", extra: []domain.LiveEvent{halfTime, {Sequence: 4, Type: domain.LiveKickoff, Minute: 45, HomeScore: 1}}, event: domain.LiveEvent{Type: domain.LiveHalfTime, Minute: 60}},
+		{name: "after full time", extra: []domain.LiveEvent{fullTime}, event: domain.LiveEvent{Type: domain.LiveGoal, Minute: 90, TeamID: "team-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mockMatchRepo, _, _ := setupMatchService(t)
+			ctx := context.Background()
+			m, history := liveFeed()
+
+			mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+			svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(append(history, tt.extra...), nil)
+
+			_, err := svc.PostLiveEvent(ctx, "match-1", &tt.event)
+
+			if !errors.Is(err, domain.ErrLiveFeedOrder) {
+				t.Fatalf("expected ErrLiveFeedOrder, got: %v", err)
+			}
+			assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+		})
+	}
+}
+
+func TestMatchService_PostLiveEvent_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		event domain.LiveEvent
+	}{
+		{name: "goal for another team", event: domain.LiveEvent{Type: domain.LiveGoal, Minute: 30, TeamID: "team-3"}},
+		{name: "card without a player", event: domain.LiveEvent{Type: domain.LiveCard, Minute: 30, TeamID: "team-1", CardType: domain.CardRed}},
+		{name: "card without a type", event: domain.LiveEvent{Type: domain.LiveCard, Minute: 30, TeamID: "team-1", PlayerID: "player-1"}},
+		{name: "substitution without the player coming on", event: domain.LiveEvent{Type: domain.LiveSubstitution, Minute: 30, TeamID: "team-1", PlayerID: "player-1"}},
+		{name: "unknown type", event: domain.LiveEvent{Type: "corner", Minute: 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mockMatchRepo, _, _ := setupMatchService(t)
+			ctx := context.Background()
+			m, history := liveFeed()
+
+			mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+			svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(history, nil)
+
+			_, err := svc.PostLiveEvent(ctx, "match-1", &tt.event)
+
+			assertMatchErrorCode(t, err, derrors.ErrorCodeBadRequest)
+		})
+	}
+}
+
+func TestMatchService_PostLiveEvent_MatchNotInProgress(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(scheduledMatch(upcomingMatchDate()), nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(nil, nil)
+
+	_, err := svc.PostLiveEvent(ctx, "match-1", &domain.LiveEvent{Type: domain.LiveKickoff})
+
+	if !errors.Is(err, domain.ErrMatchNotInProgress) {
+		t.Fatalf("expected ErrMatchNotInProgress, got: %v", err)
+	}
+}
+
+func TestMatchService_GetLiveEvents_ResumesAfterSequence(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, history := liveFeed()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(1)).Return(history[1:], nil)
+
+	events, err := svc.GetLiveEvents(ctx, "match-1", 1)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(events) != 1 || events[0].Sequence != 2 {
+		t.Fatalf("expected only the events after the first, got %+v", events)
+	}
+}
+
+func TestMatchService_GetLiveEvents_MatchNotFound(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "missing").Return(nil, matchNotFound())
+
+	_, err := svc.GetLiveEvents(ctx, "missing", 0)
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
+
+func TestMatchService_LiveFeedOver_StillPlaying(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, history := liveFeed()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(history, nil)
+
+	over, err := svc.LiveFeedOver(ctx, "match-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if over {
+		t.Fatal("expected the feed of a match being played to go on")
+	}
+}
+
+func TestMatchService_LiveFeedOver_FullTime(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, history := liveFeed()
+	history = append(history, domain.LiveEvent{Sequence: 3, MatchID: "match-1", Type: domain.LiveFullTime, Minute: 90, HomeScore: 1})
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(history, nil)
+
+	over, err := svc.LiveFeedOver(ctx, "match-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !over {
+		t.Fatal("expected the feed to be over after full time")
+	}
+}
+
+func TestMatchService_LiveFeedOver_MatchAbandoned(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+	m, history := liveFeed()
+	m.Status = domain.StatusAbandoned
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(m, nil)
+	svc.liveRepo.(*mockDomain.MockLiveEventRepository).EXPECT().FindByMatchID(ctx, "match-1", int64(0)).Return(history, nil)
+
+	over, err := svc.LiveFeedOver(ctx, "match-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !over {
+		t.Fatal("expected the feed of an abandoned match to be over")
+	}
+}
+
+func TestMatchService_LiveFeedOver_MatchNotFound(t *testing.T) {
+	svc, mockMatchRepo, _, _ := setupMatchService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "missing").Return(nil, matchNotFound())
+
+	_, err := svc.LiveFeedOver(ctx, "missing")
+
+	assertMatchErrorCode(t, err, derrors.ErrorCodeNotFound)
+}
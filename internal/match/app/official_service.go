@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/jwt"
+)
+
+// credentialGrace is how long after kickoff an official can still submit the result of their match.
+const credentialGrace = 24 * time.Hour
+
+type OfficialService struct {
+	officialRepo domain.OfficialRepository
+	matchRepo    domain.MatchRepository
+	jwtService   *jwt.Service
+}
+
+func NewOfficialService(officialRepo domain.OfficialRepository, matchRepo domain.MatchRepository, jwtService *jwt.Service) OfficialServicePort {
+	return &OfficialService{
+		officialRepo: officialRepo,
+		matchRepo:    matchRepo,
+		jwtService:   jwtService,
+	}
+}
+
+func (s *OfficialService) Create(ctx context.Context, official *domain.Official) (string, error) {
+	newOfficial, err := domain.NewOfficial(official.Name, official.Email)
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := s.officialRepo.ExistsByEmail(ctx, newOfficial.Email, "")
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", derrors.WrapErrorf(domain.ErrOfficialEmailTaken, derrors.ErrorCodeDuplicate, "official email %q is already taken", newOfficial.Email)
+	}
+
+	if err := s.officialRepo.Create(ctx, newOfficial); err != nil {
+		return "", err
+	}
+
+	return newOfficial.ID, nil
+}
+
+func (s *OfficialService) GetByID(ctx context.Context, id string) (*domain.Official, error) {
+	return s.officialRepo.FindByID(ctx, id)
+}
+
+func (s *OfficialService) GetAll(ctx context.Context) ([]domain.Official, error) {
+	return s.officialRepo.FindAll(ctx)
+}
+
+func (s *OfficialService) Update(ctx context.Context, id string, official *domain.Official) error {
+	existing, err := s.officialRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := existing.Update(official.Name, official.Email); err != nil {
+		return err
+	}
+
+	exists, err := s.officialRepo.ExistsByEmail(ctx, existing.Email, id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return derrors.WrapErrorf(domain.ErrOfficialEmailTaken, derrors.ErrorCodeDuplicate, "official email %q is already taken", existing.Email)
+	}
+
+	return s.officialRepo.Update(ctx, existing)
+}
+
+// Delete removes an official from the registry. Officials still assigned to matches
+// that have not been played must be unassigned first.
+func (s *OfficialService) Delete(ctx context.Context, id string) error {
+	if _, err := s.officialRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	inUse, err := s.officialRepo.HasUpcomingMatches(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return derrors.WrapErrorf(domain.ErrOfficialInUse, derrors.ErrorCodeBadRequest, "%s, unassign them first", domain.ErrOfficialInUse.Error())
+	}
+
+	return s.officialRepo.Delete(ctx, id)
+}
+
+// Assign gives the official a role in the match, as long as they are free on the day of the match.
+func (s *OfficialService) Assign(ctx context.Context, matchID, officialID string, role domain.OfficialRole) (*domain.MatchOfficial, error) {
+	match, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	official, err := s.officialRepo.FindByID(ctx, officialID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.officialRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := match.Day()
+	sameDay, err := s.officialRepo.FindMatches(ctx, officialID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := match.AssignOfficial(official, role, current, sameDay)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.officialRepo.Assign(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (s *OfficialService) Unassign(ctx context.Context, matchID, officialID string) error {
+	match, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	switch match.Status {
+	case domain.StatusFinished, domain.StatusCancelled, domain.StatusAbandoned:
+		return derrors.WrapErrorf(domain.ErrMatchLocked, derrors.ErrorCodeBadRequest, "officials of a %s match cannot be changed", match.Status)
+	}
+
+	return s.officialRepo.Unassign(ctx, matchID, officialID)
+}
+
+// IssueCredential returns a token that only lets the official submit the result of the match.
+// It expires a day after kickoff.
+func (s *OfficialService) IssueCredential(ctx context.Context, matchID, officialID string) (string, time.Time, error) {
+	match, err := s.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	switch match.Status {
+	case domain.StatusFinished, domain.StatusCancelled, domain.StatusAbandoned:
+		return "", time.Time{}, derrors.WrapErrorf(domain.ErrMatchLocked, derrors.ErrorCodeBadRequest, "the result of a %s match cannot be submitted", match.Status)
+	}
+
+	officials, err := s.officialRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	var assigned *domain.MatchOfficial
+	for i := range officials {
+		if officials[i].OfficialID == officialID {
+			assigned = &officials[i]
+			break
+		}
+	}
+	if assigned == nil {
+		return "", time.Time{}, derrors.WrapErrorf(domain.ErrOfficialNotAssigned, derrors.ErrorCodeNotFound, "%s", domain.ErrOfficialNotAssigned.Error())
+	}
+
+	expiresAt := match.KickoffAt.Add(credentialGrace)
+	expiry := time.Until(expiresAt)
+	if expiry <= 0 {
+		return "", time.Time{}, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "the match kicked off more than %s ago", credentialGrace)
+	}
+
+	token, err := s.jwtService.GenerateToken(jwt.JwtAttr{
+		Email: assigned.OfficialEmail,
+		Role:  domain.OfficialTokenRole,
+		Scope: domain.ResultScope(matchID),
+	}, expiry)
+	if err != nil {
+		return "", time.Time{}, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to generate token")
+	}
+
+	return token, expiresAt, nil
+}
+
+// CheckCredential makes sure the official the credential was issued to is still assigned to the match.
+func (s *OfficialService) CheckCredential(ctx context.Context, matchID, email string) error {
+	officials, err := s.officialRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range officials {
+		if strings.EqualFold(o.OfficialEmail, email) {
+			return nil
+		}
+	}
+
+	return derrors.WrapErrorf(domain.ErrOfficialNotAssigned, derrors.ErrorCodeForbidden, "%s", domain.ErrOfficialNotAssigned.Error())
+}
@@ -0,0 +1,282 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/mock"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/jwt"
+	"go.uber.org/mock/gomock"
+)
+
+func setupOfficialService(t *testing.T) (
+	*OfficialService,
+	*mockDomain.MockOfficialRepository,
+	*mockDomain.MockMatchRepository,
+) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	mockOfficialRepo := mockDomain.NewMockOfficialRepository(ctrl)
+	mockMatchRepo := mockDomain.NewMockMatchRepository(ctrl)
+	svc := &OfficialService{
+		officialRepo: mockOfficialRepo,
+		matchRepo:    mockMatchRepo,
+		jwtService:   jwt.NewService(key, &key.PublicKey, "test", "test"),
+	}
+	return svc, mockOfficialRepo, mockMatchRepo
+}
+
+// officiatedMatch returns a scheduled match kicking off at the HH:MM time on upcomingMatchDate in Western Indonesian Time.
+func officiatedMatch(id, hhmm string) *domain.Match {
+	m := onCalendar(domain.Match{ID: id, HomeTeamID: "team-1", AwayTeamID: "team-2", MatchDate: upcomingMatchDate(), MatchTime: hhmm, Status: domain.StatusScheduled})
+	return &m
+}
+
+func referee() *domain.Official {
+	return &domain.Official{ID: "official-1", Name: "Thoriq Alkatiri", Email: "thoriq@ayo.id"}
+}
+
+// ---------------------------------------------------------------------------
+// Create
+// ---------------------------------------------------------------------------
+
+func TestOfficialService_Create_Success(t *testing.T) {
+	svc, mockOfficialRepo, _ := setupOfficialService(t)
+	ctx := context.Background()
+
+	mockOfficialRepo.EXPECT().ExistsByEmail(ctx, "thoriq@ayo.id", "").Return(false, nil)
+	mockOfficialRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	id, err := svc.Create(ctx, &domain.Official{Name: " Thoriq Alkatiri ", Email: "thoriq@ayo.id"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if id == "" {
+		t.Fatal("expected non-empty ID, got empty string")
+	}
+}
+
+func TestOfficialService_Create_InvalidEmail(t *testing.T) {
+	svc, _, _ := setupOfficialService(t)
+
+	_, err := svc.Create(context.Background(), &domain.Official{Name: "Thoriq Alkatiri", Email: "not-an-email"})
+
+	var derr *derrors.Error
+	if !errors.As(err, &derr) || derr.Code() != derrors.ErrorCodeBadRequest {
+		t.Fatalf("expected bad request, got: %v", err)
+	}
+}
+
+func TestOfficialService_Create_EmailTaken(t *testing.T) {
+	svc, mockOfficialRepo, _ := setupOfficialService(t)
+	ctx := context.Background()
+
+	mockOfficialRepo.EXPECT().ExistsByEmail(ctx, "thoriq@ayo.id", "").Return(true, nil)
+
+	_, err := svc.Create(ctx, &domain.Official{Name: "Thoriq Alkatiri", Email: "thoriq@ayo.id"})
+
+	if !errors.Is(err, domain.ErrOfficialEmailTaken) {
+		t.Fatalf("expected ErrOfficialEmailTaken, got: %v", err)
+	}
+}
+
+func TestOfficialService_Delete_AssignedToUpcomingMatch(t *testing.T) {
+	svc, mockOfficialRepo, _ := setupOfficialService(t)
+	ctx := context.Background()
+
+	mockOfficialRepo.EXPECT().FindByID(ctx, "official-1").Return(referee(), nil)
+	mockOfficialRepo.EXPECT().HasUpcomingMatches(ctx, "official-1").Return(true, nil)
+
+	err := svc.Delete(ctx, "official-1")
+
+	if !errors.Is(err, domain.ErrOfficialInUse) {
+		t.Fatalf("expected ErrOfficialInUse, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Assign
+// ---------------------------------------------------------------------------
+
+func TestOfficialService_Assign_Success(t *testing.T) {
+	svc, mockOfficialRepo, mockMatchRepo := setupOfficialService(t)
+	ctx := context.Background()
+	match := officiatedMatch("match-1", "19:30")
+	from, to := match.Day()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockOfficialRepo.EXPECT().FindByID(ctx, "official-1").Return(referee(), nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(nil, nil)
+	mockOfficialRepo.EXPECT().FindMatches(ctx, "official-1", from, to).Return(nil, nil)
+	mockOfficialRepo.EXPECT().Assign(ctx, gomock.Any()).Return(nil)
+
+	assignment, err := svc.Assign(ctx, "match-1", "official-1", "Referee")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if assignment.Role != domain.RoleReferee || assignment.MatchID != "match-1" {
+		t.Fatalf("expected the official to referee match-1, got %+v", assignment)
+	}
+}
+
+func TestOfficialService_Assign_DayIsLocalToVenue(t *testing.T) {
+	match := officiatedMatch("match-1", "00:30")
+
+	from, to := match.Day()
+
+	if !from.Before(match.KickoffAt) || !to.After(match.KickoffAt) {
+		t.Fatalf("expected kickoff %v within the day %v - %v", match.KickoffAt, from, to)
+	}
+	if to.Sub(from) != 24*time.Hour {
+		t.Fatalf("expected a 24 hour day, got %v", to.Sub(from))
+	}
+}
+
+func TestOfficialService_Assign_SameDayConflict(t *testing.T) {
+	svc, mockOfficialRepo, mockMatchRepo := setupOfficialService(t)
+	ctx := context.Background()
+	match := officiatedMatch("match-1", "19:30")
+	other := officiatedMatch("match-2", "15:00")
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockOfficialRepo.EXPECT().FindByID(ctx, "official-1").Return(referee(), nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(nil, nil)
+	mockOfficialRepo.EXPECT().FindMatches(ctx, "official-1", gomock.Any(), gomock.Any()).Return([]domain.Match{*other}, nil)
+
+	_, err := svc.Assign(ctx, "match-1", "official-1", domain.RoleReferee)
+
+	if !errors.Is(err, domain.ErrOfficialUnavailable) {
+		t.Fatalf("expected ErrOfficialUnavailable, got: %v", err)
+	}
+	var derr *derrors.Error
+	if !errors.As(err, &derr) || len(derr.Details()) != 1 || derr.Details()[0].Field != "official_id" {
+		t.Fatalf("expected one detail on official_id, got: %v", err)
+	}
+}
+
+func TestOfficialService_Assign_CancelledMatchSameDayIsFree(t *testing.T) {
+	svc, mockOfficialRepo, mockMatchRepo := setupOfficialService(t)
+	ctx := context.Background()
+	match := officiatedMatch("match-1", "19:30")
+	other := officiatedMatch("match-2", "15:00")
+	other.Status = domain.StatusCancelled
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockOfficialRepo.EXPECT().FindByID(ctx, "official-1").Return(referee(), nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(nil, nil)
+	mockOfficialRepo.EXPECT().FindMatches(ctx, "official-1", gomock.Any(), gomock.Any()).Return([]domain.Match{*other}, nil)
+	mockOfficialRepo.EXPECT().Assign(ctx, gomock.Any()).Return(nil)
+
+	if _, err := svc.Assign(ctx, "match-1", "official-1", domain.RoleReferee); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestOfficialService_Assign_RoleFilled(t *testing.T) {
+	svc, mockOfficialRepo, mockMatchRepo := setupOfficialService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(officiatedMatch("match-1", "19:30"), nil)
+	mockOfficialRepo.EXPECT().FindByID(ctx, "official-1").Return(referee(), nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.MatchOfficial{
+		{MatchID: "match-1", OfficialID: "official-2", Role: domain.RoleReferee},
+	}, nil)
+	mockOfficialRepo.EXPECT().FindMatches(ctx, "official-1", gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	_, err := svc.Assign(ctx, "match-1", "official-1", domain.RoleReferee)
+
+	if !errors.Is(err, domain.ErrOfficialRoleFilled) {
+		t.Fatalf("expected ErrOfficialRoleFilled, got: %v", err)
+	}
+}
+
+func TestOfficialService_Assign_FinishedMatch(t *testing.T) {
+	svc, mockOfficialRepo, mockMatchRepo := setupOfficialService(t)
+	ctx := context.Background()
+	match := officiatedMatch("match-1", "19:30")
+	match.Status = domain.StatusFinished
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockOfficialRepo.EXPECT().FindByID(ctx, "official-1").Return(referee(), nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(nil, nil)
+	mockOfficialRepo.EXPECT().FindMatches(ctx, "official-1", gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	_, err := svc.Assign(ctx, "match-1", "official-1", domain.RoleReferee)
+
+	if !errors.Is(err, domain.ErrMatchLocked) {
+		t.Fatalf("expected ErrMatchLocked, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Credentials
+// ---------------------------------------------------------------------------
+
+func TestOfficialService_IssueCredential_NotAssigned(t *testing.T) {
+	svc, mockOfficialRepo, mockMatchRepo := setupOfficialService(t)
+	ctx := context.Background()
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(officiatedMatch("match-1", "19:30"), nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return(nil, nil)
+
+	_, _, err := svc.IssueCredential(ctx, "match-1", "official-1")
+
+	if !errors.Is(err, domain.ErrOfficialNotAssigned) {
+		t.Fatalf("expected ErrOfficialNotAssigned, got: %v", err)
+	}
+}
+
+func TestOfficialService_IssueCredential_ScopedToMatchResult(t *testing.T) {
+	svc, mockOfficialRepo, mockMatchRepo := setupOfficialService(t)
+	ctx := context.Background()
+	match := officiatedMatch("match-1", "19:30")
+
+	mockMatchRepo.EXPECT().FindByID(ctx, "match-1").Return(match, nil)
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.MatchOfficial{
+		{MatchID: "match-1", OfficialID: "official-1", OfficialEmail: "thoriq@ayo.id", Role: domain.RoleReferee},
+	}, nil)
+
+	token, expiresAt, err := svc.IssueCredential(ctx, "match-1", "official-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !expiresAt.Equal(match.KickoffAt.Add(24 * time.Hour)) {
+		t.Fatalf("expected the credential to expire a day after kickoff, got %v", expiresAt)
+	}
+	attr, err := svc.jwtService.ParseAndVerify(token)
+	if err != nil {
+		t.Fatalf("expected a valid token, got: %v", err)
+	}
+	if attr.Scope != "match:match-1:result" || attr.Role != domain.OfficialTokenRole || attr.Email != "thoriq@ayo.id" {
+		t.Fatalf("expected a result credential for thoriq@ayo.id, got %+v", attr)
+	}
+}
+
+func TestOfficialService_CheckCredential_Unassigned(t *testing.T) {
+	svc, mockOfficialRepo, _ := setupOfficialService(t)
+	ctx := context.Background()
+
+	mockOfficialRepo.EXPECT().FindByMatchID(ctx, "match-1").Return([]domain.MatchOfficial{
+		{MatchID: "match-1", OfficialID: "official-2", OfficialEmail: "other@ayo.id", Role: domain.RoleReferee},
+	}, nil)
+
+	err := svc.CheckCredential(ctx, "match-1", "thoriq@ayo.id")
+
+	var derr *derrors.Error
+	if !errors.As(err, &derr) || derr.Code() != derrors.ErrorCodeForbidden {
+		t.Fatalf("expected forbidden, got: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
 )
@@ -10,11 +11,46 @@ import (
 
 // MatchServicePort defines the contract for match business operations.
 type MatchServicePort interface {
-	CreateMatch(ctx context.Context, match *domain.Match) (string, error)
+	CreateMatch(ctx context.Context, match *domain.Match, force bool) (string, error)
 	GetMatchByID(ctx context.Context, id string) (*domain.Match, error)
-	GetAllMatches(ctx context.Context) ([]domain.Match, error)
+	GetAllMatches(ctx context.Context, filter domain.MatchFilter) ([]domain.Match, error)
 	ReportResult(ctx context.Context, matchID string, result *domain.MatchResult) (string, error)
+	AmendResult(ctx context.Context, matchID string, result *domain.MatchResult, changedBy, reason string) (string, error)
+	VoidResult(ctx context.Context, matchID, changedBy, reason string) (*domain.Match, error)
+	GetResultRevisions(ctx context.Context, matchID string) ([]domain.ResultRevision, error)
 	GetMatchReport(ctx context.Context, matchID string) (*domain.MatchReportView, error)
 	GetAllMatchReports(ctx context.Context) ([]domain.MatchReportView, error)
+	GetPlayerSuspensions(ctx context.Context, playerID string) ([]domain.Suspension, error)
+	GetSuspendedPlayers(ctx context.Context, teamID string) ([]domain.Suspension, error)
+	SubmitLineup(ctx context.Context, matchID string, lineup *domain.Lineup, submittedBy string) (*domain.Lineup, error)
+	GetLineups(ctx context.Context, matchID string) ([]domain.Lineup, error)
+	AddSubstitution(ctx context.Context, matchID string, sub *domain.Substitution) (string, error)
+	PostLiveEvent(ctx context.Context, matchID string, event *domain.LiveEvent) (*domain.LiveEvent, error)
+	GetLiveEvents(ctx context.Context, matchID string, afterSequence int64) ([]domain.LiveEvent, error)
+	LiveFeedOver(ctx context.Context, matchID string) (bool, error)
+	SubscribeLiveEvents(matchID string) (<-chan domain.LiveEvent, func())
 	DeleteMatch(ctx context.Context, id string) error
+	RestoreMatch(ctx context.Context, id string) (*domain.Match, error)
+	StartMatch(ctx context.Context, id string) (*domain.Match, error)
+	PostponeMatch(ctx context.Context, id string, matchDate time.Time, matchTime, reason string, force bool) (*domain.Match, error)
+	CancelMatch(ctx context.Context, id, reason string) (*domain.Match, error)
+	AbandonMatch(ctx context.Context, id, reason string) (*domain.Match, error)
+	UpdateMatch(ctx context.Context, id string, matchDate time.Time, matchTime, venueID, reason string, force bool) (*domain.Match, error)
+	GetMatchReschedules(ctx context.Context, id string) ([]domain.MatchReschedule, error)
+	GenerateFixtures(ctx context.Context, opts domain.FixtureOptions) ([]domain.Fixture, error)
+	DrawKnockout(ctx context.Context, opts domain.DrawOptions) (*domain.Bracket, error)
+	GetBracket(ctx context.Context, competitionID, seasonID string) (*domain.Bracket, error)
+}
+
+// OfficialServicePort defines the contract for the registry of match officials and their assignments.
+type OfficialServicePort interface {
+	Create(ctx context.Context, official *domain.Official) (string, error)
+	GetByID(ctx context.Context, id string) (*domain.Official, error)
+	GetAll(ctx context.Context) ([]domain.Official, error)
+	Update(ctx context.Context, id string, official *domain.Official) error
+	Delete(ctx context.Context, id string) error
+	Assign(ctx context.Context, matchID, officialID string, role domain.OfficialRole) (*domain.MatchOfficial, error)
+	Unassign(ctx context.Context, matchID, officialID string) error
+	IssueCredential(ctx context.Context, matchID, officialID string) (string, time.Time, error)
+	CheckCredential(ctx context.Context, matchID, email string) error
 }
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+const (
+	regulationLength = 90
+	extraTimeLength  = 120
+)
+
+// Appearance is the time a player spent on the pitch in a match. Stoppage time is not counted,
+// so events after full time are treated as happening at full time.
+type Appearance struct {
+	ID        string
+	ResultID  string
+	PlayerID  string
+	TeamID    string
+	Started   bool
+	MinuteOn  int // 0 for starters
+	MinuteOff int // Full time, unless the player was substituted or sent off
+	DeletedAt *time.Time
+}
+
+// Minutes is how long the player was on the pitch.
+func (a Appearance) Minutes() int {
+	return a.MinuteOff - a.MinuteOn
+}
+
+// RecordAppearances works out who took the field and for how long from the lineups and substitutions
+// of the match and the players sent off in the result. Unused substitutes make no appearance,
+// and teams that submitted no lineup get no appearances.
+func (r *MatchResult) RecordAppearances(lineups []Lineup) {
+	length := regulationLength
+	if r.DecidedBy == DecidedInExtraTime || r.DecidedBy == DecidedOnPenalties {
+		length = extraTimeLength
+	}
+	clamp := func(minute int) int {
+		return min(minute, length)
+	}
+
+	sentOffAt := make(map[string]int)
+	for _, c := range r.Cards {
+		if c.SendsOff() {
+			sentOffAt[c.PlayerID] = c.Minute
+		}
+	}
+
+	r.Appearances = nil
+	for _, l := range lineups {
+		appearances := make([]Appearance, 0, startingLineupSize+maxSubstitutions)
+		onPitch := make(map[string]int) // Player ID to index in appearances
+		for _, p := range l.Starters() {
+			onPitch[p.PlayerID] = len(appearances)
+			appearances = append(appearances, Appearance{PlayerID: p.PlayerID, Started: true, MinuteOn: 0, MinuteOff: length})
+		}
+
+		subs := make([]Substitution, len(l.Substitutions))
+		copy(subs, l.Substitutions)
+		sort.SliceStable(subs, func(i, j int) bool { return subs[i].Minute < subs[j].Minute })
+		for _, s := range subs {
+			if i, ok := onPitch[s.PlayerOffID]; ok {
+				appearances[i].MinuteOff = clamp(s.Minute)
+				delete(onPitch, s.PlayerOffID)
+			}
+			onPitch[s.PlayerOnID] = len(appearances)
+			appearances = append(appearances, Appearance{PlayerID: s.PlayerOnID, MinuteOn: clamp(s.Minute), MinuteOff: length})
+		}
+
+		for i := range appearances {
+			a := &appearances[i]
+			if minute, sentOff := sentOffAt[a.PlayerID]; sentOff && clamp(minute) >= a.MinuteOn && clamp(minute) < a.MinuteOff {
+				a.MinuteOff = clamp(minute)
+			}
+			a.ID = ulid.GenerateID()
+			a.ResultID = r.ID
+			a.TeamID = l.TeamID
+		}
+		r.Appearances = append(r.Appearances, appearances...)
+	}
+}
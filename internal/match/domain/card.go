@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+// CardType is the kind of booking a player received.
+type CardType string
+
+const (
+	CardYellow       CardType = "yellow"
+	CardSecondYellow CardType = "second_yellow" // A second booking in the same match, which sends the player off
+	CardRed          CardType = "red"
+)
+
+// Card is a booking recorded against a player during a match.
+type Card struct {
+	ID         string
+	ResultID   string
+	PlayerID   string
+	PlayerName string
+	TeamID     string
+	Type       CardType
+	Minute     int
+	Reason     string
+	DeletedAt  *time.Time
+}
+
+// SendsOff reports whether the card removes the player from the match.
+func (c Card) SendsOff() bool {
+	return c.Type == CardSecondYellow || c.Type == CardRed
+}
+
+// validateCards checks the bookings of a match in the order they were shown, and that no player
+// scored or assisted after being sent off.
+func validateCards(cards []Card, goals []Goal, resultID, homeTeamID, awayTeamID string) error {
+	for i := range cards {
+		cards[i].ID = ulid.GenerateID()
+		cards[i].ResultID = resultID
+		cards[i].PlayerID = strings.TrimSpace(cards[i].PlayerID)
+		cards[i].TeamID = strings.TrimSpace(cards[i].TeamID)
+		cards[i].Reason = strings.TrimSpace(cards[i].Reason)
+
+		if cards[i].PlayerID == "" {
+			return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player ID is required for each card")
+		}
+		if cards[i].TeamID != homeTeamID && cards[i].TeamID != awayTeamID {
+			return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "card team ID %s does not belong to match participants", cards[i].TeamID)
+		}
+		if cards[i].Minute <= 0 || cards[i].Minute > maxGoalMinute {
+			return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "card minute must be between 1 and %d", maxGoalMinute)
+		}
+		switch cards[i].Type {
+		case CardYellow, CardSecondYellow, CardRed:
+		default:
+			return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "card type must be one of %q, %q or %q", CardYellow, CardSecondYellow, CardRed)
+		}
+	}
+
+	// Walk the bookings in match order. Cards shown in the same minute keep the order they were reported in.
+	ordered := make([]Card, len(cards))
+	copy(ordered, cards)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Minute < ordered[j].Minute })
+
+	booked := make(map[string]bool)
+	sentOffAt := make(map[string]int)
+	for _, c := range ordered {
+		if minute, sentOff := sentOffAt[c.PlayerID]; sentOff {
+			return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player %s was already sent off in minute %d", c.PlayerID, minute)
+		}
+		switch c.Type {
+		case CardYellow:
+			if booked[c.PlayerID] {
+				return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player %s was already booked, record a %q card instead", c.PlayerID, CardSecondYellow)
+			}
+			booked[c.PlayerID] = true
+		case CardSecondYellow:
+			if !booked[c.PlayerID] {
+				return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player %s has no earlier yellow card", c.PlayerID)
+			}
+		}
+		if c.SendsOff() {
+			sentOffAt[c.PlayerID] = c.Minute
+		}
+	}
+
+	for _, g := range goals {
+		if minute, sentOff := sentOffAt[g.PlayerID]; sentOff && g.GoalMinute > minute {
+			return derrors.WrapErrorf(ErrSentOffPlayerScored, derrors.ErrorCodeBadRequest, "player %s scored in minute %d after being sent off in minute %d", g.PlayerID, g.GoalMinute, minute)
+		}
+		if minute, sentOff := sentOffAt[g.AssistPlayerID]; sentOff && g.GoalMinute > minute {
+			return derrors.WrapErrorf(ErrSentOffPlayerScored, derrors.ErrorCodeBadRequest, "player %s assisted in minute %d after being sent off in minute %d", g.AssistPlayerID, g.GoalMinute, minute)
+		}
+	}
+
+	return nil
+}
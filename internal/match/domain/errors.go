@@ -6,6 +6,52 @@ import "errors"
 var (
 	ErrMatchNotFound       = errors.New("match not found")
 	ErrMatchResultNotFound = errors.New("match result not found")
+	ErrMatchNotStarted     = errors.New("results can only be reported for matches that have started")
+	ErrMatchNotDue         = errors.New("match cannot start before its scheduled date")
+	ErrInvalidTransition   = errors.New("invalid match status transition")
+	ErrMatchLocked         = errors.New("match can no longer be edited")
+	ErrMatchNotDeleted     = errors.New("match has not been deleted")
 	ErrResultAlreadyExists = errors.New("match result already reported")
 	ErrSameTeam            = errors.New("home team and away team cannot be the same")
+	ErrSeasonNotFound      = errors.New("season not found")
+	ErrTeamNotInSeason     = errors.New("team is not registered in the match season")
+	ErrDateOutsideSeason   = errors.New("match date is outside the season dates")
+	ErrNotEnoughTeams      = errors.New("at least two teams must be registered in the season")
+	ErrTeamWithoutVenue    = errors.New("team has no home venue")
+	ErrVenueNotFound       = errors.New("venue not found")
+	ErrScheduleConflict    = errors.New("match clashes with other matches on the calendar")
+	ErrSeasonHasFixtures   = errors.New("season already has scheduled matches")
+	ErrKnockoutSeason      = errors.New("round-robin fixtures cannot be generated for a knockout competition")
+	ErrNotKnockoutSeason   = errors.New("season does not belong to a knockout competition")
+	ErrBracketNotFound     = errors.New("bracket not found")
+	ErrBracketAlreadyDrawn = errors.New("bracket has already been drawn for this season")
+	ErrInvalidSeedOrder    = errors.New("seed order must list every registered team exactly once")
+	ErrTieNotFound         = errors.New("tie not found")
+	ErrTieAlreadyDecided   = errors.New("tie already has a winner")
+	ErrNotKnockoutMatch    = errors.New("extra time and penalties can only be recorded for knockout ties")
+	ErrNotDecidingLeg      = errors.New("extra time and penalties are only played in the deciding leg of a tie")
+	ErrShootoutNotNeeded   = errors.New("a penalty shootout is only held when the tie is level")
+	ErrTieUndecided        = errors.New("a knockout tie cannot end level, report extra time or penalties")
+	ErrScorerNotInSquad    = errors.New("goal scorers must be in the squad of the team they scored for")
+	ErrSentOffPlayerScored = errors.New("a player who was sent off cannot score or assist later in the match")
+	ErrScorerSuspended     = errors.New("suspended players cannot score in the matches they are banned from")
+	ErrPlayerIneligible    = errors.New("players must be within the age limit of an age-group competition")
+	ErrLineupLocked        = errors.New("lineups can only be submitted before kickoff")
+	ErrLineupNotFound      = errors.New("team has not submitted a lineup for this match")
+	ErrPlayerNotInSquad    = errors.New("lineup players must be in the squad of their team")
+	ErrDuplicateJersey     = errors.New("each player in a lineup must wear a different jersey number")
+	ErrLineupGoalkeeper    = errors.New("the starting lineup must have exactly one goalkeeper")
+	ErrMatchNotLive        = errors.New("substitutions can only be made during a live match")
+	ErrSubstitutionLimit   = errors.New("team has no substitutions left")
+	ErrSubstitutionClash   = errors.New("the lineup changed while the substitution was being made, try again")
+	ErrMatchNotInProgress  = errors.New("live events can only be posted while a match is in progress")
+	ErrLiveFeedOrder       = errors.New("live events must follow the course of the match")
+	ErrLiveEventConflict   = errors.New("another live event was posted at the same time, try again")
+	ErrOfficialNotFound    = errors.New("official not found")
+	ErrOfficialEmailTaken  = errors.New("another official already uses this email")
+	ErrOfficialInUse       = errors.New("official is assigned to matches that have not been played")
+	ErrOfficialAssigned    = errors.New("official is already assigned to this match")
+	ErrOfficialNotAssigned = errors.New("official is not assigned to this match")
+	ErrOfficialRoleFilled  = errors.New("match already has every official it needs in this role")
+	ErrOfficialUnavailable = errors.New("official is assigned to another match on the same day")
 )
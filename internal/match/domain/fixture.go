@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+// SeasonTeam is a team registered in a season, as seen by the Match context.
+type SeasonTeam struct {
+	TeamID            string
+	TeamName          string
+	HomeVenueID       string
+	HomeVenueName     string
+	HomeVenueTimezone string
+}
+
+// FixtureOptions controls how a season's round-robin fixture list is generated.
+type FixtureOptions struct {
+	SeasonID     string
+	StartDate    time.Time
+	IntervalDays int    // Days between consecutive match days
+	MatchTime    string // HH:MM kickoff for every generated match, on the clock at each venue
+	DoubleRound  bool   // Play every pairing twice, once at each ground
+	DryRun       bool   // Preview only, nothing is persisted
+}
+
+// Fixture is a generated match together with the match day it belongs to.
+type Fixture struct {
+	Round int
+	Match *Match
+}
+
+// GenerateRoundRobin builds a round-robin schedule using the circle method.
+// Each round is played IntervalDays after the previous one, teams alternate home and away
+// as evenly as possible, and every match is played at the home team's venue.
+// With an odd number of teams one team sits out (has a bye) each round.
+func GenerateRoundRobin(teams []SeasonTeam, opts FixtureOptions) ([]Fixture, error) {
+	if len(teams) < 2 {
+		return nil, derrors.WrapErrorf(ErrNotEnoughTeams, derrors.ErrorCodeBadRequest, "%s", ErrNotEnoughTeams.Error())
+	}
+	if opts.IntervalDays <= 0 {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "interval days must be a positive number")
+	}
+	for _, t := range teams {
+		if t.HomeVenueID == "" {
+			return nil, derrors.WrapErrorf(ErrTeamWithoutVenue, derrors.ErrorCodeBadRequest, "team %q has no home venue", t.TeamName)
+		}
+	}
+
+	// A nil slot stands for the bye when the number of teams is odd. Keeping it in the
+	// fixed position means whoever faces it simply rests that round.
+	slots := make([]*SeasonTeam, 0, len(teams)+1)
+	if len(teams)%2 != 0 {
+		slots = append(slots, nil)
+	}
+	for i := range teams {
+		slots = append(slots, &teams[i])
+	}
+
+	n := len(slots)
+	rounds := n - 1
+	fixtures := make([]Fixture, 0, rounds*n/2)
+
+	for r := 0; r < rounds; r++ {
+		for i := 0; i < n/2; i++ {
+			home, away := slots[i], slots[n-1-i]
+			// The fixed first slot flips every round, the rotating pairs flip by position,
+			// which keeps every team's home and away count within one of each other.
+			if (i == 0 && r%2 == 1) || (i > 0 && i%2 == 1) {
+				home, away = away, home
+			}
+			if home == nil || away == nil {
+				continue
+			}
+
+			match, err := newFixtureMatch(home, away, opts, r)
+			if err != nil {
+				return nil, err
+			}
+			fixtures = append(fixtures, Fixture{Round: r + 1, Match: match})
+		}
+
+		// Rotate every slot except the first one step clockwise
+		last := slots[n-1]
+		copy(slots[2:], slots[1:n-1])
+		slots[1] = last
+	}
+
+	if opts.DoubleRound {
+		firstLeg := len(fixtures)
+		for _, f := range fixtures[:firstLeg] {
+			home := findSeasonTeam(teams, f.Match.AwayTeamID)
+			away := findSeasonTeam(teams, f.Match.HomeTeamID)
+			round := f.Round - 1 + rounds
+
+			match, err := newFixtureMatch(home, away, opts, round)
+			if err != nil {
+				return nil, err
+			}
+			fixtures = append(fixtures, Fixture{Round: round + 1, Match: match})
+		}
+	}
+
+	return fixtures, nil
+}
+
+func newFixtureMatch(home, away *SeasonTeam, opts FixtureOptions, round int) (*Match, error) {
+	matchDate := opts.StartDate.AddDate(0, 0, round*opts.IntervalDays)
+	match, err := NewMatch(opts.SeasonID, home.TeamID, away.TeamID, matchDate, opts.MatchTime, home.HomeVenueID, home.HomeVenueTimezone)
+	if err != nil {
+		return nil, err
+	}
+	match.HomeTeamName = home.TeamName
+	match.AwayTeamName = away.TeamName
+	match.VenueName = home.HomeVenueName
+	return match, nil
+}
+
+func findSeasonTeam(teams []SeasonTeam, teamID string) *SeasonTeam {
+	for i := range teams {
+		if teams[i].TeamID == teamID {
+			return &teams[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,414 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+// DrawMethod decides how teams are placed into the first round of a bracket.
+type DrawMethod string
+
+const (
+	DrawSeeded DrawMethod = "seeded"
+	DrawRandom DrawMethod = "random"
+)
+
+// DrawOptions configures a knockout draw and the schedule its ties are played on.
+type DrawOptions struct {
+	SeasonID          string
+	Method            DrawMethod
+	SeedOrder         []string // Team IDs, top seed first. Seeded draws only, defaults to registration order
+	StartDate         time.Time
+	RoundIntervalDays int // Days between the first legs of consecutive rounds
+	LegIntervalDays   int // Days between the first and second leg of a tie
+	MatchTime         string
+}
+
+// TieLeg is one match of a tie as shown in the bracket.
+type TieLeg struct {
+	MatchID       string
+	MatchDate     time.Time
+	Played        bool
+	HomeScore     int
+	AwayScore     int
+	DecidedBy     DecidedBy
+	HomePenalties int
+	AwayPenalties int
+}
+
+// Tie pairs two teams in a knockout round. The home team hosts the first leg.
+// A tie with only one team is a bye, and that team advances without playing.
+type Tie struct {
+	ID               string
+	SeasonID         string
+	Round            int
+	Slot             int
+	HomeTeamID       string
+	AwayTeamID       string
+	FirstLegMatchID  string
+	SecondLegMatchID string
+	WinnerTeamID     string
+	HomeTeamName     string  // Populated on read
+	AwayTeamName     string  // Populated on read
+	WinnerTeamName   string  // Populated on read
+	FirstLeg         *TieLeg // Populated on read
+	SecondLeg        *TieLeg // Populated on read
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Bracket is the knockout tree of a season. Every round is created at draw time so the
+// full path to the final is visible before a ball is kicked.
+type Bracket struct {
+	SeasonID          string
+	Method            DrawMethod
+	StartDate         time.Time
+	RoundIntervalDays int
+	LegIntervalDays   int
+	MatchTime         string
+	Rounds            int
+	Ties              []*Tie
+	CreatedAt         time.Time
+}
+
+// NewBracket draws a bracket from teams ordered by seed and schedules every tie whose
+// teams are already known. The bracket is padded to the next power of two, with the
+// top seeds receiving the byes.
+func NewBracket(teams []SeasonTeam, opts DrawOptions, rules KnockoutRules) (*Bracket, []*Match, error) {
+	if len(teams) < 2 {
+		return nil, nil, derrors.WrapErrorf(ErrNotEnoughTeams, derrors.ErrorCodeBadRequest, "%s", ErrNotEnoughTeams.Error())
+	}
+	if opts.Method != DrawSeeded && opts.Method != DrawRandom {
+		return nil, nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "draw method must be %q or %q", DrawSeeded, DrawRandom)
+	}
+	if opts.RoundIntervalDays <= 0 {
+		return nil, nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "round interval days must be a positive number")
+	}
+	if rules.TwoLegged {
+		if opts.LegIntervalDays <= 0 {
+			return nil, nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "leg interval days must be a positive number for two-legged ties")
+		}
+		if opts.LegIntervalDays >= opts.RoundIntervalDays {
+			return nil, nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "leg interval days must be shorter than round interval days")
+		}
+	}
+	for _, t := range teams {
+		if t.HomeVenueID == "" {
+			return nil, nil, derrors.WrapErrorf(ErrTeamWithoutVenue, derrors.ErrorCodeBadRequest, "team %q has no home venue", t.TeamName)
+		}
+	}
+
+	size, rounds := 1, 0
+	for size < len(teams) {
+		size *= 2
+		rounds++
+	}
+
+	now := time.Now()
+	b := &Bracket{
+		SeasonID:          opts.SeasonID,
+		Method:            opts.Method,
+		StartDate:         opts.StartDate,
+		RoundIntervalDays: opts.RoundIntervalDays,
+		LegIntervalDays:   opts.LegIntervalDays,
+		MatchTime:         opts.MatchTime,
+		Rounds:            rounds,
+		CreatedAt:         now,
+	}
+	for round := 1; round <= rounds; round++ {
+		for slot := 0; slot < size>>round; slot++ {
+			b.Ties = append(b.Ties, &Tie{
+				ID:        ulid.GenerateID(),
+				SeasonID:  opts.SeasonID,
+				Round:     round,
+				Slot:      slot,
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+	}
+
+	// Seed numbers beyond the number of teams are byes
+	positions := seedPositions(size)
+	for i := 0; i < size/2; i++ {
+		tie := b.tie(1, i)
+		if seed := positions[2*i]; seed <= len(teams) {
+			tie.HomeTeamID = teams[seed-1].TeamID
+		}
+		if seed := positions[2*i+1]; seed <= len(teams) {
+			tie.AwayTeamID = teams[seed-1].TeamID
+		}
+	}
+
+	lookup := teamLookup(teams)
+	var matches []*Match
+	for i := 0; i < size/2; i++ {
+		tie := b.tie(1, i)
+		if tie.AwayTeamID == "" {
+			_, scheduled, err := b.RecordWinner(tie.ID, tie.HomeTeamID, rules, teams)
+			if err != nil {
+				return nil, nil, err
+			}
+			matches = append(matches, scheduled...)
+			continue
+		}
+		scheduled, err := b.schedule(tie, rules, lookup)
+		if err != nil {
+			return nil, nil, err
+		}
+		matches = append(matches, scheduled...)
+	}
+
+	return b, matches, nil
+}
+
+// Advancement is what the winner of a decided tie changes in its bracket: the ties that changed and
+// the matches of the next round it schedules. It is saved together with the result that decided the tie.
+type Advancement struct {
+	Ties    []*Tie
+	Matches []*Match
+}
+
+// RecordWinner marks a tie as won and moves the winner into its next-round tie, scheduling
+// that tie once both of its teams are known. It returns the ties that changed and any new matches.
+func (b *Bracket) RecordWinner(tieID, winnerTeamID string, rules KnockoutRules, teams []SeasonTeam) ([]*Tie, []*Match, error) {
+	var tie *Tie
+	for _, t := range b.Ties {
+		if t.ID == tieID {
+			tie = t
+			break
+		}
+	}
+	if tie == nil {
+		return nil, nil, derrors.WrapErrorf(ErrTieNotFound, derrors.ErrorCodeNotFound, "%s", ErrTieNotFound.Error())
+	}
+	if winnerTeamID != tie.HomeTeamID && winnerTeamID != tie.AwayTeamID {
+		return nil, nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "team %s is not part of this tie", winnerTeamID)
+	}
+	if tie.WinnerTeamID != "" {
+		return nil, nil, derrors.WrapErrorf(ErrTieAlreadyDecided, derrors.ErrorCodeBadRequest, "%s", ErrTieAlreadyDecided.Error())
+	}
+
+	now := time.Now()
+	tie.WinnerTeamID = winnerTeamID
+	tie.UpdatedAt = now
+	changed := []*Tie{tie}
+
+	// The final has nowhere to advance to
+	if tie.Round == b.Rounds {
+		return changed, nil, nil
+	}
+
+	next := b.tie(tie.Round+1, tie.Slot/2)
+	if tie.Slot%2 == 0 {
+		next.HomeTeamID = winnerTeamID
+	} else {
+		next.AwayTeamID = winnerTeamID
+	}
+	next.UpdatedAt = now
+	changed = append(changed, next)
+
+	if next.HomeTeamID == "" || next.AwayTeamID == "" {
+		return changed, nil, nil
+	}
+
+	matches, err := b.schedule(next, rules, teamLookup(teams))
+	if err != nil {
+		return nil, nil, err
+	}
+	return changed, matches, nil
+}
+
+// RoundName returns the conventional name of a round, e.g. "Final" or "Round of 16".
+func (b *Bracket) RoundName(round int) string {
+	remaining := 1 << (b.Rounds - round + 1)
+	switch remaining {
+	case 2:
+		return "Final"
+	case 4:
+		return "Semi-finals"
+	case 8:
+		return "Quarter-finals"
+	default:
+		return fmt.Sprintf("Round of %d", remaining)
+	}
+}
+
+// Decide works out the winner of a tie from its legs. It reports false while a leg is still
+// to be played, or when the teams are level after every tiebreaker, including a shootout
+// in the deciding leg.
+func (t *Tie) Decide(rules KnockoutRules, firstLeg, secondLeg *MatchResult) (string, bool) {
+	if firstLeg == nil || (rules.TwoLegged && secondLeg == nil) {
+		return "", false
+	}
+
+	// The home team hosts the first leg and travels for the second
+	homeTotal, awayTotal := firstLeg.HomeScore, firstLeg.AwayScore
+	if rules.TwoLegged {
+		homeTotal += secondLeg.AwayScore
+		awayTotal += secondLeg.HomeScore
+	}
+
+	switch {
+	case homeTotal > awayTotal:
+		return t.HomeTeamID, true
+	case awayTotal > homeTotal:
+		return t.AwayTeamID, true
+	}
+
+	if rules.TwoLegged && rules.AwayGoalsRule {
+		homeAwayGoals, awayAwayGoals := secondLeg.AwayScore, firstLeg.AwayScore
+		switch {
+		case homeAwayGoals > awayAwayGoals:
+			return t.HomeTeamID, true
+		case awayAwayGoals > homeAwayGoals:
+			return t.AwayTeamID, true
+		}
+	}
+
+	// The shootout takes place in the deciding leg, hosted by the away team when two-legged
+	decider, deciderHome, deciderAway := firstLeg, t.HomeTeamID, t.AwayTeamID
+	if rules.TwoLegged {
+		decider, deciderHome, deciderAway = secondLeg, t.AwayTeamID, t.HomeTeamID
+	}
+	if decider.DecidedBy == DecidedOnPenalties {
+		switch {
+		case decider.HomePenalties > decider.AwayPenalties:
+			return deciderHome, true
+		case decider.AwayPenalties > decider.HomePenalties:
+			return deciderAway, true
+		}
+	}
+
+	return "", false
+}
+
+// CheckResult verifies that the way a leg was decided fits the state of the tie. Extra time and
+// penalties belong to the deciding leg only, a shootout needs the tie to be level, and the
+// deciding leg must produce a winner. The legs passed in include the result being checked.
+func (t *Tie) CheckResult(rules KnockoutRules, matchID string, firstLeg, secondLeg *MatchResult) error {
+	result, decidingLegID := firstLeg, t.FirstLegMatchID
+	if matchID == t.SecondLegMatchID {
+		result = secondLeg
+	}
+	if rules.TwoLegged {
+		decidingLegID = t.SecondLegMatchID
+	}
+
+	if matchID != decidingLegID {
+		if result.DecidedBy != DecidedInRegulation {
+			return derrors.WrapErrorf(ErrNotDecidingLeg, derrors.ErrorCodeBadRequest, "%s", ErrNotDecidingLeg.Error())
+		}
+		return nil
+	}
+
+	if firstLeg == nil || (rules.TwoLegged && secondLeg == nil) {
+		if result.DecidedBy != DecidedInRegulation {
+			return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "the first leg result must be reported before extra time or penalties in the second leg")
+		}
+		return nil
+	}
+
+	// Work out whether the tie was settled before any shootout
+	withoutShootout := *result
+	withoutShootout.DecidedBy = DecidedInRegulation
+	first, second := firstLeg, secondLeg
+	if rules.TwoLegged {
+		second = &withoutShootout
+	} else {
+		first = &withoutShootout
+	}
+	_, settled := t.Decide(rules, first, second)
+
+	if result.DecidedBy == DecidedOnPenalties {
+		if settled {
+			return derrors.WrapErrorf(ErrShootoutNotNeeded, derrors.ErrorCodeBadRequest, "%s", ErrShootoutNotNeeded.Error())
+		}
+		return nil
+	}
+	if !settled {
+		return derrors.WrapErrorf(ErrTieUndecided, derrors.ErrorCodeBadRequest, "%s", ErrTieUndecided.Error())
+	}
+	return nil
+}
+
+// Aggregate sums the played legs from the point of view of the tie's home team.
+func (t *Tie) Aggregate() (home, away int) {
+	if t.FirstLeg != nil && t.FirstLeg.Played {
+		home += t.FirstLeg.HomeScore
+		away += t.FirstLeg.AwayScore
+	}
+	if t.SecondLeg != nil && t.SecondLeg.Played {
+		home += t.SecondLeg.AwayScore
+		away += t.SecondLeg.HomeScore
+	}
+	return home, away
+}
+
+func (b *Bracket) tie(round, slot int) *Tie {
+	for _, t := range b.Ties {
+		if t.Round == round && t.Slot == slot {
+			return t
+		}
+	}
+	return nil
+}
+
+// schedule creates the matches of a tie once both teams are known.
+func (b *Bracket) schedule(tie *Tie, rules KnockoutRules, teams map[string]SeasonTeam) ([]*Match, error) {
+	home, away := teams[tie.HomeTeamID], teams[tie.AwayTeamID]
+
+	// A late draw or delayed round never schedules a leg in the past
+	loc, err := LoadTimezone(home.HomeVenueTimezone)
+	if err != nil {
+		return nil, err
+	}
+	today := calendarDay(time.Now().In(loc))
+	firstLegDate := b.StartDate.AddDate(0, 0, (tie.Round-1)*b.RoundIntervalDays)
+	if firstLegDate.Before(today) {
+		firstLegDate = today
+	}
+
+	firstLeg, err := NewMatch(b.SeasonID, home.TeamID, away.TeamID, firstLegDate, b.MatchTime, home.HomeVenueID, home.HomeVenueTimezone)
+	if err != nil {
+		return nil, err
+	}
+	tie.FirstLegMatchID = firstLeg.ID
+	matches := []*Match{firstLeg}
+
+	if rules.TwoLegged {
+		secondLeg, err := NewMatch(b.SeasonID, away.TeamID, home.TeamID, firstLegDate.AddDate(0, 0, b.LegIntervalDays), b.MatchTime, away.HomeVenueID, away.HomeVenueTimezone)
+		if err != nil {
+			return nil, err
+		}
+		tie.SecondLegMatchID = secondLeg.ID
+		matches = append(matches, secondLeg)
+	}
+
+	return matches, nil
+}
+
+// seedPositions returns seed numbers in bracket order so that, if the favourites keep
+// winning, seed 1 meets seed 2 only in the final. For a size of 8: 1 8 4 5 2 7 3 6.
+func seedPositions(size int) []int {
+	positions := []int{1}
+	for len(positions) < size {
+		next := make([]int, 0, len(positions)*2)
+		for _, seed := range positions {
+			next = append(next, seed, 2*len(positions)+1-seed)
+		}
+		positions = next
+	}
+	return positions
+}
+
+func teamLookup(teams []SeasonTeam) map[string]SeasonTeam {
+	lookup := make(map[string]SeasonTeam, len(teams))
+	for _, t := range teams {
+		lookup[t.TeamID] = t
+	}
+	return lookup
+}
@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+const (
+	startingLineupSize = 11
+	maxBenchSize       = 12
+	maxSubstitutions   = 5
+	positionGoalkeeper = "GK" // As stored by the Club context
+)
+
+// LineupPlayer is a player named in a team's matchday squad, either in the starting XI or on the bench.
+type LineupPlayer struct {
+	PlayerID     string
+	PlayerName   string // Populated from the squad
+	Position     string // Populated from the squad
+	JerseyNumber int    // Defaults to the number the player is registered with
+	Starter      bool
+}
+
+// Lineup is the starting XI and bench a team submits before kickoff.
+// Submitting again before kickoff replaces the previous lineup.
+type Lineup struct {
+	ID            string
+	MatchID       string
+	TeamID        string
+	Players       []LineupPlayer // Starting XI first, then the bench
+	Substitutions []Substitution // Populated on read
+	SubmittedBy   string
+	CreatedAt     time.Time
+	DeletedAt     *time.Time
+}
+
+// NewLineup validates the shape of a lineup. Squad membership is checked separately by CheckSquad.
+func NewLineup(m *Match, teamID string, players []LineupPlayer, submittedBy string) (*Lineup, error) {
+	teamID = strings.TrimSpace(teamID)
+
+	if m.Status != StatusScheduled && m.Status != StatusPostponed {
+		return nil, derrors.WrapErrorf(ErrLineupLocked, derrors.ErrorCodeBadRequest, "%s, match is %s", ErrLineupLocked.Error(), m.Status)
+	}
+	if teamID != m.HomeTeamID && teamID != m.AwayTeamID {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "team ID %s does not belong to match participants", teamID)
+	}
+
+	// Starters go first so the stored order reads like a team sheet
+	ordered := make([]LineupPlayer, 0, len(players))
+	for _, starter := range []bool{true, false} {
+		for _, p := range players {
+			if p.Starter == starter {
+				ordered = append(ordered, p)
+			}
+		}
+	}
+
+	starters := 0
+	seen := make(map[string]bool, len(ordered))
+	for i := range ordered {
+		ordered[i].PlayerID = strings.TrimSpace(ordered[i].PlayerID)
+		if ordered[i].PlayerID == "" {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player ID is required for each lineup player")
+		}
+		if seen[ordered[i].PlayerID] {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player %s is named more than once in the lineup", ordered[i].PlayerID)
+		}
+		seen[ordered[i].PlayerID] = true
+		if ordered[i].JerseyNumber < 0 || ordered[i].JerseyNumber > 99 {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "jersey number must be between 1 and 99")
+		}
+		if ordered[i].Starter {
+			starters++
+		}
+	}
+
+	if starters != startingLineupSize {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "the starting lineup must have exactly %d players, got %d", startingLineupSize, starters)
+	}
+	if bench := len(ordered) - starters; bench > maxBenchSize {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "at most %d substitutes can be named, got %d", maxBenchSize, bench)
+	}
+
+	return &Lineup{
+		ID:          ulid.GenerateID(),
+		MatchID:     m.ID,
+		TeamID:      teamID,
+		Players:     ordered,
+		SubmittedBy: submittedBy,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// CheckSquad verifies every player against the team's squad on the match date, fills in their name,
+// position and jersey number, and checks jersey numbers are unique and exactly one goalkeeper starts.
+func (l *Lineup) CheckSquad(squad []SquadPlayer) error {
+	teamOf := make(map[string]string, len(squad))
+	registered := make(map[string]SquadPlayer, len(squad))
+	for _, p := range squad {
+		teamOf[p.ID] = p.TeamID
+		registered[p.ID] = p
+	}
+
+	var details []derrors.Detail
+	for i := range l.Players {
+		p := &l.Players[i]
+		if d, ok := checkSquadPlayer(teamOf, p.PlayerID, l.TeamID, l.field(i, "player_id")); !ok {
+			details = append(details, d)
+			continue
+		}
+		p.PlayerName = registered[p.PlayerID].Name
+		p.Position = registered[p.PlayerID].Position
+		if p.JerseyNumber == 0 {
+			p.JerseyNumber = registered[p.PlayerID].JerseyNumber
+		}
+	}
+	if len(details) > 0 {
+		return derrors.WithDetails(ErrPlayerNotInSquad, derrors.ErrorCodeBadRequest, details, "%s", ErrPlayerNotInSquad.Error())
+	}
+
+	wearer := make(map[int]string, len(l.Players))
+	for i, p := range l.Players {
+		if other, taken := wearer[p.JerseyNumber]; taken {
+			details = append(details, derrors.Detail{
+				Field:   l.field(i, "jersey_number"),
+				Message: fmt.Sprintf("jersey number %d is already worn by player %s", p.JerseyNumber, other),
+			})
+			continue
+		}
+		wearer[p.JerseyNumber] = p.PlayerID
+	}
+	if len(details) > 0 {
+		return derrors.WithDetails(ErrDuplicateJersey, derrors.ErrorCodeBadRequest, details, "%s", ErrDuplicateJersey.Error())
+	}
+
+	goalkeepers := 0
+	for _, p := range l.Starters() {
+		if p.Position == positionGoalkeeper {
+			goalkeepers++
+		}
+	}
+	if goalkeepers != 1 {
+		return derrors.WrapErrorf(ErrLineupGoalkeeper, derrors.ErrorCodeBadRequest, "%s, got %d", ErrLineupGoalkeeper.Error(), goalkeepers)
+	}
+
+	return nil
+}
+
+// CheckEligibility verifies every player is within the age limit of the competition, given the squad
+// the lineup was checked against.
+func (l *Lineup) CheckEligibility(squad []SquadPlayer, limit AgeLimit) error {
+	registered := make(map[string]SquadPlayer, len(squad))
+	for _, p := range squad {
+		registered[p.ID] = p
+	}
+
+	var details []derrors.Detail
+	for i, p := range l.Players {
+		if message, ineligible := limit.Ineligible(registered[p.PlayerID]); ineligible {
+			details = append(details, derrors.Detail{Field: l.field(i, "player_id"), Message: message})
+		}
+	}
+	if len(details) > 0 {
+		return derrors.WithDetails(ErrPlayerIneligible, derrors.ErrorCodeBadRequest, details, "%s", ErrPlayerIneligible.Error())
+	}
+	return nil
+}
+
+// Starters returns the starting XI.
+func (l *Lineup) Starters() []LineupPlayer {
+	var starters []LineupPlayer
+	for _, p := range l.Players {
+		if p.Starter {
+			starters = append(starters, p)
+		}
+	}
+	return starters
+}
+
+// Bench returns the named substitutes.
+func (l *Lineup) Bench() []LineupPlayer {
+	var bench []LineupPlayer
+	for _, p := range l.Players {
+		if !p.Starter {
+			bench = append(bench, p)
+		}
+	}
+	return bench
+}
+
+// field names the request field of the i-th player, counting starters and bench separately as clients send them.
+func (l *Lineup) field(i int, name string) string {
+	group, index := "starters", 0
+	for j := 0; j < i; j++ {
+		if l.Players[j].Starter == l.Players[i].Starter {
+			index++
+		}
+	}
+	if !l.Players[i].Starter {
+		group = "bench"
+	}
+	return fmt.Sprintf("%s[%d].%s", group, index, name)
+}
+
+// Substitution is a player replaced by a substitute from the bench during a match.
+type Substitution struct {
+	ID            string
+	LineupID      string
+	MatchID       string
+	TeamID        string
+	Minute        int
+	PlayerOffID   string
+	PlayerOffName string // Populated on read
+	PlayerOnID    string
+	PlayerOnName  string // Populated on read
+	CreatedAt     time.Time
+}
+
+// Substitute records a substitution in a live match. The player going off must be on the pitch,
+// the player coming on must be an unused substitute, and the team must have substitutions left.
+// l.Substitutions must hold the substitutions already made.
+func (l *Lineup) Substitute(m *Match, minute int, playerOffID, playerOnID string) (*Substitution, error) {
+	playerOffID = strings.TrimSpace(playerOffID)
+	playerOnID = strings.TrimSpace(playerOnID)
+
+	if m.Status != StatusLive {
+		return nil, derrors.WrapErrorf(ErrMatchNotLive, derrors.ErrorCodeBadRequest, "%s, match is %s", ErrMatchNotLive.Error(), m.Status)
+	}
+	if minute <= 0 || minute > maxGoalMinute {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "substitution minute must be between 1 and %d", maxGoalMinute)
+	}
+	if len(l.Substitutions) >= maxSubstitutions {
+		return nil, derrors.WrapErrorf(ErrSubstitutionLimit, derrors.ErrorCodeBadRequest, "%s, team has made %d", ErrSubstitutionLimit.Error(), len(l.Substitutions))
+	}
+
+	// Replay the substitutions made so far to find who is on the pitch
+	subs := make([]Substitution, len(l.Substitutions))
+	copy(subs, l.Substitutions)
+	sort.SliceStable(subs, func(i, j int) bool { return subs[i].Minute < subs[j].Minute })
+
+	onPitch := make(map[string]bool, startingLineupSize)
+	for _, p := range l.Starters() {
+		onPitch[p.PlayerID] = true
+	}
+	onBench := make(map[string]bool, maxBenchSize)
+	for _, p := range l.Bench() {
+		onBench[p.PlayerID] = true
+	}
+	for _, s := range subs {
+		if s.Minute > minute {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a substitution was already recorded in minute %d", s.Minute)
+		}
+		delete(onPitch, s.PlayerOffID)
+		delete(onBench, s.PlayerOnID)
+		onPitch[s.PlayerOnID] = true
+	}
+
+	if !onPitch[playerOffID] {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player %s is not on the pitch", playerOffID)
+	}
+	if !onBench[playerOnID] {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player %s is not an unused substitute", playerOnID)
+	}
+
+	return &Substitution{
+		ID:          ulid.GenerateID(),
+		LineupID:    l.ID,
+		MatchID:     l.MatchID,
+		TeamID:      l.TeamID,
+		Minute:      minute,
+		PlayerOffID: playerOffID,
+		PlayerOnID:  playerOnID,
+		CreatedAt:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+// LiveEventType is something that happened in a match, as posted to the live feed.
+type LiveEventType string
+
+const (
+	LiveKickoff      LiveEventType = "kickoff" // Start of the match, or of a half after half-time
+	LiveGoal         LiveEventType = "goal"
+	LiveCard         LiveEventType = "card"
+	LiveSubstitution LiveEventType = "substitution"
+	LiveHalfTime     LiveEventType = "half_time"
+	LiveFullTime     LiveEventType = "full_time"
+)
+
+// LiveEvent is one entry of a match's live feed. The feed is for following a match as it happens;
+// the result, cards and substitutions on record are still reported through their own endpoints.
+type LiveEvent struct {
+	ID         string
+	MatchID    string
+	Sequence   int64 // Position in the feed starting at 1, sent to clients as the event ID
+	Type       LiveEventType
+	Minute     int
+	TeamID     string
+	PlayerID   string // Scorer, booked player or player going off
+	PlayerInID string // Player coming on, for substitutions
+	CardType   CardType
+	Note       string
+	HomeScore  int // Running score after the event
+	AwayScore  int
+	CreatedAt  time.Time
+}
+
+// LiveFeedOver reports whether nothing more will be posted to the live feed of the match: full time
+// is in the feed, or the match is no longer scheduled or being played.
+func LiveFeedOver(m *Match, history []LiveEvent) bool {
+	if m.Status != StatusScheduled && m.Status != StatusLive {
+		return true
+	}
+	return len(history) > 0 && history[len(history)-1].Type == LiveFullTime
+}
+
+// NewLiveEvent validates the next event of a live match against the events posted so far
+// and works out the running score.
+func NewLiveEvent(m *Match, history []LiveEvent, event LiveEvent) (*LiveEvent, error) {
+	event.TeamID = strings.TrimSpace(event.TeamID)
+	event.PlayerID = strings.TrimSpace(event.PlayerID)
+	event.PlayerInID = strings.TrimSpace(event.PlayerInID)
+	event.Note = strings.TrimSpace(event.Note)
+
+	if m.Status != StatusLive {
+		return nil, derrors.WrapErrorf(ErrMatchNotInProgress, derrors.ErrorCodeBadRequest, "%s, match is %s", ErrMatchNotInProgress.Error(), m.Status)
+	}
+	if event.Minute < 0 || event.Minute > maxGoalMinute {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "event minute must be between 0 and %d", maxGoalMinute)
+	}
+
+	var last *LiveEvent
+	if len(history) > 0 {
+		last = &history[len(history)-1]
+	}
+
+	switch {
+	case last == nil && event.Type != LiveKickoff:
+		return nil, derrors.WrapErrorf(ErrLiveFeedOrder, derrors.ErrorCodeBadRequest, "%s, the first event must be a %q", ErrLiveFeedOrder.Error(), LiveKickoff)
+	case last != nil && last.Type == LiveFullTime:
+		return nil, derrors.WrapErrorf(ErrLiveFeedOrder, derrors.ErrorCodeBadRequest, "%s, the match has reached full time", ErrLiveFeedOrder.Error())
+	case last != nil && last.Type == LiveHalfTime && event.Type != LiveKickoff:
+		return nil, derrors.WrapErrorf(ErrLiveFeedOrder, derrors.ErrorCodeBadRequest, "%s, play must kick off again after half-time", ErrLiveFeedOrder.Error())
+	case last != nil && event.Type == LiveKickoff && last.Type != LiveHalfTime:
+		return nil, derrors.WrapErrorf(ErrLiveFeedOrder, derrors.ErrorCodeBadRequest, "%s, the match is already under way", ErrLiveFeedOrder.Error())
+	case last != nil && event.Minute < last.Minute:
+		return nil, derrors.WrapErrorf(ErrLiveFeedOrder, derrors.ErrorCodeBadRequest, "%s, the last event was in minute %d", ErrLiveFeedOrder.Error(), last.Minute)
+	}
+
+	if last != nil {
+		event.HomeScore = last.HomeScore
+		event.AwayScore = last.AwayScore
+		event.Sequence = last.Sequence
+	}
+	event.Sequence++
+
+	switch event.Type {
+	case LiveKickoff, LiveFullTime:
+	case LiveHalfTime:
+		for _, e := range history {
+			if e.Type == LiveHalfTime {
+				return nil, derrors.WrapErrorf(ErrLiveFeedOrder, derrors.ErrorCodeBadRequest, "%s, half-time was already called in minute %d", ErrLiveFeedOrder.Error(), e.Minute)
+			}
+		}
+	case LiveGoal:
+		switch event.TeamID {
+		case m.HomeTeamID:
+			event.HomeScore++
+		case m.AwayTeamID:
+			event.AwayScore++
+		default:
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "goal team ID %s does not belong to match participants", event.TeamID)
+		}
+	case LiveCard:
+		if event.TeamID != m.HomeTeamID && event.TeamID != m.AwayTeamID {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "card team ID %s does not belong to match participants", event.TeamID)
+		}
+		if event.PlayerID == "" {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player ID is required for a card")
+		}
+		switch event.CardType {
+		case CardYellow, CardSecondYellow, CardRed:
+		default:
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "card type must be one of %q, %q or %q", CardYellow, CardSecondYellow, CardRed)
+		}
+	case LiveSubstitution:
+		if event.TeamID != m.HomeTeamID && event.TeamID != m.AwayTeamID {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "substitution team ID %s does not belong to match participants", event.TeamID)
+		}
+		if event.PlayerID == "" || event.PlayerInID == "" {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "both the player going off and the player coming on are required for a substitution")
+		}
+	default:
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown live event type %q", event.Type)
+	}
+
+	if event.Type != LiveCard {
+		event.CardType = ""
+	}
+	if event.Type != LiveSubstitution {
+		event.PlayerInID = ""
+	}
+
+	event.ID = ulid.GenerateID()
+	event.MatchID = m.ID
+	event.CreatedAt = time.Now()
+	return &event, nil
+}
@@ -11,26 +11,105 @@ import (
 
 var matchTimeRegex = regexp.MustCompile(`^([01]\d|2[0-3]):([0-5]\d)$`)
 
+// DefaultTimezone is the time zone of matches whose venue has none, Western Indonesian Time (WIB).
+const DefaultTimezone = "Asia/Jakarta"
+
+// MatchStatus is the stage of a match in its lifecycle.
+type MatchStatus string
+
+const (
+	StatusScheduled MatchStatus = "scheduled"
+	StatusLive      MatchStatus = "live"
+	StatusFinished  MatchStatus = "finished"
+	StatusPostponed MatchStatus = "postponed"
+	StatusCancelled MatchStatus = "cancelled"
+	StatusAbandoned MatchStatus = "abandoned"
+)
+
+// matchTransitions lists the statuses a match may move to from each status.
+// Finished and cancelled matches are final, unless the result of a finished match is voided.
+// An abandoned match can be postponed to be replayed.
+var matchTransitions = map[MatchStatus][]MatchStatus{
+	StatusScheduled: {StatusLive, StatusPostponed, StatusCancelled},
+	StatusPostponed: {StatusLive, StatusPostponed, StatusCancelled},
+	StatusLive:      {StatusFinished, StatusAbandoned},
+	StatusAbandoned: {StatusPostponed, StatusCancelled},
+}
+
+// ParseMatchStatus validates a status given by a client.
+func ParseMatchStatus(s string) (MatchStatus, error) {
+	status := MatchStatus(strings.ToLower(strings.TrimSpace(s)))
+	switch status {
+	case StatusScheduled, StatusLive, StatusFinished, StatusPostponed, StatusCancelled, StatusAbandoned:
+		return status, nil
+	}
+	return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown match status %q", s)
+}
+
 type Match struct {
 	ID           string
+	SeasonID     string
 	HomeTeamID   string
 	AwayTeamID   string
-	MatchDate    time.Time
-	MatchTime    string // HH:MM format
-	Stadium      string
-	HomeTeamName string // Populated on read
-	AwayTeamName string // Populated on read
+	KickoffAt    time.Time // The moment the match kicks off
+	Timezone     string    // IANA time zone of the venue
+	MatchDate    time.Time // Calendar date of kickoff at the venue, derived from KickoffAt
+	MatchTime    string    // HH:MM kickoff on the clock at the venue, derived from KickoffAt
+	VenueID      string
+	Status       MatchStatus
+	StatusReason string          // Why the match was postponed, cancelled or abandoned
+	VenueName    string          // Populated on read
+	SeasonName   string          // Populated on read
+	HomeTeamName string          // Populated on read
+	AwayTeamName string          // Populated on read
+	Cards        []Card          // Populated when a single match is read
+	Officials    []MatchOfficial // Populated when a single match is read
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    *time.Time
 }
 
-func NewMatch(homeTeamID, awayTeamID string, matchDate time.Time, matchTime string, stadium string) (*Match, error) {
+// MatchReschedule records a change to when or where a match is played.
+type MatchReschedule struct {
+	ID                string
+	MatchID           string
+	PreviousKickoffAt time.Time
+	PreviousTimezone  string // Time zone of the previous venue
+	PreviousVenueID   string
+	PreviousVenueName string // Populated on read
+	NewKickoffAt      time.Time
+	NewTimezone       string // Time zone of the new venue
+	NewVenueID        string
+	NewVenueName      string // Populated on read
+	Reason            string
+	CreatedAt         time.Time
+}
+
+// LoadTimezone returns the location of an IANA time zone, or of DefaultTimezone when none is given.
+func LoadTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = DefaultTimezone
+	}
+	// "Local" would be the server's own zone, which is exactly what venue time zones replace
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown time zone %q, use an IANA time zone such as %q", name, DefaultTimezone)
+	}
+	return loc, nil
+}
+
+// NewMatch schedules a match to kick off on the date and HH:MM time on the clock at the venue, in its time zone.
+func NewMatch(seasonID, homeTeamID, awayTeamID string, matchDate time.Time, matchTime string, venueID, timezone string) (*Match, error) {
+	seasonID = strings.TrimSpace(seasonID)
 	homeTeamID = strings.TrimSpace(homeTeamID)
 	awayTeamID = strings.TrimSpace(awayTeamID)
 	matchTime = strings.TrimSpace(matchTime)
-	stadium = strings.TrimSpace(stadium)
+	venueID = strings.TrimSpace(venueID)
 
+	if seasonID == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "season ID is required")
+	}
 	if homeTeamID == "" {
 		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "home team ID is required")
 	}
@@ -46,24 +125,203 @@ func NewMatch(homeTeamID, awayTeamID string, matchDate time.Time, matchTime stri
 	if !matchTimeRegex.MatchString(matchTime) {
 		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "match time must be in HH:MM format (00:00 - 23:59)")
 	}
-	if stadium == "" {
-		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "stadium is required")
+	if venueID == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "venue ID is required")
 	}
-	now := time.Now()
-	// Allow matches on the same day (today) or in the future
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	if matchDate.Before(today) {
+	loc, err := LoadTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+	// Allow matches on the same day (today at the venue) or in the future
+	if calendarDay(matchDate).Before(calendarDay(time.Now().In(loc))) {
 		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "match date cannot be in the past")
 	}
 
-	return &Match{
+	now := time.Now()
+	match := &Match{
 		ID:         ulid.GenerateID(),
+		SeasonID:   seasonID,
 		HomeTeamID: homeTeamID,
 		AwayTeamID: awayTeamID,
-		MatchDate:  matchDate,
-		MatchTime:  matchTime,
-		Stadium:    stadium,
+		VenueID:    venueID,
+		Status:     StatusScheduled,
 		CreatedAt:  now,
 		UpdatedAt:  now,
+	}
+	match.setKickoff(kickoffAt(matchDate, matchTime, loc), loc)
+	return match, nil
+}
+
+// SetKickoff sets when the match kicks off and the time zone of its venue, deriving the date and time on its clock.
+func (m *Match) SetKickoff(kickoffAt time.Time, timezone string) error {
+	loc, err := LoadTimezone(timezone)
+	if err != nil {
+		return err
+	}
+	m.setKickoff(kickoffAt, loc)
+	return nil
+}
+
+func (m *Match) setKickoff(kickoffAt time.Time, loc *time.Location) {
+	local := kickoffAt.In(loc)
+	m.KickoffAt = kickoffAt
+	m.Timezone = loc.String()
+	m.MatchDate = calendarDay(local)
+	m.MatchTime = local.Format("15:04")
+}
+
+// kickoffAt returns the moment of kickoff at the HH:MM time on the date, on the clock of the time zone.
+func kickoffAt(matchDate time.Time, matchTime string, loc *time.Location) time.Time {
+	t, _ := time.Parse("15:04", matchTime)
+	return time.Date(matchDate.Year(), matchDate.Month(), matchDate.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+}
+
+// calendarDay returns the date of t as it reads on its own clock, at midnight UTC like dates read from the database.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Start kicks the match off. A match cannot start before the day it is scheduled for at its venue.
+func (m *Match) Start() error {
+	loc, err := LoadTimezone(m.Timezone)
+	if err != nil {
+		return err
+	}
+	if m.MatchDate.After(calendarDay(time.Now().In(loc))) {
+		return derrors.WrapErrorf(ErrMatchNotDue, derrors.ErrorCodeBadRequest, "match is scheduled for %s", m.MatchDate.Format("2006-01-02"))
+	}
+	return m.transition(StatusLive, "")
+}
+
+// Finish marks a live match as played. It is called when the result is reported.
+func (m *Match) Finish() error {
+	return m.transition(StatusFinished, "")
+}
+
+// Reopen returns a finished match to scheduled after its result has been voided,
+// so it can be started and reported again.
+func (m *Match) Reopen() error {
+	if m.Status != StatusFinished {
+		return derrors.WrapErrorf(ErrInvalidTransition, derrors.ErrorCodeBadRequest, "only a finished match can be reopened, match is %s", m.Status)
+	}
+	m.Status = StatusScheduled
+	m.StatusReason = ""
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// Postpone moves the match to a new date, and optionally a new kickoff time.
+func (m *Match) Postpone(matchDate time.Time, matchTime, reason string) (*MatchReschedule, error) {
+	if strings.TrimSpace(matchTime) == "" {
+		matchTime = m.MatchTime
+	}
+	change, err := m.planReschedule(matchDate, matchTime, m.VenueID, m.Timezone, reason)
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a postponed match needs a new date or kickoff time")
+	}
+
+	if err := m.transition(StatusPostponed, reason); err != nil {
+		return nil, err
+	}
+	m.applyReschedule(change)
+	return change, nil
+}
+
+// Reschedule edits when and where the match is played without changing its status.
+// The kickoff time is on the clock of the venue, whose time zone is given.
+// It returns nil when nothing changed. Matches that are live or over cannot be edited.
+func (m *Match) Reschedule(matchDate time.Time, matchTime, venueID, timezone, reason string) (*MatchReschedule, error) {
+	switch m.Status {
+	case StatusLive, StatusFinished, StatusCancelled:
+		return nil, derrors.WrapErrorf(ErrMatchLocked, derrors.ErrorCodeBadRequest, "a %s match cannot be edited", m.Status)
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a reason is required to reschedule a match")
+	}
+
+	change, err := m.planReschedule(matchDate, matchTime, venueID, timezone, reason)
+	if err != nil || change == nil {
+		return nil, err
+	}
+	m.applyReschedule(change)
+	return change, nil
+}
+
+// Cancel calls the match off for good.
+func (m *Match) Cancel(reason string) error {
+	return m.transition(StatusCancelled, reason)
+}
+
+// Abandon stops a live match before full time.
+func (m *Match) Abandon(reason string) error {
+	return m.transition(StatusAbandoned, reason)
+}
+
+// AcceptsResult reports whether a result can be recorded for the match.
+func (m *Match) AcceptsResult() bool {
+	return m.Status == StatusLive || m.Status == StatusFinished
+}
+
+// planReschedule validates a new date, time and venue and describes the change, or returns nil if nothing moves.
+func (m *Match) planReschedule(matchDate time.Time, matchTime, venueID, timezone, reason string) (*MatchReschedule, error) {
+	matchTime = strings.TrimSpace(matchTime)
+	venueID = strings.TrimSpace(venueID)
+
+	if !matchTimeRegex.MatchString(matchTime) {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "match time must be in HH:MM format (00:00 - 23:59)")
+	}
+	if venueID == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "venue ID is required")
+	}
+	loc, err := LoadTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if calendarDay(matchDate).Before(calendarDay(now.In(loc))) {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "match date cannot be in the past")
+	}
+
+	kickoff := kickoffAt(matchDate, matchTime, loc)
+	if kickoff.Equal(m.KickoffAt) && venueID == m.VenueID {
+		return nil, nil
+	}
+
+	return &MatchReschedule{
+		ID:                ulid.GenerateID(),
+		MatchID:           m.ID,
+		PreviousKickoffAt: m.KickoffAt,
+		PreviousTimezone:  m.Timezone,
+		PreviousVenueID:   m.VenueID,
+		PreviousVenueName: m.VenueName,
+		NewKickoffAt:      kickoff,
+		NewTimezone:       loc.String(),
+		NewVenueID:        venueID,
+		Reason:            strings.TrimSpace(reason),
+		CreatedAt:         now,
 	}, nil
 }
+
+func (m *Match) applyReschedule(change *MatchReschedule) {
+	_ = m.SetKickoff(change.NewKickoffAt, change.NewTimezone) // Checked when the change was planned
+	if m.VenueID != change.NewVenueID {
+		m.VenueID = change.NewVenueID
+		m.VenueName = "" // Populated on read
+	}
+	m.UpdatedAt = change.CreatedAt
+}
+
+func (m *Match) transition(to MatchStatus, reason string) error {
+	for _, allowed := range matchTransitions[m.Status] {
+		if allowed == to {
+			m.Status = to
+			m.StatusReason = strings.TrimSpace(reason)
+			m.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return derrors.WrapErrorf(ErrInvalidTransition, derrors.ErrorCodeBadRequest, "cannot move a %s match to %s", m.Status, to)
+}
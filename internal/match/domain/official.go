@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/ulid"
+)
+
+const maxOfficialNameLength = 255
+
+// OfficialTokenRole is the role carried by the credentials issued to match officials.
+const OfficialTokenRole = "official"
+
+// OfficialRole is the job an official does in a match.
+type OfficialRole string
+
+const (
+	RoleReferee          OfficialRole = "referee"
+	RoleAssistantReferee OfficialRole = "assistant_referee"
+	RoleFourthOfficial   OfficialRole = "fourth_official"
+	RoleVAR              OfficialRole = "var" // Video assistant referee
+)
+
+// officialsPerRole is how many officials a match has in each role.
+var officialsPerRole = map[OfficialRole]int{
+	RoleReferee:          1,
+	RoleAssistantReferee: 2,
+	RoleFourthOfficial:   1,
+	RoleVAR:              1,
+}
+
+func ParseOfficialRole(s string) (OfficialRole, error) {
+	role := OfficialRole(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := officialsPerRole[role]; !ok {
+		return "", derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown official role %q, use referee, assistant_referee, fourth_official or var", s)
+	}
+	return role, nil
+}
+
+// Official is a referee or other match official who can be assigned to matches.
+type Official struct {
+	ID        string
+	Name      string
+	Email     string // Identifies the official on the credentials issued to them, unique ignoring case
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// MatchOfficial is an official assigned to a match.
+type MatchOfficial struct {
+	MatchID       string
+	OfficialID    string
+	OfficialName  string // Populated on read
+	OfficialEmail string // Populated on read
+	Role          OfficialRole
+	AssignedAt    time.Time
+}
+
+func NewOfficial(name, email string) (*Official, error) {
+	o := &Official{}
+	if err := o.set(name, email); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	o.ID = ulid.GenerateID()
+	o.CreatedAt = now
+	o.UpdatedAt = now
+	return o, nil
+}
+
+func (o *Official) Update(name, email string) error {
+	if err := o.set(name, email); err != nil {
+		return err
+	}
+
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+func (o *Official) set(name, email string) error {
+	name = strings.TrimSpace(name)
+	email = strings.TrimSpace(email)
+
+	if name == "" {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "official name is required")
+	}
+	if len(name) > maxOfficialNameLength {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "official name must not exceed %d characters", maxOfficialNameLength)
+	}
+	if email == "" {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "official email is required")
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return derrors.NewErrorf(derrors.ErrorCodeBadRequest, "official email %q is not a valid address", email)
+	}
+
+	o.Name = name
+	o.Email = email
+	return nil
+}
+
+// ResultScope is the scope of the credential that lets an official submit the result of a match.
+func ResultScope(matchID string) string {
+	return "match:" + matchID + ":result"
+}
+
+// Day returns the calendar day the match kicks off on at its venue, from midnight to midnight.
+func (m *Match) Day() (from, to time.Time) {
+	loc, err := LoadTimezone(m.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	from = time.Date(m.MatchDate.Year(), m.MatchDate.Month(), m.MatchDate.Day(), 0, 0, 0, 0, loc)
+	return from, from.AddDate(0, 0, 1)
+}
+
+// CheckOfficials makes sure the officials of a match that is moved are still free on its new day.
+// sameDay holds, by official ID, the matches each official is assigned to on that day.
+func (m *Match) CheckOfficials(officials []MatchOfficial, sameDay map[string][]Match) error {
+	var details []derrors.Detail
+	for _, o := range officials {
+		details = append(details, m.officialClashes(o.OfficialName, sameDay[o.OfficialID])...)
+	}
+	if len(details) > 0 {
+		return derrors.WithDetails(ErrOfficialUnavailable, derrors.ErrorCodeBadRequest, details, "%s, unassign them first", ErrOfficialUnavailable.Error())
+	}
+	return nil
+}
+
+// officialClashes lists the other matches an official works on the day of the match. Cancelled and
+// abandoned matches leave the official free.
+func (m *Match) officialClashes(name string, sameDay []Match) []derrors.Detail {
+	var details []derrors.Detail
+	for _, other := range sameDay {
+		if other.ID == m.ID || other.Status == StatusCancelled || other.Status == StatusAbandoned {
+			continue
+		}
+		details = append(details, derrors.Detail{
+			Field:   "official_id",
+			Message: fmt.Sprintf("%s already officiates match %s at %s %s %s", name, other.ID, other.MatchDate.Format("2006-01-02"), other.MatchTime, other.Timezone),
+		})
+	}
+	return details
+}
+
+// AssignOfficial checks that the official can take the role in the match and returns the assignment.
+// current lists the officials already assigned to the match, sameDay the other matches the official is
+// assigned to on the day of the match. An official works at most one match a day.
+func (m *Match) AssignOfficial(official *Official, role OfficialRole, current []MatchOfficial, sameDay []Match) (*MatchOfficial, error) {
+	switch m.Status {
+	case StatusFinished, StatusCancelled, StatusAbandoned:
+		return nil, derrors.WrapErrorf(ErrMatchLocked, derrors.ErrorCodeBadRequest, "officials of a %s match cannot be changed", m.Status)
+	}
+	role, err := ParseOfficialRole(string(role))
+	if err != nil {
+		return nil, err
+	}
+
+	filled := 0
+	for _, o := range current {
+		if o.OfficialID == official.ID {
+			return nil, derrors.WrapErrorf(ErrOfficialAssigned, derrors.ErrorCodeDuplicate, "%s is already the %s of this match", official.Name, o.Role)
+		}
+		if o.Role == role {
+			filled++
+		}
+	}
+	if filled >= officialsPerRole[role] {
+		return nil, derrors.WrapErrorf(ErrOfficialRoleFilled, derrors.ErrorCodeBadRequest, "match already has %d %s", filled, role)
+	}
+
+	if details := m.officialClashes(official.Name, sameDay); len(details) > 0 {
+		return nil, derrors.WithDetails(ErrOfficialUnavailable, derrors.ErrorCodeBadRequest, details, "%s", ErrOfficialUnavailable.Error())
+	}
+
+	return &MatchOfficial{
+		MatchID:       m.ID,
+		OfficialID:    official.ID,
+		OfficialName:  official.Name,
+		OfficialEmail: official.Email,
+		Role:          role,
+		AssignedAt:    time.Now(),
+	}, nil
+}
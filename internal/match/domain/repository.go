@@ -1,35 +1,158 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+// MatchFilter narrows down match listings. Empty fields are ignored.
+type MatchFilter struct {
+	SeasonID string
+	TeamID   string        // Matches the team plays in, home or away
+	Statuses []MatchStatus // Matches in any of these statuses
+}
 
 // MatchRepository defines the port for match persistence.
 type MatchRepository interface {
 	Create(ctx context.Context, match *Match) error
+	CreateBatch(ctx context.Context, matches []*Match) error
 	FindByID(ctx context.Context, id string) (*Match, error)
-	FindAll(ctx context.Context) ([]Match, error)
+	FindAll(ctx context.Context, filter MatchFilter) ([]Match, error)
+	// FindNearby returns the other matches between the two dates that involve either team of the match or its venue.
+	FindNearby(ctx context.Context, match *Match, from, to time.Time) ([]Match, error)
 	Update(ctx context.Context, match *Match) error
+	// Reschedule saves the match and records the change in its history in one transaction.
+	Reschedule(ctx context.Context, match *Match, change *MatchReschedule) error
+	FindReschedules(ctx context.Context, matchID string) ([]MatchReschedule, error)
+	// Delete soft-deletes the match together with its result, goals and shootout kicks.
 	Delete(ctx context.Context, id string) error
+	// Restore undoes Delete, bringing back exactly the rows that were deleted with the match.
+	Restore(ctx context.Context, id string) error
+	ExistsBySeasonID(ctx context.Context, seasonID string) (bool, error)
 }
 
 // MatchResultRepository defines the port for match result persistence.
 type MatchResultRepository interface {
-	Create(ctx context.Context, result *MatchResult) error
+	// Create stores the result, marks its match as finished and saves the advancement of the cup tie the
+	// result decides, if any, in one transaction.
+	Create(ctx context.Context, result *MatchResult, advancement *Advancement) error
 	FindByMatchID(ctx context.Context, matchID string) (*MatchResult, error)
+	// FindCards returns the cards of the current result of a match, in the order they were shown.
+	FindCards(ctx context.Context, matchID string) ([]Card, error)
 	ExistsByMatchID(ctx context.Context, matchID string) (bool, error)
+	// Amend replaces the current result with a new one, records the old one as a revision and saves the
+	// advancement of the cup tie the new result decides, if any, in one transaction.
+	Amend(ctx context.Context, revision *ResultRevision, result *MatchResult, advancement *Advancement) error
+	// Void removes the current result, records it as a revision and saves the reopened match, in one transaction.
+	Void(ctx context.Context, revision *ResultRevision, match *Match) error
+	FindRevisions(ctx context.Context, matchID string) ([]ResultRevision, error)
+}
+
+// SquadRepository defines the port for reading team squads owned by the Club context.
+type SquadRepository interface {
+	// FindPlayers returns those of the given players who were registered with a team on the date, with that team.
+	FindPlayers(ctx context.Context, playerIDs []string, asOf time.Time) ([]SquadPlayer, error)
+}
+
+// VenueRepository defines the port for reading venues owned by the Club context.
+type VenueRepository interface {
+	// FindByID returns the venue, or ErrVenueNotFound.
+	FindByID(ctx context.Context, id string) (*Venue, error)
+	// FindHomeVenueID returns the ID of the team's home venue, empty when it has none.
+	FindHomeVenueID(ctx context.Context, teamID string) (string, error)
+	// MoveMatches moves every match and reschedule from one venue to another, in one transaction.
+	MoveMatches(ctx context.Context, fromID, toID string) error
+}
+
+// OfficialRepository defines the port for match official persistence.
+type OfficialRepository interface {
+	Create(ctx context.Context, official *Official) error
+	FindByID(ctx context.Context, id string) (*Official, error)
+	FindAll(ctx context.Context) ([]Official, error)
+	Update(ctx context.Context, official *Official) error
+	Delete(ctx context.Context, id string) error
+	// ExistsByEmail reports whether another official than excludeID uses the email, ignoring case.
+	ExistsByEmail(ctx context.Context, email, excludeID string) (bool, error)
+	// HasUpcomingMatches reports whether the official is assigned to matches that are not over yet.
+	HasUpcomingMatches(ctx context.Context, id string) (bool, error)
+	Assign(ctx context.Context, assignment *MatchOfficial) error
+	// Unassign removes the official from the match, or returns ErrOfficialNotAssigned.
+	Unassign(ctx context.Context, matchID, officialID string) error
+	// FindByMatchID returns the officials of a match, referee first.
+	FindByMatchID(ctx context.Context, matchID string) ([]MatchOfficial, error)
+	// FindMatches returns the matches the official is assigned to that kick off from one moment until before the other.
+	FindMatches(ctx context.Context, officialID string, from, to time.Time) ([]Match, error)
+}
+
+// LineupRepository defines the port for matchday lineup and substitution persistence.
+type LineupRepository interface {
+	// Save stores the lineup, replacing the team's current lineup for the match, in one transaction.
+	Save(ctx context.Context, lineup *Lineup) error
+	// FindByMatchID returns the current lineups of a match with their players and substitutions.
+	FindByMatchID(ctx context.Context, matchID string) ([]Lineup, error)
+	// AddSubstitution stores the substitution, made after the given number of substitutions of its lineup.
+	// The lineup is locked while it is counted and the substitution stored, and it fails with
+	// ErrSubstitutionClash if the lineup was replaced or its substitutions no longer number made.
+	AddSubstitution(ctx context.Context, sub *Substitution, made int) error
+}
+
+// LiveEventRepository defines the port for live match feed persistence.
+type LiveEventRepository interface {
+	// Create stores the event. It fails with ErrLiveEventConflict if the match already has an event with its sequence.
+	Create(ctx context.Context, event *LiveEvent) error
+	// FindByMatchID returns the events of a match after the given sequence, oldest first.
+	FindByMatchID(ctx context.Context, matchID string, afterSequence int64) ([]LiveEvent, error)
+}
+
+// LiveEventBroker fans live events out to the clients following a match.
+type LiveEventBroker interface {
+	Publish(event LiveEvent)
+	// Subscribe returns the events of the match published from now on. The channel is closed by the
+	// returned cancel function, or when the subscriber falls too far behind and has to catch up from the repository.
+	Subscribe(matchID string) (<-chan LiveEvent, func())
+}
+
+// DisciplineRepository defines the port for reading the cards that suspensions are worked out from.
+// Only cards of current results of matches that have not been deleted are returned.
+type DisciplineRepository interface {
+	FindCardsByPlayer(ctx context.Context, playerID string) ([]BookedCard, error)
+	// FindCardsByTeam returns cards shown to players of the team. An empty seasonID covers every season.
+	FindCardsByTeam(ctx context.Context, teamID, seasonID string) ([]BookedCard, error)
+}
+
+// SeasonRepository defines the port for reading seasons owned by the Competition context.
+type SeasonRepository interface {
+	FindByID(ctx context.Context, id string) (*Season, error)
+	FindLatestByCompetitionID(ctx context.Context, competitionID string) (*Season, error)
+	IsTeamRegistered(ctx context.Context, seasonID, teamID string) (bool, error)
+	FindTeams(ctx context.Context, seasonID string) ([]SeasonTeam, error)
+}
+
+// BracketRepository defines the port for knockout bracket persistence.
+type BracketRepository interface {
+	Create(ctx context.Context, bracket *Bracket, matches []*Match) error
+	FindBySeasonID(ctx context.Context, seasonID string) (*Bracket, error)
+	ExistsBySeasonID(ctx context.Context, seasonID string) (bool, error)
+	FindTieByMatchID(ctx context.Context, matchID string) (*Tie, error)
 }
 
 // MatchReportView defines the read model for match reports.
 type MatchReportView struct {
 	MatchID        string
-	MatchDate      string
-	MatchTime      string
+	KickoffAt      time.Time
+	Timezone       string // IANA time zone of the venue
 	HomeTeamName   string
 	AwayTeamName   string
 	HomeScore      int
 	AwayScore      int
 	MatchStatus    string // "Tim Home Menang" / "Tim Away Menang" / "Draw"
+	DecidedBy      string // "regulation" / "extra_time" / "penalties"
+	HomePenalties  int    // Shootout score, only meaningful when decided on penalties
+	AwayPenalties  int
 	TopScorer      string // Player name with most goals in this match
 	TopScorerGoals int
+	Cards          []Card
+	Officials      []MatchOfficial
 	HomeTeamWins   int // Accumulated total home team wins
 	AwayTeamWins   int // Accumulated total away team wins
 }
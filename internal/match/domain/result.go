@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,29 +12,72 @@ import (
 )
 
 const (
-	maxGoalMinute = 150 // including extra time and penalties
+	maxGoalMinute        = 150 // including extra time and stoppage time
+	shootoutKicksPerTeam = 5   // kicks each team takes before sudden death
+	minEligibleKickers   = 7   // a match cannot continue with fewer players, so no team has fewer eligible kickers
+)
+
+// DecidedBy is the phase of play in which a match was settled.
+type DecidedBy string
+
+const (
+	DecidedInRegulation DecidedBy = "regulation"
+	DecidedInExtraTime  DecidedBy = "extra_time"
+	DecidedOnPenalties  DecidedBy = "penalties"
 )
 
 type MatchResult struct {
-	ID        string
-	MatchID   string
-	HomeScore int
-	AwayScore int
-	Goals     []Goal
-	DeletedAt *time.Time
+	ID            string
+	MatchID       string
+	HomeScore     int
+	AwayScore     int
+	DecidedBy     DecidedBy
+	HomePenalties int // Shootout score, only set when decided on penalties
+	AwayPenalties int
+	Goals         []Goal
+	Cards         []Card
+	Shootout      []ShootoutKick
+	Appearances   []Appearance // Worked out from the lineups when the result is reported
+	DeletedAt     *time.Time
 }
 
+// GoalType is how a goal was scored.
+type GoalType string
+
+const (
+	GoalOpenPlay GoalType = "open_play"
+	GoalPenalty  GoalType = "penalty"
+	GoalOwnGoal  GoalType = "own_goal"
+	GoalFreeKick GoalType = "free_kick"
+	GoalHeader   GoalType = "header"
+)
+
 type Goal struct {
+	ID               string
+	ResultID         string
+	PlayerID         string
+	PlayerName       string
+	TeamID           string // Team the goal counts for. The scorer of an own goal plays for the other team.
+	Type             GoalType
+	AssistPlayerID   string // Optional, never set for an own goal
+	AssistPlayerName string
+	GoalMinute       int
+	DeletedAt        *time.Time
+}
+
+// ShootoutKick is a single kick of a penalty shootout. Shootout goals do not count towards the score.
+type ShootoutKick struct {
 	ID         string
 	ResultID   string
+	Order      int // 1-based position in the shootout
 	PlayerID   string
 	PlayerName string
 	TeamID     string
-	GoalMinute int
+	Scored     bool
 	DeletedAt  *time.Time
 }
 
-func NewMatchResult(matchID string, homeTeamID, awayTeamID string, homeScore, awayScore int, goals []Goal) (*MatchResult, error) {
+func NewMatchResult(matchID string, homeTeamID, awayTeamID string, homeScore, awayScore int, goals []Goal, cards []Card, decidedBy DecidedBy, shootout []ShootoutKick) (*MatchResult, error) {
 	matchID = strings.TrimSpace(matchID)
 	homeTeamID = strings.TrimSpace(homeTeamID)
 	awayTeamID = strings.TrimSpace(awayTeamID)
@@ -63,6 +108,10 @@ func NewMatchResult(matchID string, homeTeamID, awayTeamID string, homeScore, aw
 		goals[i].ResultID = resultID
 		goals[i].PlayerID = strings.TrimSpace(goals[i].PlayerID)
 		goals[i].TeamID = strings.TrimSpace(goals[i].TeamID)
+		goals[i].AssistPlayerID = strings.TrimSpace(goals[i].AssistPlayerID)
+		if goals[i].Type == "" {
+			goals[i].Type = GoalOpenPlay
+		}
 
 		if goals[i].PlayerID == "" {
 			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player ID is required for each goal")
@@ -73,6 +122,19 @@ func NewMatchResult(matchID string, homeTeamID, awayTeamID string, homeScore, aw
 		if goals[i].GoalMinute <= 0 || goals[i].GoalMinute > maxGoalMinute {
 			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "goal minute must be between 1 and %d", maxGoalMinute)
 		}
+		switch goals[i].Type {
+		case GoalOpenPlay, GoalPenalty, GoalOwnGoal, GoalFreeKick, GoalHeader:
+		default:
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "unknown goal type %q", goals[i].Type)
+		}
+		if goals[i].AssistPlayerID != "" {
+			if goals[i].Type == GoalOwnGoal {
+				return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "an own goal cannot have an assist")
+			}
+			if goals[i].AssistPlayerID == goals[i].PlayerID {
+				return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a player cannot assist their own goal")
+			}
+		}
 
 		if goals[i].TeamID == homeTeamID {
 			homeGoalCount++
@@ -90,22 +152,264 @@ func NewMatchResult(matchID string, homeTeamID, awayTeamID string, homeScore, aw
 		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "away goals (%d) does not match away score (%d)", awayGoalCount, awayScore)
 	}
 
+	if err := validateCards(cards, goals, resultID, homeTeamID, awayTeamID); err != nil {
+		return nil, err
+	}
+
+	if decidedBy == "" {
+		decidedBy = DecidedInRegulation
+	}
+
+	var homePenalties, awayPenalties int
+	switch decidedBy {
+	case DecidedInRegulation, DecidedInExtraTime:
+		if len(shootout) > 0 {
+			return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "shootout kicks can only be recorded for a match decided on penalties")
+		}
+	case DecidedOnPenalties:
+		var err error
+		homePenalties, awayPenalties, err = validateShootout(shootout, homeTeamID, awayTeamID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range shootout {
+			shootout[i].ID = ulid.GenerateID()
+			shootout[i].ResultID = resultID
+		}
+	default:
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "decided by must be one of %q, %q or %q", DecidedInRegulation, DecidedInExtraTime, DecidedOnPenalties)
+	}
+
 	return &MatchResult{
-		ID:        resultID,
-		MatchID:   matchID,
-		HomeScore: homeScore,
-		AwayScore: awayScore,
-		Goals:     goals,
+		ID:            resultID,
+		MatchID:       matchID,
+		HomeScore:     homeScore,
+		AwayScore:     awayScore,
+		DecidedBy:     decidedBy,
+		HomePenalties: homePenalties,
+		AwayPenalties: awayPenalties,
+		Goals:         goals,
+		Cards:         cards,
+		Shootout:      shootout,
 	}, nil
 }
 
-// Status returns the match outcome based on the score.
+// CheckScorers verifies that every goal was scored by a player registered with the team it is
+// credited to, or with the opposing team for an own goal, and that assists came from a teammate.
+// squad holds the players registered on the match date. Rather than stopping at the first bad goal,
+// every problem is listed with the position of the goal in the result.
+func (r *MatchResult) CheckScorers(squad []SquadPlayer, homeTeamID, awayTeamID string) error {
+	teamOf := make(map[string]string, len(squad))
+	for _, p := range squad {
+		teamOf[p.ID] = p.TeamID
+	}
+
+	var details []derrors.Detail
+	for i, g := range r.Goals {
+		scorerTeamID := g.TeamID
+		if g.Type == GoalOwnGoal {
+			scorerTeamID = homeTeamID
+			if g.TeamID == homeTeamID {
+				scorerTeamID = awayTeamID
+			}
+		}
+		if d, ok := checkSquadPlayer(teamOf, g.PlayerID, scorerTeamID, fmt.Sprintf("goals[%d].player_id", i)); !ok {
+			details = append(details, d)
+		}
+		if g.AssistPlayerID != "" {
+			if d, ok := checkSquadPlayer(teamOf, g.AssistPlayerID, g.TeamID, fmt.Sprintf("goals[%d].assist_player_id", i)); !ok {
+				details = append(details, d)
+			}
+		}
+	}
+
+	if len(details) > 0 {
+		return derrors.WithDetails(ErrScorerNotInSquad, derrors.ErrorCodeBadRequest, details, "%s", ErrScorerNotInSquad.Error())
+	}
+	return nil
+}
+
+// CheckEligibility verifies every scorer and assisting player is within the age limit of the competition,
+// given the squad the scorers were checked against.
+func (r *MatchResult) CheckEligibility(squad []SquadPlayer, limit AgeLimit) error {
+	registered := make(map[string]SquadPlayer, len(squad))
+	for _, p := range squad {
+		registered[p.ID] = p
+	}
+
+	var details []derrors.Detail
+	for i, g := range r.Goals {
+		if message, ineligible := limit.Ineligible(registered[g.PlayerID]); ineligible {
+			details = append(details, derrors.Detail{Field: fmt.Sprintf("goals[%d].player_id", i), Message: message})
+		}
+		if g.AssistPlayerID == "" {
+			continue
+		}
+		if message, ineligible := limit.Ineligible(registered[g.AssistPlayerID]); ineligible {
+			details = append(details, derrors.Detail{Field: fmt.Sprintf("goals[%d].assist_player_id", i), Message: message})
+		}
+	}
+
+	if len(details) > 0 {
+		return derrors.WithDetails(ErrPlayerIneligible, derrors.ErrorCodeBadRequest, details, "%s", ErrPlayerIneligible.Error())
+	}
+	return nil
+}
+
+// checkSquadPlayer describes the problem when a player was not registered with teamID on the match date.
+func checkSquadPlayer(teamOf map[string]string, playerID, teamID, field string) (derrors.Detail, bool) {
+	registeredWith, registered := teamOf[playerID]
+	switch {
+	case !registered:
+		return derrors.Detail{Field: field, Message: fmt.Sprintf("player %s was not registered with any team on the match date", playerID)}, false
+	case registeredWith != teamID:
+		return derrors.Detail{Field: field, Message: fmt.Sprintf("player %s played for team %s, not %s", playerID, registeredWith, teamID)}, false
+	}
+	return derrors.Detail{}, true
+}
+
+// RevisionAction says what happened to a result that is no longer current.
+type RevisionAction string
+
+const (
+	RevisionAmended RevisionAction = "amended"
+	RevisionVoided  RevisionAction = "voided"
+)
+
+// ResultRevision is an immutable record of a result as it stood before it was amended or voided.
+type ResultRevision struct {
+	ID        string
+	MatchID   string
+	Action    RevisionAction
+	Result    MatchResult // The superseded result, with its goals
+	ChangedBy string      // Username of whoever made the change
+	Reason    string
+	CreatedAt time.Time
+}
+
+func NewResultRevision(previous *MatchResult, action RevisionAction, changedBy, reason string) (*ResultRevision, error) {
+	changedBy = strings.TrimSpace(changedBy)
+	reason = strings.TrimSpace(reason)
+
+	if changedBy == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeUnauthorized, "the user changing the result is unknown")
+	}
+	if reason == "" {
+		return nil, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a reason is required to %s a result", strings.TrimSuffix(string(action), "ed"))
+	}
+
+	return &ResultRevision{
+		ID:        ulid.GenerateID(),
+		MatchID:   previous.MatchID,
+		Action:    action,
+		Result:    *previous,
+		ChangedBy: changedBy,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Status returns the match outcome based on the score, or on the shootout when the score is level.
 func (r *MatchResult) Status() string {
-	if r.HomeScore > r.AwayScore {
+	home, away := r.HomeScore, r.AwayScore
+	if home == away && r.DecidedBy == DecidedOnPenalties {
+		home, away = r.HomePenalties, r.AwayPenalties
+	}
+	if home > away {
 		return "Home Win"
 	}
-	if r.AwayScore > r.HomeScore {
+	if away > home {
 		return "Away Win"
 	}
 	return "Draw"
 }
+
+// validateShootout checks a shootout against the laws of the game and returns its score.
+// Teams kick alternately, five each and then sudden death, no kick is taken once the
+// shootout is decided, and nobody kicks twice before every teammate who kicked has had a turn.
+func validateShootout(kicks []ShootoutKick, homeTeamID, awayTeamID string) (int, int, error) {
+	if len(kicks) == 0 {
+		return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a match decided on penalties requires the shootout kicks")
+	}
+
+	sort.SliceStable(kicks, func(i, j int) bool { return kicks[i].Order < kicks[j].Order })
+
+	scored := map[string]int{homeTeamID: 0, awayTeamID: 0}
+	taken := map[string]int{homeTeamID: 0, awayTeamID: 0}
+	takers := map[string][]string{}
+
+	firstTeamID := strings.TrimSpace(kicks[0].TeamID)
+	for i := range kicks {
+		kicks[i].PlayerID = strings.TrimSpace(kicks[i].PlayerID)
+		kicks[i].TeamID = strings.TrimSpace(kicks[i].TeamID)
+		k := kicks[i]
+
+		if k.Order != i+1 {
+			return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "shootout kicks must be numbered 1 to %d without gaps", len(kicks))
+		}
+		if k.PlayerID == "" {
+			return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "player ID is required for each shootout kick")
+		}
+		if k.TeamID != homeTeamID && k.TeamID != awayTeamID {
+			return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "shootout team ID %s does not belong to match participants", k.TeamID)
+		}
+		if (i%2 == 0) != (k.TeamID == firstTeamID) {
+			return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "teams must take shootout kicks alternately")
+		}
+		if shootoutDecided(scored[homeTeamID], taken[homeTeamID], scored[awayTeamID], taken[awayTeamID]) {
+			return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "kick %d was taken after the shootout was already decided", k.Order)
+		}
+
+		taken[k.TeamID]++
+		if k.Scored {
+			scored[k.TeamID]++
+		}
+		takers[k.TeamID] = append(takers[k.TeamID], k.PlayerID)
+	}
+
+	if !shootoutDecided(scored[homeTeamID], taken[homeTeamID], scored[awayTeamID], taken[awayTeamID]) {
+		return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "the shootout has no winner")
+	}
+	for _, players := range takers {
+		if !kickersRotate(players) {
+			return 0, 0, derrors.NewErrorf(derrors.ErrorCodeBadRequest, "a player cannot take a second kick before every teammate has taken one")
+		}
+	}
+
+	return scored[homeTeamID], scored[awayTeamID], nil
+}
+
+// shootoutDecided reports whether one team can no longer be caught.
+func shootoutDecided(homeScored, homeTaken, awayScored, awayTaken int) bool {
+	if homeTaken < shootoutKicksPerTeam || awayTaken < shootoutKicksPerTeam {
+		homeLeft := max(shootoutKicksPerTeam-homeTaken, 0)
+		awayLeft := max(shootoutKicksPerTeam-awayTaken, 0)
+		return homeScored+homeLeft < awayScored || awayScored+awayLeft < homeScored
+	}
+	// Sudden death is only decided once both teams have taken the same number of kicks
+	return homeTaken == awayTaken && homeScored != awayScored
+}
+
+// kickersRotate reports whether a team's kickers, in order, cycle through everyone who
+// kicked before anyone kicks again.
+func kickersRotate(players []string) bool {
+	group := make(map[string]bool, len(players))
+	for _, p := range players {
+		group[p] = true
+	}
+	size := len(group)
+	if size < len(players) && size < minEligibleKickers {
+		return false
+	}
+
+	for start := 0; start < len(players); start += size {
+		round := make(map[string]bool, size)
+		for _, p := range players[start:min(start+size, len(players))] {
+			if round[p] {
+				return false
+			}
+			round[p] = true
+		}
+	}
+	return true
+}
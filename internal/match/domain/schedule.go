@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+)
+
+// SchedulingRules are the limits checked whenever a match is scheduled or moved.
+type SchedulingRules struct {
+	MatchDuration time.Duration // How long a match keeps its teams and venue busy from kickoff
+	MinRestDays   int           // Days a team must have between two of its matches, 0 to allow back-to-back days
+}
+
+// ConflictKind tells what a scheduling conflict is about.
+type ConflictKind string
+
+const (
+	ConflictTeamDoubleBooked ConflictKind = "team_double_booked" // A team plays two matches at once
+	ConflictVenueOverlap     ConflictKind = "venue_overlap"      // Two matches are played at one venue at once
+	ConflictRestDays         ConflictKind = "rest_days"          // A team has too few days between two matches
+)
+
+// ScheduleConflict is a clash between a match and another match already on the calendar.
+type ScheduleConflict struct {
+	Kind    ConflictKind
+	MatchID string // The other match
+	TeamID  string // The team booked twice or without rest, empty for venue overlaps
+	VenueID string // The venue booked twice, empty for team conflicts
+	Message string
+	field   string // The field of the match the conflict is reported on
+}
+
+// Detail returns the conflict as an error detail on the field of the match it concerns.
+func (c ScheduleConflict) Detail() derrors.Detail {
+	return derrors.Detail{Field: c.field, Message: fmt.Sprintf("%s: %s", c.Kind, c.Message)}
+}
+
+// ConflictWindow returns the time around the kickoff of the match that other matches must be checked in.
+func (r SchedulingRules) ConflictWindow(m *Match) (from, to time.Time) {
+	days := max(r.MinRestDays, 1)
+	return m.KickoffAt.AddDate(0, 0, -days), m.KickoffAt.AddDate(0, 0, days)
+}
+
+// ScheduleConflicts lists the clashes between the match and the other matches.
+// Cancelled and abandoned matches are not played on their dates, so they never clash.
+func (r SchedulingRules) ScheduleConflicts(m *Match, others []Match) []ScheduleConflict {
+	var conflicts []ScheduleConflict
+	for _, other := range others {
+		if other.ID == m.ID || other.Status == StatusCancelled || other.Status == StatusAbandoned {
+			continue
+		}
+
+		gap := m.KickoffAt.Sub(other.KickoffAt).Abs()
+		overlaps := gap < r.MatchDuration
+		when := fmt.Sprintf("%s %s %s", other.MatchDate.Format("2006-01-02"), other.MatchTime, other.Timezone)
+
+		for _, side := range []struct{ field, teamID string }{{"home_team_id", m.HomeTeamID}, {"away_team_id", m.AwayTeamID}} {
+			field, teamID := side.field, side.teamID
+			if !other.involves(teamID) {
+				continue
+			}
+			if overlaps {
+				conflicts = append(conflicts, ScheduleConflict{
+					Kind:    ConflictTeamDoubleBooked,
+					MatchID: other.ID,
+					TeamID:  teamID,
+					field:   field,
+					Message: fmt.Sprintf("team %s already plays match %s at %s", teamID, other.ID, when),
+				})
+				continue
+			}
+			if restDays := daysBetween(m.MatchDate, other.MatchDate); restDays < r.MinRestDays {
+				conflicts = append(conflicts, ScheduleConflict{
+					Kind:    ConflictRestDays,
+					MatchID: other.ID,
+					TeamID:  teamID,
+					field:   field,
+					Message: fmt.Sprintf("team %s plays match %s on %s, %d days apart where at least %d are required", teamID, other.ID, other.MatchDate.Format("2006-01-02"), restDays, r.MinRestDays),
+				})
+			}
+		}
+
+		if overlaps && m.VenueID != "" && other.VenueID == m.VenueID {
+			conflicts = append(conflicts, ScheduleConflict{
+				Kind:    ConflictVenueOverlap,
+				MatchID: other.ID,
+				VenueID: m.VenueID,
+				field:   "venue_id",
+				Message: fmt.Sprintf("venue %s already hosts match %s at %s", m.VenueID, other.ID, when),
+			})
+		}
+	}
+	return conflicts
+}
+
+// involves reports whether the team plays in the match.
+func (m *Match) involves(teamID string) bool {
+	return m.HomeTeamID == teamID || m.AwayTeamID == teamID
+}
+
+// daysBetween counts the calendar days between two match dates.
+func daysBetween(a, b time.Time) int {
+	return int(calendarDay(a).Sub(calendarDay(b)).Abs().Hours() / 24)
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Competition formats, mirrored from the Competition context.
+const (
+	FormatLeague   = "league"
+	FormatKnockout = "knockout"
+)
+
+// KnockoutRules configures how a knockout tie is decided.
+type KnockoutRules struct {
+	TwoLegged     bool
+	AwayGoalsRule bool
+}
+
+// Season is the Match context's view of a competition season owned by the Competition context.
+type Season struct {
+	ID            string
+	CompetitionID string
+	Name          string
+	StartDate     time.Time
+	EndDate       time.Time
+	Format        string
+	Rules         KnockoutRules
+	Discipline    DisciplineRules
+	AgeLimit      *AgeLimit // Nil for competitions open to all ages
+}
+
+// AgeLimit restricts an age-group competition to players no older than MaxAge on the cutoff date.
+type AgeLimit struct {
+	MaxAge     int
+	CutoffDate time.Time
+}
+
+// Ineligible tells why the player may not play under the limit, and false when they may.
+// Players whose date of birth is unknown may not.
+func (l AgeLimit) Ineligible(p SquadPlayer) (string, bool) {
+	if p.DateOfBirth == nil {
+		return fmt.Sprintf("player %s has no date of birth on record", p.ID), true
+	}
+	if age := l.AgeOn(*p.DateOfBirth); age > l.MaxAge {
+		return fmt.Sprintf("player %s is %d on %s, older than the maximum age of %d", p.ID, age, l.CutoffDate.Format("2006-01-02"), l.MaxAge), true
+	}
+	return "", false
+}
+
+// AgeOn returns how old a player born on dob is on the cutoff date.
+func (l AgeLimit) AgeOn(dob time.Time) int {
+	age := l.CutoffDate.Year() - dob.Year()
+	if l.CutoffDate.Month() < dob.Month() || (l.CutoffDate.Month() == dob.Month() && l.CutoffDate.Day() < dob.Day()) {
+		age--
+	}
+	return age
+}
+
+// IsKnockout reports whether the season belongs to a knockout competition.
+func (s *Season) IsKnockout() bool {
+	return s.Format == FormatKnockout
+}
+
+// Covers reports whether the given date falls within the season, inclusive of both ends.
+func (s *Season) Covers(date time.Time) bool {
+	day := date.Format("2006-01-02")
+	return day >= s.StartDate.Format("2006-01-02") && day <= s.EndDate.Format("2006-01-02")
+}
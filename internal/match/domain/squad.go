@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// SquadPlayer is the Match context's view of a player owned by the Club context,
+// as registered with a team on a given date.
+type SquadPlayer struct {
+	ID           string
+	Name         string
+	TeamID       string
+	Position     string
+	JerseyNumber int
+	DateOfBirth  *time.Time // Nil when unknown
+}
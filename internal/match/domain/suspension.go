@@ -0,0 +1,144 @@
+package domain
+
+import "sort"
+
+// DisciplineRules configures the suspensions players earn from cards, mirrored from the Competition context.
+type DisciplineRules struct {
+	RedCardBan      int // Matches missed after being sent off
+	YellowCardLimit int // Yellow cards in a season that earn a ban, counted afresh after each ban
+	YellowCardBan   int // Matches missed on reaching the yellow card limit
+}
+
+// SuspensionReason is why a player was banned.
+type SuspensionReason string
+
+const (
+	SuspensionSentOff     SuspensionReason = "sent_off"
+	SuspensionYellowCards SuspensionReason = "yellow_cards"
+)
+
+// BookedCard is a card together with the match and season it was shown in.
+type BookedCard struct {
+	Card
+	MatchID  string
+	SeasonID string
+}
+
+// Suspension is a ban a player earned in a match of a season. It is served in the following
+// matches of the player's team in that season, in kickoff order.
+type Suspension struct {
+	PlayerID    string
+	PlayerName  string
+	TeamID      string
+	SeasonID    string
+	Reason      SuspensionReason
+	CardMatchID string   // Match the ban was earned in
+	Length      int      // Matches to miss
+	MatchIDs    []string // Matches the ban covers, fewer than Length while the rest are not scheduled yet
+	Served      int      // Covered matches that have been played
+}
+
+// Active reports whether the player still has matches to sit out.
+func (s Suspension) Active() bool {
+	return s.Served < s.Length
+}
+
+// Covers reports whether the player is banned from the match.
+func (s Suspension) Covers(matchID string) bool {
+	for _, id := range s.MatchIDs {
+		if id == matchID {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeSuspensions works out the bans earned by the cards shown to players of a team in one season.
+// teamMatches are the team's matches in that season; cancelled matches are never played, so bans skip them.
+// A player sent off serves rules.RedCardBan matches. Every rules.YellowCardLimit yellow cards earn a ban of
+// rules.YellowCardBan matches, not counting the yellow that led to a second yellow. A ban earned while
+// another is still running starts once the first one ends.
+func ComputeSuspensions(rules DisciplineRules, cards []BookedCard, teamMatches []Match) []Suspension {
+	var schedule []Match
+	for _, m := range teamMatches {
+		if m.Status != StatusCancelled {
+			schedule = append(schedule, m)
+		}
+	}
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].KickoffAt.Before(schedule[j].KickoffAt)
+	})
+	position := make(map[string]int, len(schedule))
+	for i, m := range schedule {
+		position[m.ID] = i
+	}
+
+	// Cards from matches outside the schedule cannot be placed, so they are left out
+	var ordered []BookedCard
+	for _, c := range cards {
+		if _, ok := position[c.MatchID]; ok {
+			ordered = append(ordered, c)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, pj := position[ordered[i].MatchID], position[ordered[j].MatchID]
+		if pi != pj {
+			return pi < pj
+		}
+		return ordered[i].Minute < ordered[j].Minute
+	})
+
+	// A yellow followed by a second yellow in the same match counts towards the sending off only
+	sentOffWithSecondYellow := make(map[string]bool)
+	for _, c := range ordered {
+		if c.Type == CardSecondYellow {
+			sentOffWithSecondYellow[c.PlayerID+"|"+c.MatchID] = true
+		}
+	}
+
+	var suspensions []Suspension
+	yellows := make(map[string]int)
+	banEnds := make(map[string]int) // Position after the last match covered by a player's bans
+	for _, c := range ordered {
+		var reason SuspensionReason
+		var length int
+		switch {
+		case c.SendsOff():
+			reason, length = SuspensionSentOff, rules.RedCardBan
+		case c.Type == CardYellow && !sentOffWithSecondYellow[c.PlayerID+"|"+c.MatchID]:
+			yellows[c.PlayerID]++
+			if rules.YellowCardLimit > 0 && yellows[c.PlayerID]%rules.YellowCardLimit == 0 {
+				reason, length = SuspensionYellowCards, rules.YellowCardBan
+			}
+		}
+		if length == 0 {
+			continue
+		}
+
+		start := position[c.MatchID] + 1
+		if banEnds[c.PlayerID] > start {
+			start = banEnds[c.PlayerID]
+		}
+		end := start + length
+		banEnds[c.PlayerID] = end
+
+		suspension := Suspension{
+			PlayerID:    c.PlayerID,
+			PlayerName:  c.PlayerName,
+			TeamID:      c.TeamID,
+			SeasonID:    c.SeasonID,
+			Reason:      reason,
+			CardMatchID: c.MatchID,
+			Length:      length,
+		}
+		for i := start; i < end && i < len(schedule); i++ {
+			suspension.MatchIDs = append(suspension.MatchIDs, schedule[i].ID)
+			if schedule[i].Status == StatusFinished {
+				suspension.Served++
+			}
+		}
+		suspensions = append(suspensions, suspension)
+	}
+
+	return suspensions
+}
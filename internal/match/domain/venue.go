@@ -0,0 +1,8 @@
+package domain
+
+// Venue is a venue as seen by the Match context.
+type Venue struct {
+	ID       string
+	Name     string
+	Timezone string // IANA time zone kickoffs at the venue are given in
+}
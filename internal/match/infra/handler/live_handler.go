@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/handler/request"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/handler/response"
+	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// liveKeepAlive is how often an idle live stream sends a comment, so proxies do not close it.
+const liveKeepAlive = 15 * time.Second
+
+func (h *MatchHandler) PostLiveEvent(c *gin.Context) {
+	matchID := c.Param("id")
+
+	var req request.LiveEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	event, err := h.service.PostLiveEvent(c.Request.Context(), matchID, req.ToDomain())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromLiveEvent(event)))
+}
+
+// FollowLive streams the live feed of a match as Server-Sent Events. Events already posted are
+// replayed first, starting after the Last-Event-ID a reconnecting client sends. The stream ends
+// after full time, or once the match is no longer being played.
+func (h *MatchHandler) FollowLive(c *gin.Context) {
+	matchID := c.Param("id")
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	var after int64
+	if lastEventID != "" {
+		parsed, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+			return
+		}
+		after = parsed
+	}
+
+	// Subscribe before reading the history, so nothing posted in between is missed
+	updates, unsubscribe := h.service.SubscribeLiveEvents(matchID)
+	defer unsubscribe()
+
+	// Nothing is posted once the feed is over, so checking first leaves nothing to miss in the history
+	over, err := h.service.LiveFeedOver(c.Request.Context(), matchID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	history, err := h.service.GetLiveEvents(c.Request.Context(), matchID, after)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	for i := range history {
+		renderLiveEvent(c, &history[i])
+		after = history[i].Sequence
+		if history[i].Type == domain.LiveFullTime {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	// A client reconnecting after full time, or following a match that is over, has nothing left to wait for
+	if over {
+		return
+	}
+
+	keepAlive := time.NewTicker(liveKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case event, ok := <-updates:
+			if !ok {
+				// Dropped for falling behind, the client reconnects with the last event it saw
+				return false
+			}
+			if event.Sequence <= after {
+				return true
+			}
+			renderLiveEvent(c, &event)
+			after = event.Sequence
+			return event.Type != domain.LiveFullTime
+		case <-keepAlive.C:
+			// Catches a match called off or abandoned without a full-time event
+			over, err := h.service.LiveFeedOver(c.Request.Context(), matchID)
+			if err != nil {
+				return false
+			}
+			if over {
+				// Send what was posted before the end but has not come through yet
+				rest, err := h.service.GetLiveEvents(c.Request.Context(), matchID, after)
+				if err == nil {
+					for i := range rest {
+						renderLiveEvent(c, &rest[i])
+					}
+				}
+				return false
+			}
+			fmt.Fprint(w, ": keep-alive\n\n")
+			return true
+		}
+	})
+}
+
+func renderLiveEvent(c *gin.Context, event *domain.LiveEvent) {
+	c.Render(-1, sse.Event{
+		Id:    strconv.FormatInt(event.Sequence, 10),
+		Event: string(event.Type),
+		Data:  response.FromLiveEvent(event),
+	})
+}
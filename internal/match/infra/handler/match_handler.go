@@ -2,11 +2,15 @@ package handler
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/handler/request"
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/handler/response"
 	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	authguard "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http/middleware/authguard"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/http/middleware/force"
 	"github.com/gin-gonic/gin"
 )
 
@@ -25,7 +29,7 @@ func (h *MatchHandler) CreateMatch(c *gin.Context) {
 		return
 	}
 
-	id, err := h.service.CreateMatch(c.Request.Context(), req.ToDomain())
+	id, err := h.service.CreateMatch(c.Request.Context(), req.ToDomain(), force.Requested(c))
 	if err != nil {
 		resp := common.RenderErrorResponse(err)
 		c.JSON(resp.Code, resp)
@@ -36,14 +40,31 @@ func (h *MatchHandler) CreateMatch(c *gin.Context) {
 }
 
 func (h *MatchHandler) GetAllMatches(c *gin.Context) {
-	matches, err := h.service.GetAllMatches(c.Request.Context())
+	filter := domain.MatchFilter{
+		SeasonID: c.Query("season_id"),
+	}
+
+	// ?status=scheduled,postponed lists matches in any of the given statuses
+	if statuses := c.Query("status"); statuses != "" {
+		for _, s := range strings.Split(statuses, ",") {
+			status, err := domain.ParseMatchStatus(s)
+			if err != nil {
+				resp := common.RenderErrorResponse(err)
+				c.JSON(resp.Code, resp)
+				return
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	matches, err := h.service.GetAllMatches(c.Request.Context(), filter)
 	if err != nil {
 		resp := common.RenderErrorResponse(err)
 		c.JSON(resp.Code, resp)
 		return
 	}
 
-	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatches(matches)))
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatches(matches, c.Query("tz"))))
 }
 
 func (h *MatchHandler) GetMatchByID(c *gin.Context) {
@@ -56,7 +77,134 @@ func (h *MatchHandler) GetMatchByID(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match)))
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
+}
+
+func (h *MatchHandler) UpdateMatch(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.UpdateMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	match, err := h.service.UpdateMatch(c.Request.Context(), id, req.Date(), req.MatchTime, req.VenueID, req.Reason, force.Requested(c))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
+}
+
+func (h *MatchHandler) GetMatchReschedules(c *gin.Context) {
+	id := c.Param("id")
+
+	reschedules, err := h.service.GetMatchReschedules(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatchReschedules(reschedules, c.Query("tz"))))
+}
+
+func (h *MatchHandler) DeleteMatch(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.service.DeleteMatch(c.Request.Context(), id); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *MatchHandler) RestoreMatch(c *gin.Context) {
+	id := c.Param("id")
+
+	match, err := h.service.RestoreMatch(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
+}
+
+func (h *MatchHandler) StartMatch(c *gin.Context) {
+	id := c.Param("id")
+
+	match, err := h.service.StartMatch(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
+}
+
+func (h *MatchHandler) PostponeMatch(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.PostponeMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	match, err := h.service.PostponeMatch(c.Request.Context(), id, req.Date(), req.MatchTime, req.Reason, force.Requested(c))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
+}
+
+func (h *MatchHandler) CancelMatch(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.MatchStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	match, err := h.service.CancelMatch(c.Request.Context(), id, req.Reason)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
+}
+
+func (h *MatchHandler) AbandonMatch(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.MatchStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	match, err := h.service.AbandonMatch(c.Request.Context(), id, req.Reason)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
 }
 
 func (h *MatchHandler) ReportResult(c *gin.Context) {
@@ -78,6 +226,131 @@ func (h *MatchHandler) ReportResult(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
 }
 
+func (h *MatchHandler) AmendResult(c *gin.Context) {
+	matchID := c.Param("id")
+
+	var req request.AmendResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	user := authguard.CurrentUser(c)
+	id, err := h.service.AmendResult(c.Request.Context(), matchID, req.ToDomain(), user.Email, req.Reason)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
+}
+
+func (h *MatchHandler) VoidResult(c *gin.Context) {
+	matchID := c.Param("id")
+
+	var req request.MatchStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	user := authguard.CurrentUser(c)
+	match, err := h.service.VoidResult(c.Request.Context(), matchID, user.Email, req.Reason)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatch(match, c.Query("tz"))))
+}
+
+func (h *MatchHandler) GetResultRevisions(c *gin.Context) {
+	matchID := c.Param("id")
+
+	revisions, err := h.service.GetResultRevisions(c.Request.Context(), matchID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromResultRevisions(revisions)))
+}
+
+func (h *MatchHandler) SubmitLineup(c *gin.Context) {
+	matchID := c.Param("id")
+
+	var req request.SubmitLineupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	user := authguard.CurrentUser(c)
+	lineup, err := h.service.SubmitLineup(c.Request.Context(), matchID, req.ToDomain(), user.Email)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromLineup(lineup)))
+}
+
+func (h *MatchHandler) GetLineups(c *gin.Context) {
+	lineups, err := h.service.GetLineups(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromLineups(lineups)))
+}
+
+func (h *MatchHandler) AddSubstitution(c *gin.Context) {
+	matchID := c.Param("id")
+
+	var req request.SubstitutionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	id, err := h.service.AddSubstitution(c.Request.Context(), matchID, req.ToDomain())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
+}
+
+func (h *MatchHandler) GetPlayerSuspensions(c *gin.Context) {
+	suspensions, err := h.service.GetPlayerSuspensions(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromSuspensions(suspensions)))
+}
+
+func (h *MatchHandler) GetSuspendedPlayers(c *gin.Context) {
+	suspensions, err := h.service.GetSuspendedPlayers(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromSuspensions(suspensions)))
+}
+
 func (h *MatchHandler) GetMatchReport(c *gin.Context) {
 	matchID := c.Param("id")
 
@@ -88,7 +361,7 @@ func (h *MatchHandler) GetMatchReport(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatchReport(report)))
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatchReport(report, c.Query("tz"))))
 }
 
 func (h *MatchHandler) GetAllMatchReports(c *gin.Context) {
@@ -99,5 +372,69 @@ func (h *MatchHandler) GetAllMatchReports(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatchReports(reports)))
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatchReports(reports, c.Query("tz"))))
+}
+
+func (h *MatchHandler) GenerateFixtures(c *gin.Context) {
+	seasonID := c.Param("id")
+
+	var req request.GenerateFixturesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	fixtures, err := h.service.GenerateFixtures(c.Request.Context(), req.ToDomain(seasonID))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromFixtures(seasonID, req.DryRun, fixtures, c.Query("tz"))))
+}
+
+func (h *MatchHandler) DrawKnockout(c *gin.Context) {
+	seasonID := c.Param("id")
+
+	var req request.DrawKnockoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	bracket, err := h.service.DrawKnockout(c.Request.Context(), req.ToDomain(seasonID))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromBracket(bracket)))
+}
+
+func (h *MatchHandler) GetBracket(c *gin.Context) {
+	competitionID := c.Param("id")
+
+	bracket, err := h.service.GetBracket(c.Request.Context(), competitionID, c.Query("season_id"))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromBracket(bracket)))
+}
+
+// validTimezone rejects requests whose ?tz= is not an IANA time zone to render kickoff times in.
+func validTimezone(c *gin.Context) {
+	if tz := c.Query("tz"); tz != "" {
+		if _, err := domain.LoadTimezone(tz); err != nil {
+			resp := common.RenderErrorResponse(err)
+			c.JSON(resp.Code, resp)
+			c.Abort()
+			return
+		}
+	}
+	c.Next()
 }
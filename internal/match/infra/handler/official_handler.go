@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/handler/request"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/infra/handler/response"
+	common "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http"
+	authguard "github.com/ZyoGo/ayo-indonesia-footbal/pkg/http/middleware/authguard"
+	"github.com/gin-gonic/gin"
+)
+
+type OfficialHandler struct {
+	service app.OfficialServicePort
+}
+
+func NewOfficialHandler(service app.OfficialServicePort) *OfficialHandler {
+	return &OfficialHandler{service: service}
+}
+
+func (h *OfficialHandler) Create(c *gin.Context) {
+	var req request.CreateOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	id, err := h.service.Create(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewCreatedSuccessResponse(id))
+}
+
+func (h *OfficialHandler) GetAll(c *gin.Context) {
+	officials, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromOfficials(officials)))
+}
+
+func (h *OfficialHandler) GetByID(c *gin.Context) {
+	id := c.Param("id")
+
+	official, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromOfficial(official)))
+}
+
+func (h *OfficialHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	var req request.UpdateOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	if err := h.service.Update(c.Request.Context(), id, req.ToDomain()); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *OfficialHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+func (h *OfficialHandler) Assign(c *gin.Context) {
+	matchID := c.Param("id")
+
+	var req request.AssignOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewBadRequestResponse())
+		return
+	}
+
+	assignment, err := h.service.Assign(c.Request.Context(), matchID, req.OfficialID, domain.OfficialRole(req.Role))
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromMatchOfficial(assignment)))
+}
+
+func (h *OfficialHandler) Unassign(c *gin.Context) {
+	matchID := c.Param("id")
+	officialID := c.Param("official_id")
+
+	if err := h.service.Unassign(c.Request.Context(), matchID, officialID); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponse())
+}
+
+// IssueCredential hands out the token an assigned official submits the result of the match with.
+func (h *OfficialHandler) IssueCredential(c *gin.Context) {
+	matchID := c.Param("id")
+	officialID := c.Param("official_id")
+
+	token, expiresAt, err := h.service.IssueCredential(c.Request.Context(), matchID, officialID)
+	if err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(response.FromOfficialCredential(token, domain.ResultScope(matchID), expiresAt)))
+}
+
+// AssignedOfficial lets requests made with an official's credential through only while
+// the official is still assigned to the match. Requests made by users pass untouched.
+func (h *OfficialHandler) AssignedOfficial(c *gin.Context) {
+	user := authguard.CurrentUser(c)
+	if user.Scope == "" {
+		c.Next()
+		return
+	}
+
+	if err := h.service.CheckCredential(c.Request.Context(), c.Param("id"), user.Email); err != nil {
+		resp := common.RenderErrorResponse(err)
+		c.JSON(resp.Code, resp)
+		c.Abort()
+		return
+	}
+
+	c.Next()
+}
+
+// ResultScope is the credential scope that allows submitting the result of the match in the path.
+func ResultScope(c *gin.Context) string {
+	return domain.ResultScope(c.Param("id"))
+}
@@ -7,48 +7,239 @@ import (
 )
 
 type CreateMatchRequest struct {
+	SeasonID   string `json:"season_id" binding:"required"`
 	HomeTeamID string `json:"home_team_id" binding:"required"`
 	AwayTeamID string `json:"away_team_id" binding:"required"`
 	MatchDate  string `json:"match_date" binding:"required"` // YYYY-MM-DD
 	MatchTime  string `json:"match_time" binding:"required"` // HH:MM
-	Stadium    string `json:"stadium" binding:"required"`
+	VenueID    string `json:"venue_id"`                      // Defaults to the home team's venue
 }
 
 func (r CreateMatchRequest) ToDomain() *domain.Match {
 	date, _ := time.Parse("2006-01-02", r.MatchDate)
 	return &domain.Match{
+		SeasonID:   r.SeasonID,
 		HomeTeamID: r.HomeTeamID,
 		AwayTeamID: r.AwayTeamID,
 		MatchDate:  date,
 		MatchTime:  r.MatchTime,
-		Stadium:    r.Stadium,
+		VenueID:    r.VenueID,
 	}
 }
 
+type UpdateMatchRequest struct {
+	MatchDate string `json:"match_date" binding:"required"` // YYYY-MM-DD
+	MatchTime string `json:"match_time" binding:"required"` // HH:MM
+	VenueID   string `json:"venue_id"`                      // Defaults to the current venue
+	Reason    string `json:"reason" binding:"required"`
+}
+
+func (r UpdateMatchRequest) Date() time.Time {
+	date, _ := time.Parse("2006-01-02", r.MatchDate)
+	return date
+}
+
+type PostponeMatchRequest struct {
+	MatchDate string `json:"match_date" binding:"required"` // YYYY-MM-DD
+	MatchTime string `json:"match_time"`                    // HH:MM, defaults to the current kickoff time
+	Reason    string `json:"reason"`
+}
+
+func (r PostponeMatchRequest) Date() time.Time {
+	date, _ := time.Parse("2006-01-02", r.MatchDate)
+	return date
+}
+
+// MatchStatusRequest carries the reason for cancelling or abandoning a match, or voiding its result.
+type MatchStatusRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
 type ReportResultRequest struct {
-	HomeScore int          `json:"home_score" binding:"min=0"`
-	AwayScore int          `json:"away_score" binding:"min=0"`
-	Goals     []GoalInput  `json:"goals" binding:"required"`
+	HomeScore int                 `json:"home_score" binding:"min=0"`
+	AwayScore int                 `json:"away_score" binding:"min=0"`
+	Goals     []GoalInput         `json:"goals" binding:"required"`
+	Cards     []CardInput         `json:"cards"`
+	DecidedBy string              `json:"decided_by" binding:"omitempty,oneof=regulation extra_time penalties"`
+	Shootout  []ShootoutKickInput `json:"shootout"`
 }
 
+// GoalInput is a single goal. For an own goal, team_id is the team the goal counts for
+// and player_id is the opponent who put the ball into their own net.
 type GoalInput struct {
-	PlayerID   string `json:"player_id" binding:"required"`
-	TeamID     string `json:"team_id" binding:"required"`
-	GoalMinute int    `json:"goal_minute" binding:"required"`
+	PlayerID       string `json:"player_id" binding:"required"`
+	TeamID         string `json:"team_id" binding:"required"`
+	Type           string `json:"type" binding:"omitempty,oneof=open_play penalty own_goal free_kick header"`
+	AssistPlayerID string `json:"assist_player_id"`
+	GoalMinute     int    `json:"goal_minute" binding:"required"`
+}
+
+type CardInput struct {
+	PlayerID string `json:"player_id" binding:"required"`
+	TeamID   string `json:"team_id" binding:"required"`
+	Type     string `json:"type" binding:"required,oneof=yellow second_yellow red"`
+	Minute   int    `json:"minute" binding:"required"`
+	Reason   string `json:"reason"`
+}
+
+type ShootoutKickInput struct {
+	Order    int    `json:"order" binding:"required,min=1"`
+	PlayerID string `json:"player_id" binding:"required"`
+	TeamID   string `json:"team_id" binding:"required"`
+	Scored   bool   `json:"scored"`
 }
 
 func (r ReportResultRequest) ToDomain() *domain.MatchResult {
 	goals := make([]domain.Goal, len(r.Goals))
 	for i, g := range r.Goals {
 		goals[i] = domain.Goal{
-			PlayerID:   g.PlayerID,
-			TeamID:     g.TeamID,
-			GoalMinute: g.GoalMinute,
+			PlayerID:       g.PlayerID,
+			TeamID:         g.TeamID,
+			Type:           domain.GoalType(g.Type),
+			AssistPlayerID: g.AssistPlayerID,
+			GoalMinute:     g.GoalMinute,
+		}
+	}
+	cards := make([]domain.Card, len(r.Cards))
+	for i, c := range r.Cards {
+		cards[i] = domain.Card{
+			PlayerID: c.PlayerID,
+			TeamID:   c.TeamID,
+			Type:     domain.CardType(c.Type),
+			Minute:   c.Minute,
+			Reason:   c.Reason,
+		}
+	}
+	shootout := make([]domain.ShootoutKick, len(r.Shootout))
+	for i, k := range r.Shootout {
+		shootout[i] = domain.ShootoutKick{
+			Order:    k.Order,
+			PlayerID: k.PlayerID,
+			TeamID:   k.TeamID,
+			Scored:   k.Scored,
 		}
 	}
 	return &domain.MatchResult{
 		HomeScore: r.HomeScore,
 		AwayScore: r.AwayScore,
+		DecidedBy: domain.DecidedBy(r.DecidedBy),
 		Goals:     goals,
+		Cards:     cards,
+		Shootout:  shootout,
+	}
+}
+
+// AmendResultRequest replaces a reported result. The reason is kept with the previous version.
+type AmendResultRequest struct {
+	ReportResultRequest
+	Reason string `json:"reason" binding:"required"`
+}
+
+// SubmitLineupRequest names a team's starting XI and bench. Jersey numbers default to the
+// numbers the players are registered with.
+type SubmitLineupRequest struct {
+	TeamID   string              `json:"team_id" binding:"required"`
+	Starters []LineupPlayerInput `json:"starters" binding:"required"`
+	Bench    []LineupPlayerInput `json:"bench"`
+}
+
+type LineupPlayerInput struct {
+	PlayerID     string `json:"player_id" binding:"required"`
+	JerseyNumber int    `json:"jersey_number" binding:"omitempty,min=1,max=99"`
+}
+
+func (r SubmitLineupRequest) ToDomain() *domain.Lineup {
+	players := make([]domain.LineupPlayer, 0, len(r.Starters)+len(r.Bench))
+	for _, p := range r.Starters {
+		players = append(players, domain.LineupPlayer{PlayerID: p.PlayerID, JerseyNumber: p.JerseyNumber, Starter: true})
+	}
+	for _, p := range r.Bench {
+		players = append(players, domain.LineupPlayer{PlayerID: p.PlayerID, JerseyNumber: p.JerseyNumber})
+	}
+	return &domain.Lineup{
+		TeamID:  r.TeamID,
+		Players: players,
+	}
+}
+
+type SubstitutionRequest struct {
+	TeamID      string `json:"team_id" binding:"required"`
+	Minute      int    `json:"minute" binding:"required"`
+	PlayerOffID string `json:"player_off_id" binding:"required"`
+	PlayerOnID  string `json:"player_on_id" binding:"required"`
+}
+
+func (r SubstitutionRequest) ToDomain() *domain.Substitution {
+	return &domain.Substitution{
+		TeamID:      r.TeamID,
+		Minute:      r.Minute,
+		PlayerOffID: r.PlayerOffID,
+		PlayerOnID:  r.PlayerOnID,
+	}
+}
+
+// LiveEventRequest is an event posted to the live feed. player_id is the scorer, the booked player
+// or the player going off; player_in_id is the player coming on.
+type LiveEventRequest struct {
+	Type       string `json:"type" binding:"required,oneof=kickoff goal card substitution half_time full_time"`
+	Minute     int    `json:"minute" binding:"min=0"`
+	TeamID     string `json:"team_id"`
+	PlayerID   string `json:"player_id"`
+	PlayerInID string `json:"player_in_id"`
+	CardType   string `json:"card_type" binding:"omitempty,oneof=yellow second_yellow red"`
+	Note       string `json:"note"`
+}
+
+func (r LiveEventRequest) ToDomain() *domain.LiveEvent {
+	return &domain.LiveEvent{
+		Type:       domain.LiveEventType(r.Type),
+		Minute:     r.Minute,
+		TeamID:     r.TeamID,
+		PlayerID:   r.PlayerID,
+		PlayerInID: r.PlayerInID,
+		CardType:   domain.CardType(r.CardType),
+		Note:       r.Note,
+	}
+}
+
+type GenerateFixturesRequest struct {
+	StartDate    string `json:"start_date" binding:"required"` // YYYY-MM-DD
+	IntervalDays int    `json:"interval_days" binding:"required,min=1"`
+	MatchTime    string `json:"match_time" binding:"required"` // HH:MM
+	DoubleRound  bool   `json:"double_round_robin"`
+	DryRun       bool   `json:"dry_run"`
+}
+
+func (r GenerateFixturesRequest) ToDomain(seasonID string) domain.FixtureOptions {
+	startDate, _ := time.Parse("2006-01-02", r.StartDate)
+	return domain.FixtureOptions{
+		SeasonID:     seasonID,
+		StartDate:    startDate,
+		IntervalDays: r.IntervalDays,
+		MatchTime:    r.MatchTime,
+		DoubleRound:  r.DoubleRound,
+		DryRun:       r.DryRun,
+	}
+}
+
+type DrawKnockoutRequest struct {
+	Method            string   `json:"method" binding:"required,oneof=seeded random"`
+	SeedOrder         []string `json:"seed_order"`                    // Team IDs, top seed first
+	StartDate         string   `json:"start_date" binding:"required"` // YYYY-MM-DD
+	RoundIntervalDays int      `json:"round_interval_days" binding:"required,min=1"`
+	LegIntervalDays   int      `json:"leg_interval_days" binding:"min=0"`
+	MatchTime         string   `json:"match_time" binding:"required"` // HH:MM
+}
+
+func (r DrawKnockoutRequest) ToDomain(seasonID string) domain.DrawOptions {
+	startDate, _ := time.Parse("2006-01-02", r.StartDate)
+	return domain.DrawOptions{
+		SeasonID:          seasonID,
+		Method:            domain.DrawMethod(r.Method),
+		SeedOrder:         r.SeedOrder,
+		StartDate:         startDate,
+		RoundIntervalDays: r.RoundIntervalDays,
+		LegIntervalDays:   r.LegIntervalDays,
+		MatchTime:         r.MatchTime,
 	}
 }
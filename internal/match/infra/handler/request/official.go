@@ -0,0 +1,32 @@
+package request
+
+import "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+
+type CreateOfficialRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Email string `json:"email" binding:"required"`
+}
+
+func (r CreateOfficialRequest) ToDomain() *domain.Official {
+	return &domain.Official{
+		Name:  r.Name,
+		Email: r.Email,
+	}
+}
+
+type UpdateOfficialRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Email string `json:"email" binding:"required"`
+}
+
+func (r UpdateOfficialRequest) ToDomain() *domain.Official {
+	return &domain.Official{
+		Name:  r.Name,
+		Email: r.Email,
+	}
+}
+
+type AssignOfficialRequest struct {
+	OfficialID string `json:"official_id" binding:"required"`
+	Role       string `json:"role" binding:"required"` // referee, assistant_referee, fourth_official or var
+}
@@ -1,101 +1,595 @@
 package response
 
-import "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+)
+
+// kickoff is the moment a match kicks off as read on the clock of a time zone.
+type kickoff struct {
+	date, time, at, timezone string
+}
+
+// localKickoff renders a kickoff in the time zone asked for, or in the venue's when none is.
+func localKickoff(at time.Time, venueTimezone, tz string) kickoff {
+	if tz == "" {
+		tz = venueTimezone
+	}
+	loc, err := domain.LoadTimezone(tz)
+	if err != nil {
+		loc = time.UTC // Requested zones are validated by the handler, so only a bad stored zone gets here
+	}
+	local := at.In(loc)
+	return kickoff{
+		date:     local.Format("2006-01-02"),
+		time:     local.Format("15:04"),
+		at:       local.Format(time.RFC3339),
+		timezone: loc.String(),
+	}
+}
 
 type MatchSummaryResponse struct {
 	ID           string `json:"id"`
+	SeasonID     string `json:"season_id"`
+	SeasonName   string `json:"season_name"`
 	HomeTeamID   string `json:"home_team_id"`
 	HomeTeamName string `json:"home_team_name"`
 	AwayTeamID   string `json:"away_team_id"`
 	AwayTeamName string `json:"away_team_name"`
 	MatchDate    string `json:"match_date"`
 	MatchTime    string `json:"match_time"`
+	KickoffAt    string `json:"kickoff_at"`
+	Timezone     string `json:"timezone"`
+	Status       string `json:"status"`
 }
 
 type MatchDetailResponse struct {
-	ID           string `json:"id"`
-	HomeTeamID   string `json:"home_team_id"`
-	HomeTeamName string `json:"home_team_name"`
-	AwayTeamID   string `json:"away_team_id"`
-	AwayTeamName string `json:"away_team_name"`
-	MatchDate    string `json:"match_date"`
-	MatchTime    string `json:"match_time"`
-	Stadium      string `json:"stadium"`
-	CreatedAt    string `json:"created_at"`
-	UpdatedAt    string `json:"updated_at"`
+	ID           string                  `json:"id"`
+	SeasonID     string                  `json:"season_id"`
+	SeasonName   string                  `json:"season_name"`
+	HomeTeamID   string                  `json:"home_team_id"`
+	HomeTeamName string                  `json:"home_team_name"`
+	AwayTeamID   string                  `json:"away_team_id"`
+	AwayTeamName string                  `json:"away_team_name"`
+	MatchDate    string                  `json:"match_date"`
+	MatchTime    string                  `json:"match_time"`
+	KickoffAt    string                  `json:"kickoff_at"`
+	Timezone     string                  `json:"timezone"`
+	VenueID      string                  `json:"venue_id"`
+	VenueName    string                  `json:"venue_name"`
+	Status       string                  `json:"status"`
+	StatusReason string                  `json:"status_reason,omitempty"`
+	Cards        []CardResponse          `json:"cards"`
+	Officials    []MatchOfficialResponse `json:"officials"`
+	CreatedAt    string                  `json:"created_at"`
+	UpdatedAt    string                  `json:"updated_at"`
+}
+
+type CardResponse struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	TeamID     string `json:"team_id"`
+	Type       string `json:"type"`
+	Minute     int    `json:"minute"`
+	Reason     string `json:"reason,omitempty"`
 }
 
-func FromMatch(match *domain.Match) MatchDetailResponse {
+func FromCards(cards []domain.Card) []CardResponse {
+	result := make([]CardResponse, len(cards))
+	for i, c := range cards {
+		result[i] = CardResponse{
+			PlayerID:   c.PlayerID,
+			PlayerName: c.PlayerName,
+			TeamID:     c.TeamID,
+			Type:       string(c.Type),
+			Minute:     c.Minute,
+			Reason:     c.Reason,
+		}
+	}
+	return result
+}
+
+// FromMatch renders the match with its kickoff in the time zone tz, or in the venue's when tz is empty.
+func FromMatch(match *domain.Match, tz string) MatchDetailResponse {
+	kickoff := localKickoff(match.KickoffAt, match.Timezone, tz)
 	return MatchDetailResponse{
 		ID:           match.ID,
+		SeasonID:     match.SeasonID,
+		SeasonName:   match.SeasonName,
 		HomeTeamID:   match.HomeTeamID,
 		HomeTeamName: match.HomeTeamName,
 		AwayTeamID:   match.AwayTeamID,
 		AwayTeamName: match.AwayTeamName,
-		MatchDate:    match.MatchDate.Format("2006-01-02"),
-		MatchTime:    match.MatchTime,
-		Stadium:      match.Stadium,
+		MatchDate:    kickoff.date,
+		MatchTime:    kickoff.time,
+		KickoffAt:    kickoff.at,
+		Timezone:     kickoff.timezone,
+		VenueID:      match.VenueID,
+		VenueName:    match.VenueName,
+		Status:       string(match.Status),
+		StatusReason: match.StatusReason,
+		Cards:        FromCards(match.Cards),
+		Officials:    FromMatchOfficials(match.Officials),
 		CreatedAt:    match.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:    match.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
-func FromMatchSummary(match *domain.Match) MatchSummaryResponse {
+func FromMatchSummary(match *domain.Match, tz string) MatchSummaryResponse {
+	kickoff := localKickoff(match.KickoffAt, match.Timezone, tz)
 	return MatchSummaryResponse{
 		ID:           match.ID,
+		SeasonID:     match.SeasonID,
+		SeasonName:   match.SeasonName,
 		HomeTeamID:   match.HomeTeamID,
 		HomeTeamName: match.HomeTeamName,
 		AwayTeamID:   match.AwayTeamID,
 		AwayTeamName: match.AwayTeamName,
-		MatchDate:    match.MatchDate.Format("2006-01-02"),
-		MatchTime:    match.MatchTime,
+		MatchDate:    kickoff.date,
+		MatchTime:    kickoff.time,
+		KickoffAt:    kickoff.at,
+		Timezone:     kickoff.timezone,
+		Status:       string(match.Status),
 	}
 }
 
-func FromMatches(matches []domain.Match) []MatchSummaryResponse {
+func FromMatches(matches []domain.Match, tz string) []MatchSummaryResponse {
 	result := make([]MatchSummaryResponse, len(matches))
 	for i, m := range matches {
-		result[i] = FromMatchSummary(&m)
+		result[i] = FromMatchSummary(&m, tz)
+	}
+	return result
+}
+
+type MatchRescheduleResponse struct {
+	ID                string `json:"id"`
+	PreviousDate      string `json:"previous_date"`
+	PreviousTime      string `json:"previous_time"`
+	PreviousKickoffAt string `json:"previous_kickoff_at"`
+	PreviousTimezone  string `json:"previous_timezone"`
+	PreviousVenueID   string `json:"previous_venue_id"`
+	PreviousVenueName string `json:"previous_venue_name"`
+	NewDate           string `json:"new_date"`
+	NewTime           string `json:"new_time"`
+	NewKickoffAt      string `json:"new_kickoff_at"`
+	NewTimezone       string `json:"new_timezone"`
+	NewVenueID        string `json:"new_venue_id"`
+	NewVenueName      string `json:"new_venue_name"`
+	Reason            string `json:"reason"`
+	CreatedAt         string `json:"created_at"`
+}
+
+// FromMatchReschedules renders each kickoff in the time zone tz, or in the zone of its own venue when tz is empty.
+func FromMatchReschedules(reschedules []domain.MatchReschedule, tz string) []MatchRescheduleResponse {
+	result := make([]MatchRescheduleResponse, len(reschedules))
+	for i, r := range reschedules {
+		previous := localKickoff(r.PreviousKickoffAt, r.PreviousTimezone, tz)
+		next := localKickoff(r.NewKickoffAt, r.NewTimezone, tz)
+		result[i] = MatchRescheduleResponse{
+			ID:                r.ID,
+			PreviousDate:      previous.date,
+			PreviousTime:      previous.time,
+			PreviousKickoffAt: previous.at,
+			PreviousTimezone:  previous.timezone,
+			PreviousVenueID:   r.PreviousVenueID,
+			PreviousVenueName: r.PreviousVenueName,
+			NewDate:           next.date,
+			NewTime:           next.time,
+			NewKickoffAt:      next.at,
+			NewTimezone:       next.timezone,
+			NewVenueID:        r.NewVenueID,
+			NewVenueName:      r.NewVenueName,
+			Reason:            r.Reason,
+			CreatedAt:         r.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return result
+}
+
+type RevisionGoalResponse struct {
+	PlayerID         string `json:"player_id"`
+	PlayerName       string `json:"player_name"`
+	TeamID           string `json:"team_id"`
+	Type             string `json:"type"`
+	AssistPlayerID   string `json:"assist_player_id,omitempty"`
+	AssistPlayerName string `json:"assist_player_name,omitempty"`
+	GoalMinute       int    `json:"goal_minute"`
+}
+
+type ResultRevisionResponse struct {
+	ID            string                 `json:"id"`
+	Action        string                 `json:"action"`
+	ChangedBy     string                 `json:"changed_by"`
+	Reason        string                 `json:"reason"`
+	ResultID      string                 `json:"result_id"`
+	HomeScore     int                    `json:"home_score"`
+	AwayScore     int                    `json:"away_score"`
+	DecidedBy     string                 `json:"decided_by"`
+	HomePenalties *int                   `json:"home_penalties,omitempty"`
+	AwayPenalties *int                   `json:"away_penalties,omitempty"`
+	Goals         []RevisionGoalResponse `json:"goals"`
+	CreatedAt     string                 `json:"created_at"`
+}
+
+func FromResultRevisions(revisions []domain.ResultRevision) []ResultRevisionResponse {
+	result := make([]ResultRevisionResponse, len(revisions))
+	for i, r := range revisions {
+		goals := make([]RevisionGoalResponse, len(r.Result.Goals))
+		for j, g := range r.Result.Goals {
+			goals[j] = RevisionGoalResponse{
+				PlayerID:         g.PlayerID,
+				PlayerName:       g.PlayerName,
+				TeamID:           g.TeamID,
+				Type:             string(g.Type),
+				AssistPlayerID:   g.AssistPlayerID,
+				AssistPlayerName: g.AssistPlayerName,
+				GoalMinute:       g.GoalMinute,
+			}
+		}
+		result[i] = ResultRevisionResponse{
+			ID:        r.ID,
+			Action:    string(r.Action),
+			ChangedBy: r.ChangedBy,
+			Reason:    r.Reason,
+			ResultID:  r.Result.ID,
+			HomeScore: r.Result.HomeScore,
+			AwayScore: r.Result.AwayScore,
+			DecidedBy: string(r.Result.DecidedBy),
+			Goals:     goals,
+			CreatedAt: r.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if r.Result.DecidedBy == domain.DecidedOnPenalties {
+			result[i].HomePenalties = &revisions[i].Result.HomePenalties
+			result[i].AwayPenalties = &revisions[i].Result.AwayPenalties
+		}
 	}
 	return result
 }
 
 type MatchReportResponse struct {
-	MatchID        string `json:"match_id"`
-	MatchDate      string `json:"match_date"`
-	MatchTime      string `json:"match_time"`
-	HomeTeamName   string `json:"home_team_name"`
-	AwayTeamName   string `json:"away_team_name"`
-	HomeScore      int    `json:"home_score"`
-	AwayScore      int    `json:"away_score"`
-	MatchStatus    string `json:"match_status"`
-	TopScorer      string `json:"top_scorer"`
-	TopScorerGoals int    `json:"top_scorer_goals"`
-	HomeTeamWins   int    `json:"home_team_wins"`
-	AwayTeamWins   int    `json:"away_team_wins"`
-}
-
-func FromMatchReport(report *domain.MatchReportView) MatchReportResponse {
-	return MatchReportResponse{
+	MatchID        string                  `json:"match_id"`
+	MatchDate      string                  `json:"match_date"`
+	MatchTime      string                  `json:"match_time"`
+	KickoffAt      string                  `json:"kickoff_at"`
+	Timezone       string                  `json:"timezone"`
+	HomeTeamName   string                  `json:"home_team_name"`
+	AwayTeamName   string                  `json:"away_team_name"`
+	HomeScore      int                     `json:"home_score"`
+	AwayScore      int                     `json:"away_score"`
+	MatchStatus    string                  `json:"match_status"`
+	DecidedBy      string                  `json:"decided_by"`
+	HomePenalties  *int                    `json:"home_penalties,omitempty"`
+	AwayPenalties  *int                    `json:"away_penalties,omitempty"`
+	TopScorer      string                  `json:"top_scorer"`
+	TopScorerGoals int                     `json:"top_scorer_goals"`
+	Cards          []CardResponse          `json:"cards"`
+	Officials      []MatchOfficialResponse `json:"officials"`
+	HomeTeamWins   int                     `json:"home_team_wins"`
+	AwayTeamWins   int                     `json:"away_team_wins"`
+}
+
+func FromMatchReport(report *domain.MatchReportView, tz string) MatchReportResponse {
+	kickoff := localKickoff(report.KickoffAt, report.Timezone, tz)
+	resp := MatchReportResponse{
 		MatchID:        report.MatchID,
-		MatchDate:      report.MatchDate,
-		MatchTime:      report.MatchTime,
+		MatchDate:      kickoff.date,
+		MatchTime:      kickoff.time,
+		KickoffAt:      kickoff.at,
+		Timezone:       kickoff.timezone,
 		HomeTeamName:   report.HomeTeamName,
 		AwayTeamName:   report.AwayTeamName,
 		HomeScore:      report.HomeScore,
 		AwayScore:      report.AwayScore,
 		MatchStatus:    report.MatchStatus,
+		DecidedBy:      report.DecidedBy,
 		TopScorer:      report.TopScorer,
 		TopScorerGoals: report.TopScorerGoals,
+		Cards:          FromCards(report.Cards),
+		Officials:      FromMatchOfficials(report.Officials),
 		HomeTeamWins:   report.HomeTeamWins,
 		AwayTeamWins:   report.AwayTeamWins,
 	}
+	if report.DecidedBy == string(domain.DecidedOnPenalties) {
+		resp.HomePenalties = &report.HomePenalties
+		resp.AwayPenalties = &report.AwayPenalties
+	}
+	return resp
 }
 
-func FromMatchReports(reports []domain.MatchReportView) []MatchReportResponse {
+func FromMatchReports(reports []domain.MatchReportView, tz string) []MatchReportResponse {
 	result := make([]MatchReportResponse, len(reports))
 	for i, r := range reports {
-		result[i] = FromMatchReport(&r)
+		result[i] = FromMatchReport(&r, tz)
 	}
 	return result
 }
+
+type FixtureResponse struct {
+	Round        int    `json:"round"`
+	MatchID      string `json:"match_id,omitempty"` // Empty on dry runs
+	HomeTeamID   string `json:"home_team_id"`
+	HomeTeamName string `json:"home_team_name"`
+	AwayTeamID   string `json:"away_team_id"`
+	AwayTeamName string `json:"away_team_name"`
+	MatchDate    string `json:"match_date"`
+	MatchTime    string `json:"match_time"`
+	KickoffAt    string `json:"kickoff_at"`
+	Timezone     string `json:"timezone"`
+	VenueID      string `json:"venue_id"`
+	VenueName    string `json:"venue_name"`
+}
+
+type FixtureListResponse struct {
+	SeasonID string            `json:"season_id"`
+	DryRun   bool              `json:"dry_run"`
+	Total    int               `json:"total"`
+	Fixtures []FixtureResponse `json:"fixtures"`
+}
+
+func FromFixtures(seasonID string, dryRun bool, fixtures []domain.Fixture, tz string) FixtureListResponse {
+	result := make([]FixtureResponse, len(fixtures))
+	for i, f := range fixtures {
+		kickoff := localKickoff(f.Match.KickoffAt, f.Match.Timezone, tz)
+		result[i] = FixtureResponse{
+			Round:        f.Round,
+			HomeTeamID:   f.Match.HomeTeamID,
+			HomeTeamName: f.Match.HomeTeamName,
+			AwayTeamID:   f.Match.AwayTeamID,
+			AwayTeamName: f.Match.AwayTeamName,
+			MatchDate:    kickoff.date,
+			MatchTime:    kickoff.time,
+			KickoffAt:    kickoff.at,
+			Timezone:     kickoff.timezone,
+			VenueID:      f.Match.VenueID,
+			VenueName:    f.Match.VenueName,
+		}
+		if !dryRun {
+			result[i].MatchID = f.Match.ID
+		}
+	}
+	return FixtureListResponse{
+		SeasonID: seasonID,
+		DryRun:   dryRun,
+		Total:    len(result),
+		Fixtures: result,
+	}
+}
+
+type TieLegResponse struct {
+	MatchID       string `json:"match_id"`
+	MatchDate     string `json:"match_date"`
+	Played        bool   `json:"played"`
+	HomeScore     *int   `json:"home_score"`
+	AwayScore     *int   `json:"away_score"`
+	DecidedBy     string `json:"decided_by,omitempty"`
+	HomePenalties *int   `json:"home_penalties,omitempty"`
+	AwayPenalties *int   `json:"away_penalties,omitempty"`
+}
+
+type TieResponse struct {
+	ID             string          `json:"id"`
+	Slot           int             `json:"slot"`
+	HomeTeamID     string          `json:"home_team_id"`
+	HomeTeamName   string          `json:"home_team_name"`
+	AwayTeamID     string          `json:"away_team_id"`
+	AwayTeamName   string          `json:"away_team_name"`
+	FirstLeg       *TieLegResponse `json:"first_leg"`
+	SecondLeg      *TieLegResponse `json:"second_leg,omitempty"`
+	AggregateHome  int             `json:"aggregate_home"`
+	AggregateAway  int             `json:"aggregate_away"`
+	WinnerTeamID   string          `json:"winner_team_id"`
+	WinnerTeamName string          `json:"winner_team_name"`
+}
+
+type BracketRoundResponse struct {
+	Round int           `json:"round"`
+	Name  string        `json:"name"`
+	Ties  []TieResponse `json:"ties"`
+}
+
+type BracketResponse struct {
+	SeasonID   string                 `json:"season_id"`
+	DrawMethod string                 `json:"draw_method"`
+	Rounds     []BracketRoundResponse `json:"rounds"`
+}
+
+func FromBracket(bracket *domain.Bracket) BracketResponse {
+	rounds := make([]BracketRoundResponse, bracket.Rounds)
+	for i := range rounds {
+		rounds[i] = BracketRoundResponse{
+			Round: i + 1,
+			Name:  bracket.RoundName(i + 1),
+			Ties:  []TieResponse{},
+		}
+	}
+
+	for _, t := range bracket.Ties {
+		aggHome, aggAway := t.Aggregate()
+		rounds[t.Round-1].Ties = append(rounds[t.Round-1].Ties, TieResponse{
+			ID:             t.ID,
+			Slot:           t.Slot,
+			HomeTeamID:     t.HomeTeamID,
+			HomeTeamName:   t.HomeTeamName,
+			AwayTeamID:     t.AwayTeamID,
+			AwayTeamName:   t.AwayTeamName,
+			FirstLeg:       fromTieLeg(t.FirstLeg),
+			SecondLeg:      fromTieLeg(t.SecondLeg),
+			AggregateHome:  aggHome,
+			AggregateAway:  aggAway,
+			WinnerTeamID:   t.WinnerTeamID,
+			WinnerTeamName: t.WinnerTeamName,
+		})
+	}
+
+	return BracketResponse{
+		SeasonID:   bracket.SeasonID,
+		DrawMethod: string(bracket.Method),
+		Rounds:     rounds,
+	}
+}
+
+func fromTieLeg(leg *domain.TieLeg) *TieLegResponse {
+	if leg == nil {
+		return nil
+	}
+	resp := &TieLegResponse{
+		MatchID:   leg.MatchID,
+		MatchDate: leg.MatchDate.Format("2006-01-02"),
+		Played:    leg.Played,
+	}
+	if leg.Played {
+		resp.HomeScore = &leg.HomeScore
+		resp.AwayScore = &leg.AwayScore
+		resp.DecidedBy = string(leg.DecidedBy)
+	}
+	if leg.DecidedBy == domain.DecidedOnPenalties {
+		resp.HomePenalties = &leg.HomePenalties
+		resp.AwayPenalties = &leg.AwayPenalties
+	}
+	return resp
+}
+
+type SuspensionResponse struct {
+	PlayerID    string   `json:"player_id"`
+	PlayerName  string   `json:"player_name"`
+	TeamID      string   `json:"team_id"`
+	SeasonID    string   `json:"season_id"`
+	Reason      string   `json:"reason"`
+	CardMatchID string   `json:"card_match_id"`
+	Length      int      `json:"length"`
+	Served      int      `json:"served"`
+	MatchIDs    []string `json:"match_ids"`
+	Active      bool     `json:"active"`
+}
+
+func FromSuspensions(suspensions []domain.Suspension) []SuspensionResponse {
+	result := make([]SuspensionResponse, len(suspensions))
+	for i, s := range suspensions {
+		matchIDs := s.MatchIDs
+		if matchIDs == nil {
+			matchIDs = []string{}
+		}
+		result[i] = SuspensionResponse{
+			PlayerID:    s.PlayerID,
+			PlayerName:  s.PlayerName,
+			TeamID:      s.TeamID,
+			SeasonID:    s.SeasonID,
+			Reason:      string(s.Reason),
+			CardMatchID: s.CardMatchID,
+			Length:      s.Length,
+			Served:      s.Served,
+			MatchIDs:    matchIDs,
+			Active:      s.Active(),
+		}
+	}
+	return result
+}
+
+type LineupPlayerResponse struct {
+	PlayerID     string `json:"player_id"`
+	PlayerName   string `json:"player_name"`
+	Position     string `json:"position"`
+	JerseyNumber int    `json:"jersey_number"`
+}
+
+type SubstitutionResponse struct {
+	ID            string `json:"id"`
+	Minute        int    `json:"minute"`
+	PlayerOffID   string `json:"player_off_id"`
+	PlayerOffName string `json:"player_off_name"`
+	PlayerOnID    string `json:"player_on_id"`
+	PlayerOnName  string `json:"player_on_name"`
+}
+
+type LineupResponse struct {
+	ID            string                 `json:"id"`
+	MatchID       string                 `json:"match_id"`
+	TeamID        string                 `json:"team_id"`
+	Starters      []LineupPlayerResponse `json:"starters"`
+	Bench         []LineupPlayerResponse `json:"bench"`
+	Substitutions []SubstitutionResponse `json:"substitutions"`
+	SubmittedBy   string                 `json:"submitted_by"`
+	CreatedAt     string                 `json:"created_at"`
+}
+
+func FromLineup(lineup *domain.Lineup) LineupResponse {
+	starters := []LineupPlayerResponse{}
+	bench := []LineupPlayerResponse{}
+	for _, p := range lineup.Players {
+		player := LineupPlayerResponse{
+			PlayerID:     p.PlayerID,
+			PlayerName:   p.PlayerName,
+			Position:     p.Position,
+			JerseyNumber: p.JerseyNumber,
+		}
+		if p.Starter {
+			starters = append(starters, player)
+		} else {
+			bench = append(bench, player)
+		}
+	}
+
+	substitutions := make([]SubstitutionResponse, len(lineup.Substitutions))
+	for i, sub := range lineup.Substitutions {
+		substitutions[i] = SubstitutionResponse{
+			ID:            sub.ID,
+			Minute:        sub.Minute,
+			PlayerOffID:   sub.PlayerOffID,
+			PlayerOffName: sub.PlayerOffName,
+			PlayerOnID:    sub.PlayerOnID,
+			PlayerOnName:  sub.PlayerOnName,
+		}
+	}
+
+	return LineupResponse{
+		ID:            lineup.ID,
+		MatchID:       lineup.MatchID,
+		TeamID:        lineup.TeamID,
+		Starters:      starters,
+		Bench:         bench,
+		Substitutions: substitutions,
+		SubmittedBy:   lineup.SubmittedBy,
+		CreatedAt:     lineup.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func FromLineups(lineups []domain.Lineup) []LineupResponse {
+	result := make([]LineupResponse, len(lineups))
+	for i := range lineups {
+		result[i] = FromLineup(&lineups[i])
+	}
+	return result
+}
+
+type LiveEventResponse struct {
+	ID         int64  `json:"id"`
+	MatchID    string `json:"match_id"`
+	Type       string `json:"type"`
+	Minute     int    `json:"minute"`
+	TeamID     string `json:"team_id,omitempty"`
+	PlayerID   string `json:"player_id,omitempty"`
+	PlayerInID string `json:"player_in_id,omitempty"`
+	CardType   string `json:"card_type,omitempty"`
+	Note       string `json:"note,omitempty"`
+	HomeScore  int    `json:"home_score"`
+	AwayScore  int    `json:"away_score"`
+	CreatedAt  string `json:"created_at"`
+}
+
+func FromLiveEvent(event *domain.LiveEvent) LiveEventResponse {
+	return LiveEventResponse{
+		ID:         event.Sequence,
+		MatchID:    event.MatchID,
+		Type:       string(event.Type),
+		Minute:     event.Minute,
+		TeamID:     event.TeamID,
+		PlayerID:   event.PlayerID,
+		PlayerInID: event.PlayerInID,
+		CardType:   string(event.CardType),
+		Note:       event.Note,
+		HomeScore:  event.HomeScore,
+		AwayScore:  event.AwayScore,
+		CreatedAt:  event.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
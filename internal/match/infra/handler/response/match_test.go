@@ -0,0 +1,44 @@
+package response
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+)
+
+func fixtures() []domain.Fixture {
+	kickoff := time.Date(2025, time.August, 9, 12, 0, 0, 0, time.UTC)
+	return []domain.Fixture{
+		{Round: 1, Match: &domain.Match{ID: "match-1", HomeTeamID: "team-1", AwayTeamID: "team-2", KickoffAt: kickoff, Timezone: "Asia/Jakarta"}},
+		{Round: 1, Match: &domain.Match{ID: "match-2", HomeTeamID: "team-3", AwayTeamID: "team-4", KickoffAt: kickoff, Timezone: "Asia/Jakarta"}},
+	}
+}
+
+func TestFromFixtures_DryRunLeavesOutMatchIDs(t *testing.T) {
+	// When
+	resp := FromFixtures("season-1", true, fixtures(), "")
+
+	// Then
+	if !resp.DryRun || resp.Total != 2 {
+		t.Fatalf("expected a dry run of 2 fixtures, got dry run %v with %d", resp.DryRun, resp.Total)
+	}
+	for _, f := range resp.Fixtures {
+		if f.MatchID != "" {
+			t.Errorf("expected no match ID on a dry run, got %q", f.MatchID)
+		}
+	}
+}
+
+func TestFromFixtures_SavedFixturesHaveMatchIDs(t *testing.T) {
+	// When
+	resp := FromFixtures("season-1", false, fixtures(), "")
+
+	// Then
+	if resp.Fixtures[0].MatchID != "match-1" || resp.Fixtures[1].MatchID != "match-2" {
+		t.Fatalf("expected the IDs of the saved matches, got %q and %q", resp.Fixtures[0].MatchID, resp.Fixtures[1].MatchID)
+	}
+	if resp.Fixtures[0].MatchDate != "2025-08-09" || resp.Fixtures[0].MatchTime != "19:00" {
+		t.Errorf("expected kickoff on the venue's clock, got %s %s", resp.Fixtures[0].MatchDate, resp.Fixtures[0].MatchTime)
+	}
+}
@@ -0,0 +1,70 @@
+package response
+
+import (
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+)
+
+type OfficialResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func FromOfficial(official *domain.Official) OfficialResponse {
+	return OfficialResponse{
+		ID:        official.ID,
+		Name:      official.Name,
+		Email:     official.Email,
+		CreatedAt: official.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: official.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func FromOfficials(officials []domain.Official) []OfficialResponse {
+	result := make([]OfficialResponse, len(officials))
+	for i, o := range officials {
+		result[i] = FromOfficial(&o)
+	}
+	return result
+}
+
+// MatchOfficialResponse is an official as shown on a match, without their contact details.
+type MatchOfficialResponse struct {
+	OfficialID string `json:"official_id"`
+	Name       string `json:"name"`
+	Role       string `json:"role"`
+}
+
+func FromMatchOfficial(official *domain.MatchOfficial) MatchOfficialResponse {
+	return MatchOfficialResponse{
+		OfficialID: official.OfficialID,
+		Name:       official.OfficialName,
+		Role:       string(official.Role),
+	}
+}
+
+func FromMatchOfficials(officials []domain.MatchOfficial) []MatchOfficialResponse {
+	result := make([]MatchOfficialResponse, len(officials))
+	for i, o := range officials {
+		result[i] = FromMatchOfficial(&o)
+	}
+	return result
+}
+
+type OfficialCredentialResponse struct {
+	Token     string `json:"token"`
+	Scope     string `json:"scope"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+func FromOfficialCredential(token, scope string, expiresAt time.Time) OfficialCredentialResponse {
+	return OfficialCredentialResponse{
+		Token:     token,
+		Scope:     scope,
+		ExpiresAt: expiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
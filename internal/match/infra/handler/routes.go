@@ -1,23 +1,77 @@
 package handler
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/http/middleware/force"
+	"github.com/gin-gonic/gin"
+)
 
 // RegisterRoutes registers all Match Context routes.
-// Write routes (POST) are protected by the auth middleware.
+// Write routes (POST, PUT, DELETE) are protected by the auth middleware.
+// Restoring deleted matches and posting live events additionally require the admin middleware,
+// as does forcing a schedule that clashes with other matches (?force=true).
 // Read routes (GET) are public.
-func RegisterRoutes(rg *gin.RouterGroup, matchHandler *MatchHandler, authMiddleware ...gin.HandlerFunc) {
-	matches := rg.Group("/matches")
+// Kickoff times are rendered in the venue's time zone, or in the one asked for with ?tz=.
+// Results are submitted behind the result middleware, which also accepts the credential
+// issued to an official of the match; issuing such credentials is admin only.
+func RegisterRoutes(rg *gin.RouterGroup, matchHandler *MatchHandler, officialHandler *OfficialHandler, adminMiddleware, resultMiddleware gin.HandlerFunc, authMiddleware ...gin.HandlerFunc) {
+	forceMiddleware := force.RequireAdmin(adminMiddleware)
+
+	matches := rg.Group("/matches", validTimezone)
 	{
 		// Public (read-only)
 		matches.GET("", matchHandler.GetAllMatches)
 		matches.GET("/:id", matchHandler.GetMatchByID)
 		matches.GET("/:id/report", matchHandler.GetMatchReport)
+		matches.GET("/:id/reschedules", matchHandler.GetMatchReschedules)
+		matches.GET("/:id/result/revisions", matchHandler.GetResultRevisions)
+		matches.GET("/:id/lineups", matchHandler.GetLineups)
+		matches.GET("/:id/live", matchHandler.FollowLive) // Server-Sent Events
 
 		// Protected (write) — middleware applied per-route
-		matches.POST("", append(authMiddleware, matchHandler.CreateMatch)...)
-		matches.POST("/:id/result", append(authMiddleware, matchHandler.ReportResult)...)
+		matches.POST("", append(authMiddleware, forceMiddleware, matchHandler.CreateMatch)...)
+		matches.PUT("/:id", append(authMiddleware, forceMiddleware, matchHandler.UpdateMatch)...)
+		matches.POST("/:id/result", resultMiddleware, officialHandler.AssignedOfficial, matchHandler.ReportResult)
+		matches.PUT("/:id/result", append(authMiddleware, matchHandler.AmendResult)...)
+		matches.POST("/:id/result/void", append(authMiddleware, matchHandler.VoidResult)...)
+		matches.PUT("/:id/lineups", append(authMiddleware, matchHandler.SubmitLineup)...)
+		matches.POST("/:id/substitutions", append(authMiddleware, matchHandler.AddSubstitution)...)
+		matches.POST("/:id/start", append(authMiddleware, matchHandler.StartMatch)...)
+		matches.POST("/:id/postpone", append(authMiddleware, forceMiddleware, matchHandler.PostponeMatch)...)
+		matches.POST("/:id/cancel", append(authMiddleware, matchHandler.CancelMatch)...)
+		matches.POST("/:id/abandon", append(authMiddleware, matchHandler.AbandonMatch)...)
+		matches.DELETE("/:id", append(authMiddleware, matchHandler.DeleteMatch)...)
+		matches.POST("/:id/officials", append(authMiddleware, officialHandler.Assign)...)
+		matches.DELETE("/:id/officials/:official_id", append(authMiddleware, officialHandler.Unassign)...)
+
+		// Admin only
+		matches.POST("/:id/restore", append(authMiddleware, adminMiddleware, matchHandler.RestoreMatch)...)
+		matches.POST("/:id/live", append(authMiddleware, adminMiddleware, matchHandler.PostLiveEvent)...)
+		matches.POST("/:id/officials/:official_id/credential", append(authMiddleware, adminMiddleware, officialHandler.IssueCredential)...)
+	}
+
+	officials := rg.Group("/officials")
+	{
+		// Public (read-only)
+		officials.GET("", officialHandler.GetAll)
+		officials.GET("/:id", officialHandler.GetByID)
+
+		// Protected (write)
+		officials.POST("", append(authMiddleware, officialHandler.Create)...)
+		officials.PUT("/:id", append(authMiddleware, officialHandler.Update)...)
+		officials.DELETE("/:id", append(authMiddleware, officialHandler.Delete)...)
 	}
 
+	// Fixture generation and knockout draws for a whole season (protected)
+	rg.POST("/seasons/:id/fixtures", append(authMiddleware, validTimezone, matchHandler.GenerateFixtures)...)
+	rg.POST("/seasons/:id/draw", append(authMiddleware, matchHandler.DrawKnockout)...)
+
+	// Knockout bracket (public, read-only)
+	rg.GET("/competitions/:id/bracket", matchHandler.GetBracket)
+
+	// Suspensions earned from cards (public, read-only)
+	rg.GET("/players/:id/suspensions", matchHandler.GetPlayerSuspensions)
+	rg.GET("/teams/:id/suspended-players", matchHandler.GetSuspendedPlayers)
+
 	// Reports (public, read-only)
-	rg.GET("/reports/matches", matchHandler.GetAllMatchReports)
+	rg.GET("/reports/matches", validTimezone, matchHandler.GetAllMatchReports)
 }
@@ -0,0 +1,64 @@
+package live
+
+import (
+	"sync"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it is dropped.
+const subscriberBuffer = 32
+
+// broker fans events out to subscribers within this process. A client that is dropped or
+// connects to another instance catches up from the repository using the last event it saw.
+type broker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan domain.LiveEvent]struct{}
+}
+
+func NewBroker() domain.LiveEventBroker {
+	return &broker{subscribers: make(map[string]map[chan domain.LiveEvent]struct{})}
+}
+
+func (b *broker) Publish(event domain.LiveEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[event.MatchID] {
+		select {
+		case ch <- event:
+		default:
+			// Never block the publisher on a slow client
+			b.remove(event.MatchID, ch)
+		}
+	}
+}
+
+func (b *broker) Subscribe(matchID string) (<-chan domain.LiveEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan domain.LiveEvent, subscriberBuffer)
+	if b.subscribers[matchID] == nil {
+		b.subscribers[matchID] = make(map[chan domain.LiveEvent]struct{})
+	}
+	b.subscribers[matchID][ch] = struct{}{}
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(matchID, ch)
+	}
+}
+
+// remove closes and forgets a subscriber. It is safe to call more than once. b.mu must be held.
+func (b *broker) remove(matchID string, ch chan domain.LiveEvent) {
+	if _, ok := b.subscribers[matchID][ch]; !ok {
+		return
+	}
+	delete(b.subscribers[matchID], ch)
+	close(ch)
+	if len(b.subscribers[matchID]) == 0 {
+		delete(b.subscribers, matchID)
+	}
+}
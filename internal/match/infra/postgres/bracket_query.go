@@ -0,0 +1,65 @@
+package postgres
+
+const (
+	queryInsertBracket = `
+		INSERT INTO knockout_brackets (season_id, draw_method, start_date, round_interval_days, leg_interval_days, match_time, rounds, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	queryInsertTie = `
+		INSERT INTO knockout_ties (id, season_id, round, slot, home_team_id, away_team_id, first_leg_match_id, second_leg_match_id, winner_team_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''), $10, $11)
+	`
+
+	queryUpdateTie = `
+		UPDATE knockout_ties
+		SET home_team_id = NULLIF($1, ''), away_team_id = NULLIF($2, ''), first_leg_match_id = NULLIF($3, ''),
+			second_leg_match_id = NULLIF($4, ''), winner_team_id = NULLIF($5, ''), updated_at = $6
+		WHERE id = $7
+	`
+
+	queryFindBracketBySeasonID = `
+		SELECT season_id, draw_method, start_date, round_interval_days, leg_interval_days, match_time, rounds, created_at
+		FROM knockout_brackets
+		WHERE season_id = $1
+	`
+
+	queryExistsBracketBySeasonID = `
+		SELECT EXISTS(SELECT 1 FROM knockout_brackets WHERE season_id = $1)
+	`
+
+	queryFindTiesBySeasonID = `
+		SELECT t.id, t.season_id, t.round, t.slot,
+			COALESCE(t.home_team_id, '') AS home_team_id, COALESCE(ht.name, '') AS home_team_name,
+			COALESCE(t.away_team_id, '') AS away_team_id, COALESCE(at.name, '') AS away_team_name,
+			COALESCE(t.winner_team_id, '') AS winner_team_id, COALESCE(wt.name, '') AS winner_team_name,
+			COALESCE(t.first_leg_match_id, '') AS first_leg_match_id,
+			(m1.kickoff_at AT TIME ZONE COALESCE(v1.timezone, $2))::date AS first_leg_date,
+			r1.home_score, r1.away_score, r1.decided_by, r1.home_penalties, r1.away_penalties,
+			COALESCE(t.second_leg_match_id, '') AS second_leg_match_id,
+			(m2.kickoff_at AT TIME ZONE COALESCE(v2.timezone, $2))::date AS second_leg_date,
+			r2.home_score, r2.away_score, r2.decided_by, r2.home_penalties, r2.away_penalties,
+			t.created_at, t.updated_at
+		FROM knockout_ties t
+		LEFT JOIN teams ht ON ht.id = t.home_team_id
+		LEFT JOIN teams at ON at.id = t.away_team_id
+		LEFT JOIN teams wt ON wt.id = t.winner_team_id
+		LEFT JOIN matches m1 ON m1.id = t.first_leg_match_id AND m1.deleted_at IS NULL
+		LEFT JOIN venues v1 ON v1.id = m1.venue_id
+		LEFT JOIN match_results r1 ON r1.match_id = m1.id AND r1.deleted_at IS NULL
+		LEFT JOIN matches m2 ON m2.id = t.second_leg_match_id AND m2.deleted_at IS NULL
+		LEFT JOIN venues v2 ON v2.id = m2.venue_id
+		LEFT JOIN match_results r2 ON r2.match_id = m2.id AND r2.deleted_at IS NULL
+		WHERE t.season_id = $1
+		ORDER BY t.round ASC, t.slot ASC
+	`
+
+	queryFindTieByMatchID = `
+		SELECT id, season_id, round, slot,
+			COALESCE(home_team_id, '') AS home_team_id, COALESCE(away_team_id, '') AS away_team_id,
+			COALESCE(first_leg_match_id, '') AS first_leg_match_id, COALESCE(second_leg_match_id, '') AS second_leg_match_id,
+			COALESCE(winner_team_id, '') AS winner_team_id, created_at, updated_at
+		FROM knockout_ties
+		WHERE first_leg_match_id = $1 OR second_leg_match_id = $1
+	`
+)
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type bracketRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBracketRepository(db *pgxpool.Pool) domain.BracketRepository {
+	return &bracketRepository{db: db}
+}
+
+func (r *bracketRepository) Create(ctx context.Context, bracket *domain.Bracket, matches []*domain.Match) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, queryInsertBracket,
+		bracket.SeasonID,
+		bracket.Method,
+		bracket.StartDate,
+		bracket.RoundIntervalDays,
+		bracket.LegIntervalDays,
+		bracket.MatchTime,
+		bracket.Rounds,
+		bracket.CreatedAt,
+	); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert bracket")
+	}
+
+	// Ties reference their leg matches, so the matches go in first
+	if err := insertMatches(ctx, tx, matches); err != nil {
+		return err
+	}
+
+	for _, tie := range bracket.Ties {
+		if _, err := tx.Exec(ctx, queryInsertTie,
+			tie.ID,
+			tie.SeasonID,
+			tie.Round,
+			tie.Slot,
+			tie.HomeTeamID,
+			tie.AwayTeamID,
+			tie.FirstLegMatchID,
+			tie.SecondLegMatchID,
+			tie.WinnerTeamID,
+			tie.CreatedAt,
+			tie.UpdatedAt,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert tie")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *bracketRepository) FindBySeasonID(ctx context.Context, seasonID string) (*domain.Bracket, error) {
+	var bracket domain.Bracket
+	err := r.db.QueryRow(ctx, queryFindBracketBySeasonID, seasonID).Scan(
+		&bracket.SeasonID,
+		&bracket.Method,
+		&bracket.StartDate,
+		&bracket.RoundIntervalDays,
+		&bracket.LegIntervalDays,
+		&bracket.MatchTime,
+		&bracket.Rounds,
+		&bracket.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrBracketNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrBracketNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find bracket")
+	}
+
+	rows, err := r.db.Query(ctx, queryFindTiesBySeasonID, seasonID, domain.DefaultTimezone)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query ties")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			tie                 domain.Tie
+			firstLeg, secondLeg legRow
+		)
+		if err := rows.Scan(
+			&tie.ID,
+			&tie.SeasonID,
+			&tie.Round,
+			&tie.Slot,
+			&tie.HomeTeamID,
+			&tie.HomeTeamName,
+			&tie.AwayTeamID,
+			&tie.AwayTeamName,
+			&tie.WinnerTeamID,
+			&tie.WinnerTeamName,
+			&tie.FirstLegMatchID,
+			&firstLeg.date,
+			&firstLeg.homeScore,
+			&firstLeg.awayScore,
+			&firstLeg.decidedBy,
+			&firstLeg.homePenalties,
+			&firstLeg.awayPenalties,
+			&tie.SecondLegMatchID,
+			&secondLeg.date,
+			&secondLeg.homeScore,
+			&secondLeg.awayScore,
+			&secondLeg.decidedBy,
+			&secondLeg.homePenalties,
+			&secondLeg.awayPenalties,
+			&tie.CreatedAt,
+			&tie.UpdatedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan tie row")
+		}
+		tie.FirstLeg = firstLeg.toTieLeg(tie.FirstLegMatchID)
+		tie.SecondLeg = secondLeg.toTieLeg(tie.SecondLegMatchID)
+		bracket.Ties = append(bracket.Ties, &tie)
+	}
+
+	return &bracket, nil
+}
+
+func (r *bracketRepository) ExistsBySeasonID(ctx context.Context, seasonID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsBracketBySeasonID, seasonID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check if bracket exists")
+	}
+	return exists, nil
+}
+
+func (r *bracketRepository) FindTieByMatchID(ctx context.Context, matchID string) (*domain.Tie, error) {
+	var tie domain.Tie
+	err := r.db.QueryRow(ctx, queryFindTieByMatchID, matchID).Scan(
+		&tie.ID,
+		&tie.SeasonID,
+		&tie.Round,
+		&tie.Slot,
+		&tie.HomeTeamID,
+		&tie.AwayTeamID,
+		&tie.FirstLegMatchID,
+		&tie.SecondLegMatchID,
+		&tie.WinnerTeamID,
+		&tie.CreatedAt,
+		&tie.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrTieNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrTieNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find tie")
+	}
+	return &tie, nil
+}
+
+// advanceBracket saves the ties a decided tie changed and the next-round matches it scheduled.
+func advanceBracket(ctx context.Context, tx pgx.Tx, advancement *domain.Advancement) error {
+	if advancement == nil {
+		return nil
+	}
+
+	if err := insertMatches(ctx, tx, advancement.Matches); err != nil {
+		return err
+	}
+
+	for _, tie := range advancement.Ties {
+		if _, err := tx.Exec(ctx, queryUpdateTie,
+			tie.HomeTeamID,
+			tie.AwayTeamID,
+			tie.FirstLegMatchID,
+			tie.SecondLegMatchID,
+			tie.WinnerTeamID,
+			tie.UpdatedAt,
+			tie.ID,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update tie")
+		}
+	}
+
+	return nil
+}
+
+// legRow holds the nullable leg columns of a tie row, which stay empty until the leg is scheduled and played.
+type legRow struct {
+	date          *time.Time
+	homeScore     *int
+	awayScore     *int
+	decidedBy     *domain.DecidedBy
+	homePenalties *int
+	awayPenalties *int
+}
+
+func (l legRow) toTieLeg(matchID string) *domain.TieLeg {
+	if matchID == "" || l.date == nil {
+		return nil
+	}
+	leg := &domain.TieLeg{MatchID: matchID, MatchDate: *l.date}
+	if l.homeScore != nil && l.awayScore != nil {
+		leg.Played = true
+		leg.HomeScore = *l.homeScore
+		leg.AwayScore = *l.awayScore
+		leg.DecidedBy = *l.decidedBy
+		leg.HomePenalties = *l.homePenalties
+		leg.AwayPenalties = *l.awayPenalties
+	}
+	return leg
+}
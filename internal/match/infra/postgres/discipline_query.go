@@ -0,0 +1,24 @@
+package postgres
+
+const (
+	queryFindCardsByPlayer = `
+		SELECT c.id, c.result_id, c.player_id, p.name AS player_name, c.team_id, c.card_type, c.card_minute, c.reason, c.deleted_at,
+			m.id, COALESCE(m.season_id, '') AS season_id
+		FROM match_cards c
+		JOIN match_results mr ON mr.id = c.result_id AND mr.deleted_at IS NULL
+		JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
+		JOIN players p ON p.id = c.player_id
+		WHERE c.player_id = $1 AND c.deleted_at IS NULL
+	`
+
+	queryFindCardsByTeam = `
+		SELECT c.id, c.result_id, c.player_id, p.name AS player_name, c.team_id, c.card_type, c.card_minute, c.reason, c.deleted_at,
+			m.id, COALESCE(m.season_id, '') AS season_id
+		FROM match_cards c
+		JOIN match_results mr ON mr.id = c.result_id AND mr.deleted_at IS NULL
+		JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
+		JOIN players p ON p.id = c.player_id
+		WHERE c.team_id = $1 AND c.deleted_at IS NULL
+			AND ($2 = '' OR m.season_id = $2)
+	`
+)
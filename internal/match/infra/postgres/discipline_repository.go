@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type disciplineRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDisciplineRepository(db *pgxpool.Pool) domain.DisciplineRepository {
+	return &disciplineRepository{db: db}
+}
+
+func (r *disciplineRepository) FindCardsByPlayer(ctx context.Context, playerID string) ([]domain.BookedCard, error) {
+	return r.findCards(ctx, queryFindCardsByPlayer, playerID)
+}
+
+func (r *disciplineRepository) FindCardsByTeam(ctx context.Context, teamID, seasonID string) ([]domain.BookedCard, error) {
+	return r.findCards(ctx, queryFindCardsByTeam, teamID, seasonID)
+}
+
+func (r *disciplineRepository) findCards(ctx context.Context, query string, args ...any) ([]domain.BookedCard, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query booked cards")
+	}
+	defer rows.Close()
+
+	var cards []domain.BookedCard
+	for rows.Next() {
+		var card domain.BookedCard
+		if err := rows.Scan(
+			&card.ID,
+			&card.ResultID,
+			&card.PlayerID,
+			&card.PlayerName,
+			&card.TeamID,
+			&card.Type,
+			&card.Minute,
+			&card.Reason,
+			&card.DeletedAt,
+			&card.MatchID,
+			&card.SeasonID,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan booked card row")
+		}
+		cards = append(cards, card)
+	}
+
+	return cards, nil
+}
@@ -0,0 +1,56 @@
+package postgres
+
+const (
+	querySupersedeLineup = `
+		UPDATE match_lineups SET deleted_at = NOW()
+		WHERE match_id = $1 AND team_id = $2 AND deleted_at IS NULL
+	`
+
+	queryInsertLineup = `
+		INSERT INTO match_lineups (id, match_id, team_id, submitted_by, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	queryInsertLineupPlayer = `
+		INSERT INTO lineup_players (lineup_id, player_id, position, jersey_number, starter, sort_order)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	queryFindLineupsByMatchID = `
+		SELECT id, match_id, team_id, submitted_by, created_at
+		FROM match_lineups
+		WHERE match_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at ASC
+	`
+
+	queryFindLineupPlayersByMatchID = `
+		SELECT lp.lineup_id, lp.player_id, p.name AS player_name, lp.position, lp.jersey_number, lp.starter
+		FROM lineup_players lp
+		JOIN match_lineups l ON l.id = lp.lineup_id AND l.deleted_at IS NULL
+		JOIN players p ON p.id = lp.player_id
+		WHERE l.match_id = $1
+		ORDER BY lp.sort_order ASC
+	`
+
+	queryFindSubstitutionsByMatchID = `
+		SELECT s.id, s.lineup_id, l.match_id, l.team_id, s.minute,
+			s.player_off_id, poff.name AS player_off_name,
+			s.player_on_id, pon.name AS player_on_name,
+			s.created_at
+		FROM match_substitutions s
+		JOIN match_lineups l ON l.id = s.lineup_id AND l.deleted_at IS NULL
+		JOIN players poff ON poff.id = s.player_off_id
+		JOIN players pon ON pon.id = s.player_on_id
+		WHERE l.match_id = $1
+		ORDER BY s.minute ASC, s.created_at ASC
+	`
+
+	queryLockLineup = `SELECT id FROM match_lineups WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	queryCountSubstitutions = `SELECT COUNT(*) FROM match_substitutions WHERE lineup_id = $1`
+
+	queryInsertSubstitution = `
+		INSERT INTO match_substitutions (id, lineup_id, minute, player_off_id, player_on_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+)
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type lineupRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLineupRepository(db *pgxpool.Pool) domain.LineupRepository {
+	return &lineupRepository{db: db}
+}
+
+func (r *lineupRepository) Save(ctx context.Context, lineup *domain.Lineup) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	// Only one lineup per team and match can be active
+	if _, err := tx.Exec(ctx, querySupersedeLineup, lineup.MatchID, lineup.TeamID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to supersede lineup")
+	}
+
+	if _, err := tx.Exec(ctx, queryInsertLineup,
+		lineup.ID,
+		lineup.MatchID,
+		lineup.TeamID,
+		lineup.SubmittedBy,
+		lineup.CreatedAt,
+	); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert lineup")
+	}
+
+	for i, player := range lineup.Players {
+		if _, err := tx.Exec(ctx, queryInsertLineupPlayer,
+			lineup.ID,
+			player.PlayerID,
+			player.Position,
+			player.JerseyNumber,
+			player.Starter,
+			i,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert lineup player")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *lineupRepository) FindByMatchID(ctx context.Context, matchID string) ([]domain.Lineup, error) {
+	rows, err := r.db.Query(ctx, queryFindLineupsByMatchID, matchID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query lineups")
+	}
+	defer rows.Close()
+
+	var lineups []domain.Lineup
+	index := make(map[string]int)
+	for rows.Next() {
+		var lineup domain.Lineup
+		if err := rows.Scan(
+			&lineup.ID,
+			&lineup.MatchID,
+			&lineup.TeamID,
+			&lineup.SubmittedBy,
+			&lineup.CreatedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan lineup row")
+		}
+		index[lineup.ID] = len(lineups)
+		lineups = append(lineups, lineup)
+	}
+	rows.Close()
+
+	if len(lineups) == 0 {
+		return lineups, nil
+	}
+
+	playerRows, err := r.db.Query(ctx, queryFindLineupPlayersByMatchID, matchID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query lineup players")
+	}
+	defer playerRows.Close()
+
+	for playerRows.Next() {
+		var lineupID string
+		var player domain.LineupPlayer
+		if err := playerRows.Scan(
+			&lineupID,
+			&player.PlayerID,
+			&player.PlayerName,
+			&player.Position,
+			&player.JerseyNumber,
+			&player.Starter,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan lineup player row")
+		}
+		if i, ok := index[lineupID]; ok {
+			lineups[i].Players = append(lineups[i].Players, player)
+		}
+	}
+	playerRows.Close()
+
+	subRows, err := r.db.Query(ctx, queryFindSubstitutionsByMatchID, matchID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query substitutions")
+	}
+	defer subRows.Close()
+
+	for subRows.Next() {
+		var sub domain.Substitution
+		if err := subRows.Scan(
+			&sub.ID,
+			&sub.LineupID,
+			&sub.MatchID,
+			&sub.TeamID,
+			&sub.Minute,
+			&sub.PlayerOffID,
+			&sub.PlayerOffName,
+			&sub.PlayerOnID,
+			&sub.PlayerOnName,
+			&sub.CreatedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan substitution row")
+		}
+		if i, ok := index[sub.LineupID]; ok {
+			lineups[i].Substitutions = append(lineups[i].Substitutions, sub)
+		}
+	}
+
+	return lineups, nil
+}
+
+func (r *lineupRepository) AddSubstitution(ctx context.Context, sub *domain.Substitution, made int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	var lineupID string
+	if err := tx.QueryRow(ctx, queryLockLineup, sub.LineupID).Scan(&lineupID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return derrors.WrapErrorf(domain.ErrSubstitutionClash, derrors.ErrorCodeDuplicate, "%s", domain.ErrSubstitutionClash.Error())
+		}
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to lock lineup")
+	}
+
+	var count int
+	if err := tx.QueryRow(ctx, queryCountSubstitutions, sub.LineupID).Scan(&count); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to count substitutions")
+	}
+	if count != made {
+		return derrors.WrapErrorf(domain.ErrSubstitutionClash, derrors.ErrorCodeDuplicate, "%s", domain.ErrSubstitutionClash.Error())
+	}
+
+	if _, err := tx.Exec(ctx, queryInsertSubstitution,
+		sub.ID,
+		sub.LineupID,
+		sub.Minute,
+		sub.PlayerOffID,
+		sub.PlayerOnID,
+		sub.CreatedAt,
+	); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert substitution")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package postgres
+
+const (
+	queryInsertLiveEvent = `
+		INSERT INTO match_live_events (id, match_id, sequence, event_type, event_minute, team_id, player_id, player_in_id, card_type, note, home_score, away_score, created_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''), $10, $11, $12, $13)
+	`
+
+	queryFindLiveEventsByMatchID = `
+		SELECT id, match_id, sequence, event_type, event_minute, COALESCE(team_id, '') AS team_id, COALESCE(player_id, '') AS player_id,
+			COALESCE(player_in_id, '') AS player_in_id, COALESCE(card_type, '') AS card_type, note, home_score, away_score, created_at
+		FROM match_live_events
+		WHERE match_id = $1 AND sequence > $2
+		ORDER BY sequence ASC
+	`
+)
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const uniqueViolation = "23505"
+
+type liveEventRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLiveEventRepository(db *pgxpool.Pool) domain.LiveEventRepository {
+	return &liveEventRepository{db: db}
+}
+
+func (r *liveEventRepository) Create(ctx context.Context, event *domain.LiveEvent) error {
+	if _, err := r.db.Exec(ctx, queryInsertLiveEvent,
+		event.ID,
+		event.MatchID,
+		event.Sequence,
+		event.Type,
+		event.Minute,
+		event.TeamID,
+		event.PlayerID,
+		event.PlayerInID,
+		event.CardType,
+		event.Note,
+		event.HomeScore,
+		event.AwayScore,
+		event.CreatedAt,
+	); err != nil {
+		// Two events worked out from the same history get the same sequence, only the first one is kept
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return derrors.WrapErrorf(domain.ErrLiveEventConflict, derrors.ErrorCodeDuplicate, "%s", domain.ErrLiveEventConflict.Error())
+		}
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert live event")
+	}
+
+	return nil
+}
+
+func (r *liveEventRepository) FindByMatchID(ctx context.Context, matchID string, afterSequence int64) ([]domain.LiveEvent, error) {
+	rows, err := r.db.Query(ctx, queryFindLiveEventsByMatchID, matchID, afterSequence)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query live events")
+	}
+	defer rows.Close()
+
+	var events []domain.LiveEvent
+	for rows.Next() {
+		var event domain.LiveEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.MatchID,
+			&event.Sequence,
+			&event.Type,
+			&event.Minute,
+			&event.TeamID,
+			&event.PlayerID,
+			&event.PlayerInID,
+			&event.CardType,
+			&event.Note,
+			&event.HomeScore,
+			&event.AwayScore,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan live event row")
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
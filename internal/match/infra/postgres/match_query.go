@@ -2,59 +2,184 @@ package postgres
 
 const (
 	queryInsertMatch = `
-		INSERT INTO matches (id, home_team_id, away_team_id, match_date, match_time, stadium, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO matches (id, season_id, home_team_id, away_team_id, kickoff_at, venue_id, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
 	queryFindMatchByID = `
-		SELECT m.id, m.home_team_id, m.away_team_id, m.match_date, m.match_time, m.stadium, ht.name AS home_team_name, at.name AS away_team_name, m.created_at, m.updated_at, m.deleted_at
+		SELECT m.id, COALESCE(m.season_id, '') AS season_id, COALESCE(s.name, '') AS season_name, m.home_team_id, m.away_team_id, m.kickoff_at, COALESCE(v.timezone, '') AS timezone, COALESCE(m.venue_id, '') AS venue_id, COALESCE(v.name, '') AS venue_name, m.status, COALESCE(m.status_reason, '') AS status_reason, ht.name AS home_team_name, at.name AS away_team_name, m.created_at, m.updated_at, m.deleted_at
 		FROM matches m
 		JOIN teams ht ON ht.id = m.home_team_id
 		JOIN teams at ON at.id = m.away_team_id
+		LEFT JOIN seasons s ON s.id = m.season_id
+		LEFT JOIN venues v ON v.id = m.venue_id
 		WHERE m.id = $1 AND m.deleted_at IS NULL
 	`
 
 	queryFindAllMatches = `
-		SELECT m.id, m.home_team_id, m.away_team_id, m.match_date, m.match_time, m.stadium, ht.name AS home_team_name, at.name AS away_team_name, m.created_at, m.updated_at, m.deleted_at
+		SELECT m.id, COALESCE(m.season_id, '') AS season_id, COALESCE(s.name, '') AS season_name, m.home_team_id, m.away_team_id, m.kickoff_at, COALESCE(v.timezone, '') AS timezone, COALESCE(m.venue_id, '') AS venue_id, COALESCE(v.name, '') AS venue_name, m.status, COALESCE(m.status_reason, '') AS status_reason, ht.name AS home_team_name, at.name AS away_team_name, m.created_at, m.updated_at, m.deleted_at
+		FROM matches m
+		JOIN teams ht ON ht.id = m.home_team_id
+		JOIN teams at ON at.id = m.away_team_id
+		LEFT JOIN seasons s ON s.id = m.season_id
+		LEFT JOIN venues v ON v.id = m.venue_id
+		WHERE m.deleted_at IS NULL
+			AND ($1 = '' OR m.season_id = $1)
+			AND (cardinality($2::text[]) = 0 OR m.status = ANY($2))
+			AND ($3 = '' OR m.home_team_id = $3 OR m.away_team_id = $3)
+		ORDER BY m.kickoff_at DESC
+	`
+
+	queryFindNearbyMatches = `
+		SELECT m.id, COALESCE(m.season_id, '') AS season_id, COALESCE(s.name, '') AS season_name, m.home_team_id, m.away_team_id, m.kickoff_at, COALESCE(v.timezone, '') AS timezone, COALESCE(m.venue_id, '') AS venue_id, COALESCE(v.name, '') AS venue_name, m.status, COALESCE(m.status_reason, '') AS status_reason, ht.name AS home_team_name, at.name AS away_team_name, m.created_at, m.updated_at, m.deleted_at
 		FROM matches m
 		JOIN teams ht ON ht.id = m.home_team_id
 		JOIN teams at ON at.id = m.away_team_id
+		LEFT JOIN seasons s ON s.id = m.season_id
+		LEFT JOIN venues v ON v.id = m.venue_id
 		WHERE m.deleted_at IS NULL
-		ORDER BY m.match_date DESC, m.match_time DESC
+			AND m.id <> $1
+			AND m.kickoff_at BETWEEN $2 AND $3
+			AND (m.home_team_id IN ($4, $5) OR m.away_team_id IN ($4, $5) OR m.venue_id = $6)
+		ORDER BY m.kickoff_at
 	`
 
 	queryUpdateMatch = `
 		UPDATE matches
-		SET home_team_id = $1, away_team_id = $2, match_date = $3, match_time = $4, stadium = $5, updated_at = $6
-		WHERE id = $7 AND deleted_at IS NULL
+		SET home_team_id = $1, away_team_id = $2, kickoff_at = $3, venue_id = $4,
+			status = $5, status_reason = NULLIF($6, ''), updated_at = $7
+		WHERE id = $8 AND deleted_at IS NULL
+	`
+
+	queryInsertMatchReschedule = `
+		INSERT INTO match_reschedules (id, match_id, previous_kickoff_at, previous_venue_id, new_kickoff_at, new_venue_id, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	queryFindReschedulesByMatchID = `
+		SELECT r.id, r.match_id, r.previous_kickoff_at, COALESCE(pv.timezone, '') AS previous_timezone,
+			COALESCE(r.previous_venue_id, '') AS previous_venue_id, COALESCE(pv.name, '') AS previous_venue_name,
+			r.new_kickoff_at, COALESCE(nv.timezone, '') AS new_timezone,
+			COALESCE(r.new_venue_id, '') AS new_venue_id, COALESCE(nv.name, '') AS new_venue_name,
+			r.reason, r.created_at
+		FROM match_reschedules r
+		LEFT JOIN venues pv ON pv.id = r.previous_venue_id
+		LEFT JOIN venues nv ON nv.id = r.new_venue_id
+		WHERE r.match_id = $1
+		ORDER BY r.created_at DESC
+	`
+
+	queryExistsMatchBySeasonID = `
+		SELECT EXISTS(SELECT 1 FROM matches WHERE season_id = $1 AND deleted_at IS NULL)
 	`
 
 	queryDeleteMatch = `UPDATE matches SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
 	queryInsertMatchResult = `
-		INSERT INTO match_results (id, match_id, home_score, away_score)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO match_results (id, match_id, home_score, away_score, decided_by, home_penalties, away_penalties)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	queryInsertGoal = `
-		INSERT INTO goals (id, result_id, player_id, team_id, goal_minute)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO goals (id, result_id, player_id, team_id, goal_type, assist_player_id, goal_minute)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7)
+	`
+
+	queryInsertCard = `
+		INSERT INTO match_cards (id, result_id, player_id, team_id, card_type, card_minute, reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	queryInsertAppearance = `
+		INSERT INTO match_appearances (id, result_id, player_id, team_id, started, minute_on, minute_off)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	queryInsertShootoutKick = `
+		INSERT INTO shootout_kicks (id, result_id, kick_order, player_id, team_id, scored)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
 	queryFindResultByMatchID = `
-		SELECT id, match_id, home_score, away_score, deleted_at
+		SELECT id, match_id, home_score, away_score, decided_by, home_penalties, away_penalties, deleted_at
 		FROM match_results
 		WHERE match_id = $1 AND deleted_at IS NULL
 	`
 
 	queryFindGoalsByResultID = `
-		SELECT g.id, g.result_id, g.player_id, p.name AS player_name, g.team_id, g.goal_minute, g.deleted_at
+		SELECT g.id, g.result_id, g.player_id, p.name AS player_name, g.team_id, g.goal_type,
+			COALESCE(g.assist_player_id, ''), COALESCE(ap.name, '') AS assist_player_name, g.goal_minute, g.deleted_at
 		FROM goals g
 		JOIN players p ON p.id = g.player_id
+		LEFT JOIN players ap ON ap.id = g.assist_player_id
 		WHERE g.result_id = $1 AND g.deleted_at IS NULL
 		ORDER BY g.goal_minute ASC
 	`
 
+	// Cards are keyed by match so that the cards of many matches can be read in one query
+	queryFindCardsByMatchID = `
+		SELECT mr.match_id, c.id, c.result_id, c.player_id, p.name AS player_name, c.team_id, c.card_type, c.card_minute, c.reason, c.deleted_at
+		FROM match_cards c
+		JOIN match_results mr ON mr.id = c.result_id AND mr.deleted_at IS NULL
+		JOIN players p ON p.id = c.player_id
+		WHERE mr.match_id = $1 AND c.deleted_at IS NULL
+		ORDER BY c.card_minute ASC
+	`
+
+	queryFindAllMatchCards = `
+		SELECT mr.match_id, c.id, c.result_id, c.player_id, p.name AS player_name, c.team_id, c.card_type, c.card_minute, c.reason, c.deleted_at
+		FROM match_cards c
+		JOIN match_results mr ON mr.id = c.result_id AND mr.deleted_at IS NULL
+		JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
+		JOIN players p ON p.id = c.player_id
+		WHERE c.deleted_at IS NULL
+		ORDER BY mr.match_id, c.card_minute ASC
+	`
+
+	queryFindShootoutByResultID = `
+		SELECT k.id, k.result_id, k.kick_order, k.player_id, p.name AS player_name, k.team_id, k.scored, k.deleted_at
+		FROM shootout_kicks k
+		JOIN players p ON p.id = k.player_id
+		WHERE k.result_id = $1 AND k.deleted_at IS NULL
+		ORDER BY k.kick_order ASC
+	`
+
+	queryFinishMatch = `
+		UPDATE matches SET status = 'finished', updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	querySupersedeResult      = `UPDATE match_results SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+	querySupersedeGoals       = `UPDATE goals SET deleted_at = NOW() WHERE result_id = $1 AND deleted_at IS NULL`
+	querySupersedeShootout    = `UPDATE shootout_kicks SET deleted_at = NOW() WHERE result_id = $1 AND deleted_at IS NULL`
+	querySupersedeCards       = `UPDATE match_cards SET deleted_at = NOW() WHERE result_id = $1 AND deleted_at IS NULL`
+	querySupersedeAppearances = `UPDATE match_appearances SET deleted_at = NOW() WHERE result_id = $1 AND deleted_at IS NULL`
+
+	queryInsertResultRevision = `
+		INSERT INTO result_revisions (id, match_id, result_id, action, changed_by, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	queryFindResultRevisionsByMatchID = `
+		SELECT rr.id, rr.match_id, rr.action, rr.changed_by, rr.reason, rr.created_at,
+			mr.id, mr.home_score, mr.away_score, mr.decided_by, mr.home_penalties, mr.away_penalties, mr.deleted_at
+		FROM result_revisions rr
+		JOIN match_results mr ON mr.id = rr.result_id
+		WHERE rr.match_id = $1
+		ORDER BY rr.created_at DESC
+	`
+
+	// Goals of a superseded result are soft-deleted along with it, so they are not filtered out here
+	queryFindRevisionGoalsByResultID = `
+		SELECT g.id, g.result_id, g.player_id, p.name AS player_name, g.team_id, g.goal_type,
+			COALESCE(g.assist_player_id, ''), COALESCE(ap.name, '') AS assist_player_name, g.goal_minute, g.deleted_at
+		FROM goals g
+		JOIN players p ON p.id = g.player_id
+		LEFT JOIN players ap ON ap.id = g.assist_player_id
+		WHERE g.result_id = $1
+		ORDER BY g.goal_minute ASC
+	`
+
 	queryExistsResultByMatchID = `
 		SELECT EXISTS(SELECT 1 FROM match_results WHERE match_id = $1 AND deleted_at IS NULL)
 	`
@@ -62,8 +187,8 @@ const (
 	queryMatchReport = `
 		SELECT
 			m.id AS match_id,
-			TO_CHAR(m.match_date, 'YYYY-MM-DD') AS match_date,
-			m.match_time,
+			m.kickoff_at,
+			COALESCE(v.timezone, '') AS timezone,
 			ht.name AS home_team_name,
 			at.name AS away_team_name,
 			mr.home_score,
@@ -71,29 +196,35 @@ const (
 			CASE
 				WHEN mr.home_score > mr.away_score THEN 'Home Win'
 				WHEN mr.away_score > mr.home_score THEN 'Away Win'
+				WHEN mr.decided_by = 'penalties' AND mr.home_penalties > mr.away_penalties THEN 'Home Win'
+				WHEN mr.decided_by = 'penalties' AND mr.away_penalties > mr.home_penalties THEN 'Away Win'
 				ELSE 'Draw'
 			END AS match_status,
+			mr.decided_by,
+			mr.home_penalties,
+			mr.away_penalties,
 			COALESCE(ts.player_name, '') AS top_scorer,
 			COALESCE(ts.goal_count, 0) AS top_scorer_goals,
 			(SELECT COUNT(*) FROM match_results mr2
 				JOIN matches m2 ON m2.id = mr2.match_id
-				WHERE (m2.home_team_id = m.home_team_id AND mr2.home_score > mr2.away_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
-				   OR (m2.away_team_id = m.home_team_id AND mr2.away_score > mr2.home_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				WHERE (m2.home_team_id = m.home_team_id AND (mr2.home_score > mr2.away_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.home_penalties > mr2.away_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				   OR (m2.away_team_id = m.home_team_id AND (mr2.away_score > mr2.home_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.away_penalties > mr2.home_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
 			) AS home_team_wins,
 			(SELECT COUNT(*) FROM match_results mr2
 				JOIN matches m2 ON m2.id = mr2.match_id
-				WHERE (m2.home_team_id = m.away_team_id AND mr2.home_score > mr2.away_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
-				   OR (m2.away_team_id = m.away_team_id AND mr2.away_score > mr2.home_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				WHERE (m2.home_team_id = m.away_team_id AND (mr2.home_score > mr2.away_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.home_penalties > mr2.away_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				   OR (m2.away_team_id = m.away_team_id AND (mr2.away_score > mr2.home_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.away_penalties > mr2.home_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
 			) AS away_team_wins
 		FROM matches m
 		JOIN teams ht ON ht.id = m.home_team_id AND ht.deleted_at IS NULL
 		JOIN teams at ON at.id = m.away_team_id AND at.deleted_at IS NULL
 		JOIN match_results mr ON mr.match_id = m.id AND mr.deleted_at IS NULL
+		LEFT JOIN venues v ON v.id = m.venue_id
 		LEFT JOIN LATERAL (
 			SELECT p.name AS player_name, COUNT(*) AS goal_count
 			FROM goals g
 			JOIN players p ON p.id = g.player_id AND p.deleted_at IS NULL
-			WHERE g.result_id = mr.id AND g.deleted_at IS NULL
+			WHERE g.result_id = mr.id AND g.deleted_at IS NULL AND g.goal_type <> 'own_goal'
 			GROUP BY p.name
 			ORDER BY goal_count DESC
 			LIMIT 1
@@ -104,8 +235,8 @@ const (
 	queryAllMatchReports = `
 		SELECT
 			m.id AS match_id,
-			TO_CHAR(m.match_date, 'YYYY-MM-DD') AS match_date,
-			m.match_time,
+			m.kickoff_at,
+			COALESCE(v.timezone, '') AS timezone,
 			ht.name AS home_team_name,
 			at.name AS away_team_name,
 			mr.home_score,
@@ -113,35 +244,41 @@ const (
 			CASE
 				WHEN mr.home_score > mr.away_score THEN 'Home Win'
 				WHEN mr.away_score > mr.home_score THEN 'Away Win'
+				WHEN mr.decided_by = 'penalties' AND mr.home_penalties > mr.away_penalties THEN 'Home Win'
+				WHEN mr.decided_by = 'penalties' AND mr.away_penalties > mr.home_penalties THEN 'Away Win'
 				ELSE 'Draw'
 			END AS match_status,
+			mr.decided_by,
+			mr.home_penalties,
+			mr.away_penalties,
 			COALESCE(ts.player_name, '') AS top_scorer,
 			COALESCE(ts.goal_count, 0) AS top_scorer_goals,
 			(SELECT COUNT(*) FROM match_results mr2
 				JOIN matches m2 ON m2.id = mr2.match_id
-				WHERE (m2.home_team_id = m.home_team_id AND mr2.home_score > mr2.away_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
-				   OR (m2.away_team_id = m.home_team_id AND mr2.away_score > mr2.home_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				WHERE (m2.home_team_id = m.home_team_id AND (mr2.home_score > mr2.away_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.home_penalties > mr2.away_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				   OR (m2.away_team_id = m.home_team_id AND (mr2.away_score > mr2.home_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.away_penalties > mr2.home_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
 			) AS home_team_wins,
 			(SELECT COUNT(*) FROM match_results mr2
 				JOIN matches m2 ON m2.id = mr2.match_id
-				WHERE (m2.home_team_id = m.away_team_id AND mr2.home_score > mr2.away_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
-				   OR (m2.away_team_id = m.away_team_id AND mr2.away_score > mr2.home_score AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				WHERE (m2.home_team_id = m.away_team_id AND (mr2.home_score > mr2.away_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.home_penalties > mr2.away_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
+				   OR (m2.away_team_id = m.away_team_id AND (mr2.away_score > mr2.home_score OR (mr2.home_score = mr2.away_score AND mr2.decided_by = 'penalties' AND mr2.away_penalties > mr2.home_penalties)) AND m2.deleted_at IS NULL AND mr2.deleted_at IS NULL)
 			) AS away_team_wins
 		FROM matches m
 		JOIN teams ht ON ht.id = m.home_team_id AND ht.deleted_at IS NULL
 		JOIN teams at ON at.id = m.away_team_id AND at.deleted_at IS NULL
 		JOIN match_results mr ON mr.match_id = m.id AND mr.deleted_at IS NULL
+		LEFT JOIN venues v ON v.id = m.venue_id
 		LEFT JOIN LATERAL (
 			SELECT p.name AS player_name, COUNT(*) AS goal_count
 			FROM goals g
 			JOIN players p ON p.id = g.player_id AND p.deleted_at IS NULL
-			WHERE g.result_id = mr.id AND g.deleted_at IS NULL
+			WHERE g.result_id = mr.id AND g.deleted_at IS NULL AND g.goal_type <> 'own_goal'
 			GROUP BY p.name
 			ORDER BY goal_count DESC
 			LIMIT 1
 		) ts ON TRUE
 		WHERE m.deleted_at IS NULL
-		ORDER BY m.match_date DESC
+		ORDER BY m.kickoff_at DESC
 	`
 	querySoftDeleteResultByMatchID = `UPDATE match_results SET deleted_at = NOW() WHERE match_id = $1 AND deleted_at IS NULL`
 	querySoftDeleteGoalsByMatchID  = `
@@ -149,4 +286,46 @@ const (
 		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1) 
 		AND deleted_at IS NULL
 	`
+	querySoftDeleteShootoutByMatchID = `
+		UPDATE shootout_kicks SET deleted_at = NOW()
+		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1)
+		AND deleted_at IS NULL
+	`
+	querySoftDeleteCardsByMatchID = `
+		UPDATE match_cards SET deleted_at = NOW()
+		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1)
+		AND deleted_at IS NULL
+	`
+	querySoftDeleteAppearancesByMatchID = `
+		UPDATE match_appearances SET deleted_at = NOW()
+		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1)
+		AND deleted_at IS NULL
+	`
+
+	// Deleting a match stamps the match and its dependents inside one transaction, so they share
+	// the same deleted_at. Restoring only brings back rows with that stamp, leaving anything that
+	// was removed earlier on its own deleted.
+	queryFindMatchDeletedAt     = `SELECT deleted_at FROM matches WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`
+	queryRestoreMatch           = `UPDATE matches SET deleted_at = NULL WHERE id = $1`
+	queryRestoreResultByMatchID = `UPDATE match_results SET deleted_at = NULL WHERE match_id = $1 AND deleted_at = $2`
+	queryRestoreGoalsByMatchID  = `
+		UPDATE goals SET deleted_at = NULL
+		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1)
+		AND deleted_at = $2
+	`
+	queryRestoreShootoutByMatchID = `
+		UPDATE shootout_kicks SET deleted_at = NULL
+		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1)
+		AND deleted_at = $2
+	`
+	queryRestoreCardsByMatchID = `
+		UPDATE match_cards SET deleted_at = NULL
+		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1)
+		AND deleted_at = $2
+	`
+	queryRestoreAppearancesByMatchID = `
+		UPDATE match_appearances SET deleted_at = NULL
+		WHERE result_id IN (SELECT id FROM match_results WHERE match_id = $1)
+		AND deleted_at = $2
+	`
 )
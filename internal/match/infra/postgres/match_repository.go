@@ -3,10 +3,12 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
 	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -21,11 +23,12 @@ func NewMatchRepository(db *pgxpool.Pool) domain.MatchRepository {
 func (r *matchRepository) Create(ctx context.Context, match *domain.Match) error {
 	_, err := r.db.Exec(ctx, queryInsertMatch,
 		match.ID,
+		match.SeasonID,
 		match.HomeTeamID,
 		match.AwayTeamID,
-		match.MatchDate,
-		match.MatchTime,
-		match.Stadium,
+		match.KickoffAt,
+		match.VenueID,
+		match.Status,
 		match.CreatedAt,
 		match.UpdatedAt,
 	)
@@ -35,15 +38,38 @@ func (r *matchRepository) Create(ctx context.Context, match *domain.Match) error
 	return nil
 }
 
+func (r *matchRepository) CreateBatch(ctx context.Context, matches []*domain.Match) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertMatches(ctx, tx, matches); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+
+	return nil
+}
+
 func (r *matchRepository) FindByID(ctx context.Context, id string) (*domain.Match, error) {
 	var match domain.Match
 	err := r.db.QueryRow(ctx, queryFindMatchByID, id).Scan(
 		&match.ID,
+		&match.SeasonID,
+		&match.SeasonName,
 		&match.HomeTeamID,
 		&match.AwayTeamID,
-		&match.MatchDate,
-		&match.MatchTime,
-		&match.Stadium,
+		&match.KickoffAt,
+		&match.Timezone,
+		&match.VenueID,
+		&match.VenueName,
+		&match.Status,
+		&match.StatusReason,
 		&match.HomeTeamName,
 		&match.AwayTeamName,
 		&match.CreatedAt,
@@ -56,26 +82,53 @@ func (r *matchRepository) FindByID(ctx context.Context, id string) (*domain.Matc
 		}
 		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find match")
 	}
+	if err := match.SetKickoff(match.KickoffAt, match.Timezone); err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to read match kickoff")
+	}
 	return &match, nil
 }
 
-func (r *matchRepository) FindAll(ctx context.Context) ([]domain.Match, error) {
-	rows, err := r.db.Query(ctx, queryFindAllMatches)
+func (r *matchRepository) FindAll(ctx context.Context, filter domain.MatchFilter) ([]domain.Match, error) {
+	statuses := make([]string, len(filter.Statuses))
+	for i, s := range filter.Statuses {
+		statuses[i] = string(s)
+	}
+
+	rows, err := r.db.Query(ctx, queryFindAllMatches, filter.SeasonID, statuses, filter.TeamID)
 	if err != nil {
 		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query matches")
 	}
 	defer rows.Close()
 
+	return scanMatches(rows)
+}
+
+func (r *matchRepository) FindNearby(ctx context.Context, match *domain.Match, from, to time.Time) ([]domain.Match, error) {
+	rows, err := r.db.Query(ctx, queryFindNearbyMatches, match.ID, from, to, match.HomeTeamID, match.AwayTeamID, match.VenueID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query nearby matches")
+	}
+	defer rows.Close()
+
+	return scanMatches(rows)
+}
+
+func scanMatches(rows pgx.Rows) ([]domain.Match, error) {
 	var matches []domain.Match
 	for rows.Next() {
 		var match domain.Match
 		if err := rows.Scan(
 			&match.ID,
+			&match.SeasonID,
+			&match.SeasonName,
 			&match.HomeTeamID,
 			&match.AwayTeamID,
-			&match.MatchDate,
-			&match.MatchTime,
-			&match.Stadium,
+			&match.KickoffAt,
+			&match.Timezone,
+			&match.VenueID,
+			&match.VenueName,
+			&match.Status,
+			&match.StatusReason,
 			&match.HomeTeamName,
 			&match.AwayTeamName,
 			&match.CreatedAt,
@@ -84,6 +137,9 @@ func (r *matchRepository) FindAll(ctx context.Context) ([]domain.Match, error) {
 		); err != nil {
 			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan match row")
 		}
+		if err := match.SetKickoff(match.KickoffAt, match.Timezone); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to read match kickoff")
+		}
 		matches = append(matches, match)
 	}
 
@@ -91,21 +147,73 @@ func (r *matchRepository) FindAll(ctx context.Context) ([]domain.Match, error) {
 }
 
 func (r *matchRepository) Update(ctx context.Context, match *domain.Match) error {
-	_, err := r.db.Exec(ctx, queryUpdateMatch,
-		match.HomeTeamID,
-		match.AwayTeamID,
-		match.MatchDate,
-		match.MatchTime,
-		match.Stadium,
-		match.UpdatedAt,
-		match.ID,
+	return updateMatch(ctx, r.db, match)
+}
+
+func (r *matchRepository) Reschedule(ctx context.Context, match *domain.Match, change *domain.MatchReschedule) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateMatch(ctx, tx, match); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, queryInsertMatchReschedule,
+		change.ID,
+		change.MatchID,
+		change.PreviousKickoffAt,
+		change.PreviousVenueID,
+		change.NewKickoffAt,
+		change.NewVenueID,
+		change.Reason,
+		change.CreatedAt,
 	)
 	if err != nil {
-		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update match")
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert match reschedule")
 	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+
 	return nil
 }
 
+func (r *matchRepository) FindReschedules(ctx context.Context, matchID string) ([]domain.MatchReschedule, error) {
+	rows, err := r.db.Query(ctx, queryFindReschedulesByMatchID, matchID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query match reschedules")
+	}
+	defer rows.Close()
+
+	var reschedules []domain.MatchReschedule
+	for rows.Next() {
+		var change domain.MatchReschedule
+		if err := rows.Scan(
+			&change.ID,
+			&change.MatchID,
+			&change.PreviousKickoffAt,
+			&change.PreviousTimezone,
+			&change.PreviousVenueID,
+			&change.PreviousVenueName,
+			&change.NewKickoffAt,
+			&change.NewTimezone,
+			&change.NewVenueID,
+			&change.NewVenueName,
+			&change.Reason,
+			&change.CreatedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan match reschedule row")
+		}
+		reschedules = append(reschedules, change)
+	}
+
+	return reschedules, nil
+}
+
 func (r *matchRepository) Delete(ctx context.Context, id string) error {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
@@ -118,6 +226,21 @@ func (r *matchRepository) Delete(ctx context.Context, id string) error {
 		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete goals")
 	}
 
+	// Soft delete the shootout kicks of the match result
+	if _, err := tx.Exec(ctx, querySoftDeleteShootoutByMatchID, id); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete shootout kicks")
+	}
+
+	// Soft delete the cards shown in the match
+	if _, err := tx.Exec(ctx, querySoftDeleteCardsByMatchID, id); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete cards")
+	}
+
+	// Soft delete the appearances recorded with the match result
+	if _, err := tx.Exec(ctx, querySoftDeleteAppearancesByMatchID, id); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete appearances")
+	}
+
 	// Soft delete the match result
 	if _, err := tx.Exec(ctx, querySoftDeleteResultByMatchID, id); err != nil {
 		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete match result")
@@ -134,3 +257,100 @@ func (r *matchRepository) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (r *matchRepository) Restore(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	var deletedAt time.Time
+	if err := tx.QueryRow(ctx, queryFindMatchDeletedAt, id).Scan(&deletedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return derrors.WrapErrorf(domain.ErrMatchNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrMatchNotFound.Error())
+		}
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find deleted match")
+	}
+
+	if _, err := tx.Exec(ctx, queryRestoreMatch, id); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to restore match")
+	}
+
+	if _, err := tx.Exec(ctx, queryRestoreGoalsByMatchID, id, deletedAt); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to restore goals")
+	}
+
+	if _, err := tx.Exec(ctx, queryRestoreShootoutByMatchID, id, deletedAt); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to restore shootout kicks")
+	}
+
+	if _, err := tx.Exec(ctx, queryRestoreCardsByMatchID, id, deletedAt); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to restore cards")
+	}
+
+	if _, err := tx.Exec(ctx, queryRestoreAppearancesByMatchID, id, deletedAt); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to restore appearances")
+	}
+
+	if _, err := tx.Exec(ctx, queryRestoreResultByMatchID, id, deletedAt); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to restore match result")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *matchRepository) ExistsBySeasonID(ctx context.Context, seasonID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsMatchBySeasonID, seasonID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check season matches")
+	}
+	return exists, nil
+}
+
+// execer is satisfied by both the pool and a transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func updateMatch(ctx context.Context, db execer, match *domain.Match) error {
+	_, err := db.Exec(ctx, queryUpdateMatch,
+		match.HomeTeamID,
+		match.AwayTeamID,
+		match.KickoffAt,
+		match.VenueID,
+		match.Status,
+		match.StatusReason,
+		match.UpdatedAt,
+		match.ID,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update match")
+	}
+	return nil
+}
+
+// insertMatches writes a batch of matches inside an existing transaction.
+func insertMatches(ctx context.Context, tx pgx.Tx, matches []*domain.Match) error {
+	for _, match := range matches {
+		if _, err := tx.Exec(ctx, queryInsertMatch,
+			match.ID,
+			match.SeasonID,
+			match.HomeTeamID,
+			match.AwayTeamID,
+			match.KickoffAt,
+			match.VenueID,
+			match.Status,
+			match.CreatedAt,
+			match.UpdatedAt,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert match")
+		}
+	}
+	return nil
+}
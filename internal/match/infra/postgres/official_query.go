@@ -0,0 +1,89 @@
+package postgres
+
+const (
+	queryInsertOfficial = `
+		INSERT INTO officials (id, name, email, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	queryFindOfficialByID = `
+		SELECT id, name, email, created_at, updated_at, deleted_at
+		FROM officials
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	queryFindAllOfficials = `
+		SELECT id, name, email, created_at, updated_at, deleted_at
+		FROM officials
+		WHERE deleted_at IS NULL
+		ORDER BY name
+	`
+
+	queryUpdateOfficial = `
+		UPDATE officials
+		SET name = $1, email = $2, updated_at = $3
+		WHERE id = $4 AND deleted_at IS NULL
+	`
+
+	querySoftDeleteOfficial = `UPDATE officials SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	queryExistsOfficialByEmail = `
+		SELECT EXISTS (
+			SELECT 1 FROM officials
+			WHERE LOWER(email) = LOWER($1) AND id != $2 AND deleted_at IS NULL
+		)
+	`
+
+	queryOfficialHasUpcomingMatches = `
+		SELECT EXISTS (
+			SELECT 1 FROM match_officials mo
+			JOIN matches m ON m.id = mo.match_id AND m.deleted_at IS NULL
+			WHERE mo.official_id = $1 AND m.status IN ('scheduled', 'postponed', 'live')
+		)
+	`
+
+	queryInsertMatchOfficial = `
+		INSERT INTO match_officials (match_id, official_id, role, assigned_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	queryDeleteMatchOfficial = `DELETE FROM match_officials WHERE match_id = $1 AND official_id = $2`
+
+	// Officials who were deleted from the registry afterwards still show on the matches they worked
+	queryFindOfficialsByMatchID = `
+		SELECT mo.match_id, mo.official_id, o.name, o.email, mo.role, mo.assigned_at
+		FROM match_officials mo
+		JOIN officials o ON o.id = mo.official_id
+		WHERE mo.match_id = $1
+		ORDER BY ` + orderByOfficialRole + `, o.name
+	`
+
+	queryFindAllMatchOfficials = `
+		SELECT mo.match_id, mo.official_id, o.name, o.email, mo.role, mo.assigned_at
+		FROM match_officials mo
+		JOIN officials o ON o.id = mo.official_id
+		ORDER BY mo.match_id, ` + orderByOfficialRole + `, o.name
+	`
+
+	queryFindMatchesOfOfficial = `
+		SELECT m.id, COALESCE(m.season_id, '') AS season_id, COALESCE(s.name, '') AS season_name, m.home_team_id, m.away_team_id, m.kickoff_at, COALESCE(v.timezone, '') AS timezone, COALESCE(m.venue_id, '') AS venue_id, COALESCE(v.name, '') AS venue_name, m.status, COALESCE(m.status_reason, '') AS status_reason, ht.name AS home_team_name, at.name AS away_team_name, m.created_at, m.updated_at, m.deleted_at
+		FROM match_officials mo
+		JOIN matches m ON m.id = mo.match_id
+		JOIN teams ht ON ht.id = m.home_team_id
+		JOIN teams at ON at.id = m.away_team_id
+		LEFT JOIN seasons s ON s.id = m.season_id
+		LEFT JOIN venues v ON v.id = m.venue_id
+		WHERE mo.official_id = $1
+			AND m.deleted_at IS NULL
+			AND m.kickoff_at >= $2 AND m.kickoff_at < $3
+		ORDER BY m.kickoff_at
+	`
+
+	orderByOfficialRole = `
+		CASE mo.role
+			WHEN 'referee' THEN 1
+			WHEN 'assistant_referee' THEN 2
+			WHEN 'fourth_official' THEN 3
+			ELSE 4
+		END`
+)
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type officialRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOfficialRepository(db *pgxpool.Pool) domain.OfficialRepository {
+	return &officialRepository{db: db}
+}
+
+func (r *officialRepository) Create(ctx context.Context, official *domain.Official) error {
+	_, err := r.db.Exec(ctx, queryInsertOfficial,
+		official.ID,
+		official.Name,
+		official.Email,
+		official.CreatedAt,
+		official.UpdatedAt,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert official")
+	}
+	return nil
+}
+
+func (r *officialRepository) FindByID(ctx context.Context, id string) (*domain.Official, error) {
+	var official domain.Official
+	err := r.db.QueryRow(ctx, queryFindOfficialByID, id).Scan(
+		&official.ID,
+		&official.Name,
+		&official.Email,
+		&official.CreatedAt,
+		&official.UpdatedAt,
+		&official.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrOfficialNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrOfficialNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find official")
+	}
+	return &official, nil
+}
+
+func (r *officialRepository) FindAll(ctx context.Context) ([]domain.Official, error) {
+	rows, err := r.db.Query(ctx, queryFindAllOfficials)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query officials")
+	}
+	defer rows.Close()
+
+	var officials []domain.Official
+	for rows.Next() {
+		var official domain.Official
+		if err := rows.Scan(
+			&official.ID,
+			&official.Name,
+			&official.Email,
+			&official.CreatedAt,
+			&official.UpdatedAt,
+			&official.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan official row")
+		}
+		officials = append(officials, official)
+	}
+
+	return officials, nil
+}
+
+func (r *officialRepository) Update(ctx context.Context, official *domain.Official) error {
+	_, err := r.db.Exec(ctx, queryUpdateOfficial,
+		official.Name,
+		official.Email,
+		official.UpdatedAt,
+		official.ID,
+	)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to update official")
+	}
+	return nil
+}
+
+func (r *officialRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, querySoftDeleteOfficial, id)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete official")
+	}
+	return nil
+}
+
+func (r *officialRepository) ExistsByEmail(ctx context.Context, email, excludeID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryExistsOfficialByEmail, email, excludeID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check if official exists by email")
+	}
+	return exists, nil
+}
+
+func (r *officialRepository) HasUpcomingMatches(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryOfficialHasUpcomingMatches, id).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check official assignments")
+	}
+	return exists, nil
+}
+
+func (r *officialRepository) Assign(ctx context.Context, assignment *domain.MatchOfficial) error {
+	_, err := r.db.Exec(ctx, queryInsertMatchOfficial,
+		assignment.MatchID,
+		assignment.OfficialID,
+		assignment.Role,
+		assignment.AssignedAt,
+	)
+	if err != nil {
+		// The same official assigned twice at the same time, only the first one is kept
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return derrors.WrapErrorf(domain.ErrOfficialAssigned, derrors.ErrorCodeDuplicate, "%s", domain.ErrOfficialAssigned.Error())
+		}
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert match official")
+	}
+	return nil
+}
+
+func (r *officialRepository) Unassign(ctx context.Context, matchID, officialID string) error {
+	tag, err := r.db.Exec(ctx, queryDeleteMatchOfficial, matchID, officialID)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to delete match official")
+	}
+	if tag.RowsAffected() == 0 {
+		return derrors.WrapErrorf(domain.ErrOfficialNotAssigned, derrors.ErrorCodeNotFound, "%s", domain.ErrOfficialNotAssigned.Error())
+	}
+	return nil
+}
+
+func (r *officialRepository) FindByMatchID(ctx context.Context, matchID string) ([]domain.MatchOfficial, error) {
+	officials, err := findMatchOfficials(ctx, r.db, queryFindOfficialsByMatchID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return officials[matchID], nil
+}
+
+func (r *officialRepository) FindMatches(ctx context.Context, officialID string, from, to time.Time) ([]domain.Match, error) {
+	rows, err := r.db.Query(ctx, queryFindMatchesOfOfficial, officialID, from, to)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query official matches")
+	}
+	defer rows.Close()
+
+	return scanMatches(rows)
+}
+
+// findMatchOfficials runs a query for match officials and groups them by match ID.
+func findMatchOfficials(ctx context.Context, db *pgxpool.Pool, query string, args ...any) (map[string][]domain.MatchOfficial, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query match officials")
+	}
+	defer rows.Close()
+
+	officials := make(map[string][]domain.MatchOfficial)
+	for rows.Next() {
+		var official domain.MatchOfficial
+		if err := rows.Scan(
+			&official.MatchID,
+			&official.OfficialID,
+			&official.OfficialName,
+			&official.OfficialEmail,
+			&official.Role,
+			&official.AssignedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan match official row")
+		}
+		officials[official.MatchID] = append(officials[official.MatchID], official)
+	}
+
+	return officials, nil
+}
@@ -22,13 +22,16 @@ func (r *reportRepository) GetMatchReport(ctx context.Context, matchID string) (
 	var report domain.MatchReportView
 	err := r.db.QueryRow(ctx, queryMatchReport, matchID).Scan(
 		&report.MatchID,
-		&report.MatchDate,
-		&report.MatchTime,
+		&report.KickoffAt,
+		&report.Timezone,
 		&report.HomeTeamName,
 		&report.AwayTeamName,
 		&report.HomeScore,
 		&report.AwayScore,
 		&report.MatchStatus,
+		&report.DecidedBy,
+		&report.HomePenalties,
+		&report.AwayPenalties,
 		&report.TopScorer,
 		&report.TopScorerGoals,
 		&report.HomeTeamWins,
@@ -40,6 +43,19 @@ func (r *reportRepository) GetMatchReport(ctx context.Context, matchID string) (
 		}
 		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to get match report")
 	}
+
+	cards, err := findCards(ctx, r.db, queryFindCardsByMatchID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	report.Cards = cards[matchID]
+
+	officials, err := findMatchOfficials(ctx, r.db, queryFindOfficialsByMatchID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	report.Officials = officials[matchID]
+
 	return &report, nil
 }
 
@@ -55,13 +71,16 @@ func (r *reportRepository) GetAllMatchReports(ctx context.Context) ([]domain.Mat
 		var report domain.MatchReportView
 		if err := rows.Scan(
 			&report.MatchID,
-			&report.MatchDate,
-			&report.MatchTime,
+			&report.KickoffAt,
+			&report.Timezone,
 			&report.HomeTeamName,
 			&report.AwayTeamName,
 			&report.HomeScore,
 			&report.AwayScore,
 			&report.MatchStatus,
+			&report.DecidedBy,
+			&report.HomePenalties,
+			&report.AwayPenalties,
 			&report.TopScorer,
 			&report.TopScorerGoals,
 			&report.HomeTeamWins,
@@ -71,6 +90,20 @@ func (r *reportRepository) GetAllMatchReports(ctx context.Context) ([]domain.Mat
 		}
 		reports = append(reports, report)
 	}
+	rows.Close()
+
+	cards, err := findCards(ctx, r.db, queryFindAllMatchCards)
+	if err != nil {
+		return nil, err
+	}
+	officials, err := findMatchOfficials(ctx, r.db, queryFindAllMatchOfficials)
+	if err != nil {
+		return nil, err
+	}
+	for i := range reports {
+		reports[i].Cards = cards[reports[i].MatchID]
+		reports[i].Officials = officials[reports[i].MatchID]
+	}
 
 	return reports, nil
 }
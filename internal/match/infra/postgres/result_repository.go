@@ -18,36 +18,50 @@ func NewMatchResultRepository(db *pgxpool.Pool) domain.MatchResultRepository {
 	return &matchResultRepository{db: db}
 }
 
-func (r *matchResultRepository) Create(ctx context.Context, result *domain.MatchResult) error {
+func (r *matchResultRepository) Create(ctx context.Context, result *domain.MatchResult, advancement *domain.Advancement) error {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
 	}
 	defer tx.Rollback(ctx)
 
-	// Insert match result
-	_, err = tx.Exec(ctx, queryInsertMatchResult,
-		result.ID,
-		result.MatchID,
-		result.HomeScore,
-		result.AwayScore,
-	)
+	if err := insertResult(ctx, tx, result); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, queryFinishMatch, result.MatchID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to mark match as finished")
+	}
+
+	if err := advanceBracket(ctx, tx, advancement); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *matchResultRepository) Amend(ctx context.Context, revision *domain.ResultRevision, result *domain.MatchResult, advancement *domain.Advancement) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert match result")
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
 	}
+	defer tx.Rollback(ctx)
 
-	// Insert each goal event
-	for _, goal := range result.Goals {
-		_, err = tx.Exec(ctx, queryInsertGoal,
-			goal.ID,
-			goal.ResultID,
-			goal.PlayerID,
-			goal.TeamID,
-			goal.GoalMinute,
-		)
-		if err != nil {
-			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert goal event")
-		}
+	// The old result has to go first, only one result per match can be active
+	if err := supersedeResult(ctx, tx, revision); err != nil {
+		return err
+	}
+
+	if err := insertResult(ctx, tx, result); err != nil {
+		return err
+	}
+
+	if err := advanceBracket(ctx, tx, advancement); err != nil {
+		return err
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -57,6 +71,101 @@ func (r *matchResultRepository) Create(ctx context.Context, result *domain.Match
 	return nil
 }
 
+func (r *matchResultRepository) Void(ctx context.Context, revision *domain.ResultRevision, match *domain.Match) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := supersedeResult(ctx, tx, revision); err != nil {
+		return err
+	}
+
+	if err := updateMatch(ctx, tx, match); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *matchResultRepository) FindRevisions(ctx context.Context, matchID string) ([]domain.ResultRevision, error) {
+	rows, err := r.db.Query(ctx, queryFindResultRevisionsByMatchID, matchID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query result revisions")
+	}
+	defer rows.Close()
+
+	var revisions []domain.ResultRevision
+	for rows.Next() {
+		var revision domain.ResultRevision
+		if err := rows.Scan(
+			&revision.ID,
+			&revision.MatchID,
+			&revision.Action,
+			&revision.ChangedBy,
+			&revision.Reason,
+			&revision.CreatedAt,
+			&revision.Result.ID,
+			&revision.Result.HomeScore,
+			&revision.Result.AwayScore,
+			&revision.Result.DecidedBy,
+			&revision.Result.HomePenalties,
+			&revision.Result.AwayPenalties,
+			&revision.Result.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan result revision row")
+		}
+		revision.Result.MatchID = revision.MatchID
+		revisions = append(revisions, revision)
+	}
+	rows.Close()
+
+	for i := range revisions {
+		goals, err := r.findRevisionGoals(ctx, revisions[i].Result.ID)
+		if err != nil {
+			return nil, err
+		}
+		revisions[i].Result.Goals = goals
+	}
+
+	return revisions, nil
+}
+
+func (r *matchResultRepository) findRevisionGoals(ctx context.Context, resultID string) ([]domain.Goal, error) {
+	rows, err := r.db.Query(ctx, queryFindRevisionGoalsByResultID, resultID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query revision goals")
+	}
+	defer rows.Close()
+
+	var goals []domain.Goal
+	for rows.Next() {
+		var goal domain.Goal
+		if err := rows.Scan(
+			&goal.ID,
+			&goal.ResultID,
+			&goal.PlayerID,
+			&goal.PlayerName,
+			&goal.TeamID,
+			&goal.Type,
+			&goal.AssistPlayerID,
+			&goal.AssistPlayerName,
+			&goal.GoalMinute,
+			&goal.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan goal row")
+		}
+		goals = append(goals, goal)
+	}
+
+	return goals, nil
+}
+
 func (r *matchResultRepository) FindByMatchID(ctx context.Context, matchID string) (*domain.MatchResult, error) {
 	var result domain.MatchResult
 	err := r.db.QueryRow(ctx, queryFindResultByMatchID, matchID).Scan(
@@ -64,6 +173,9 @@ func (r *matchResultRepository) FindByMatchID(ctx context.Context, matchID strin
 		&result.MatchID,
 		&result.HomeScore,
 		&result.AwayScore,
+		&result.DecidedBy,
+		&result.HomePenalties,
+		&result.AwayPenalties,
 		&result.DeletedAt,
 	)
 	if err != nil {
@@ -88,6 +200,9 @@ func (r *matchResultRepository) FindByMatchID(ctx context.Context, matchID strin
 			&goal.PlayerID,
 			&goal.PlayerName,
 			&goal.TeamID,
+			&goal.Type,
+			&goal.AssistPlayerID,
+			&goal.AssistPlayerName,
 			&goal.GoalMinute,
 			&goal.DeletedAt,
 		); err != nil {
@@ -95,10 +210,50 @@ func (r *matchResultRepository) FindByMatchID(ctx context.Context, matchID strin
 		}
 		result.Goals = append(result.Goals, goal)
 	}
+	rows.Close()
+
+	// Fetch cards
+	cards, err := findCards(ctx, r.db, queryFindCardsByMatchID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	result.Cards = cards[matchID]
+
+	// Fetch shootout kicks
+	kickRows, err := r.db.Query(ctx, queryFindShootoutByResultID, result.ID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query shootout kicks")
+	}
+	defer kickRows.Close()
+
+	for kickRows.Next() {
+		var kick domain.ShootoutKick
+		if err := kickRows.Scan(
+			&kick.ID,
+			&kick.ResultID,
+			&kick.Order,
+			&kick.PlayerID,
+			&kick.PlayerName,
+			&kick.TeamID,
+			&kick.Scored,
+			&kick.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan shootout kick row")
+		}
+		result.Shootout = append(result.Shootout, kick)
+	}
 
 	return &result, nil
 }
 
+func (r *matchResultRepository) FindCards(ctx context.Context, matchID string) ([]domain.Card, error) {
+	cards, err := findCards(ctx, r.db, queryFindCardsByMatchID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return cards[matchID], nil
+}
+
 func (r *matchResultRepository) ExistsByMatchID(ctx context.Context, matchID string) (bool, error) {
 	var exists bool
 	err := r.db.QueryRow(ctx, queryExistsResultByMatchID, matchID).Scan(&exists)
@@ -107,3 +262,150 @@ func (r *matchResultRepository) ExistsByMatchID(ctx context.Context, matchID str
 	}
 	return exists, nil
 }
+
+// insertResult writes a result with its goals, cards and shootout kicks inside an existing transaction.
+func insertResult(ctx context.Context, tx pgx.Tx, result *domain.MatchResult) error {
+	if _, err := tx.Exec(ctx, queryInsertMatchResult,
+		result.ID,
+		result.MatchID,
+		result.HomeScore,
+		result.AwayScore,
+		result.DecidedBy,
+		result.HomePenalties,
+		result.AwayPenalties,
+	); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert match result")
+	}
+
+	// Insert each goal event
+	for _, goal := range result.Goals {
+		if _, err := tx.Exec(ctx, queryInsertGoal,
+			goal.ID,
+			goal.ResultID,
+			goal.PlayerID,
+			goal.TeamID,
+			goal.Type,
+			goal.AssistPlayerID,
+			goal.GoalMinute,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert goal event")
+		}
+	}
+
+	// Insert each card
+	for _, card := range result.Cards {
+		if _, err := tx.Exec(ctx, queryInsertCard,
+			card.ID,
+			card.ResultID,
+			card.PlayerID,
+			card.TeamID,
+			card.Type,
+			card.Minute,
+			card.Reason,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert card")
+		}
+	}
+
+	// Insert each appearance
+	for _, appearance := range result.Appearances {
+		if _, err := tx.Exec(ctx, queryInsertAppearance,
+			appearance.ID,
+			appearance.ResultID,
+			appearance.PlayerID,
+			appearance.TeamID,
+			appearance.Started,
+			appearance.MinuteOn,
+			appearance.MinuteOff,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert appearance")
+		}
+	}
+
+	// Insert each shootout kick
+	for _, kick := range result.Shootout {
+		if _, err := tx.Exec(ctx, queryInsertShootoutKick,
+			kick.ID,
+			kick.ResultID,
+			kick.Order,
+			kick.PlayerID,
+			kick.TeamID,
+			kick.Scored,
+		); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert shootout kick")
+		}
+	}
+
+	return nil
+}
+
+// supersedeResult soft-deletes the revised result with its goals and shootout kicks and records the revision.
+func supersedeResult(ctx context.Context, tx pgx.Tx, revision *domain.ResultRevision) error {
+	resultID := revision.Result.ID
+
+	if _, err := tx.Exec(ctx, querySupersedeGoals, resultID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete goals")
+	}
+
+	if _, err := tx.Exec(ctx, querySupersedeShootout, resultID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete shootout kicks")
+	}
+
+	if _, err := tx.Exec(ctx, querySupersedeCards, resultID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete cards")
+	}
+
+	if _, err := tx.Exec(ctx, querySupersedeAppearances, resultID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete appearances")
+	}
+
+	if _, err := tx.Exec(ctx, querySupersedeResult, resultID); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to soft delete match result")
+	}
+
+	if _, err := tx.Exec(ctx, queryInsertResultRevision,
+		revision.ID,
+		revision.MatchID,
+		resultID,
+		revision.Action,
+		revision.ChangedBy,
+		revision.Reason,
+		revision.CreatedAt,
+	); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to insert result revision")
+	}
+
+	return nil
+}
+
+// findCards reads cards of active results, grouped by match ID.
+func findCards(ctx context.Context, db *pgxpool.Pool, query string, args ...any) (map[string][]domain.Card, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query cards")
+	}
+	defer rows.Close()
+
+	cards := make(map[string][]domain.Card)
+	for rows.Next() {
+		var matchID string
+		var card domain.Card
+		if err := rows.Scan(
+			&matchID,
+			&card.ID,
+			&card.ResultID,
+			&card.PlayerID,
+			&card.PlayerName,
+			&card.TeamID,
+			&card.Type,
+			&card.Minute,
+			&card.Reason,
+			&card.DeletedAt,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan card row")
+		}
+		cards[matchID] = append(cards[matchID], card)
+	}
+
+	return cards, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+const (
+	queryFindSeasonByID = `
+		SELECT s.id, s.competition_id, s.name, s.start_date, s.end_date, c.format, c.two_legged, c.away_goals_rule,
+			c.red_card_ban, c.yellow_card_limit, c.yellow_card_ban, c.max_age, c.age_cutoff_date
+		FROM seasons s
+		JOIN competitions c ON c.id = s.competition_id
+		WHERE s.id = $1 AND s.deleted_at IS NULL
+	`
+
+	queryFindLatestSeasonByCompetitionID = `
+		SELECT s.id, s.competition_id, s.name, s.start_date, s.end_date, c.format, c.two_legged, c.away_goals_rule,
+			c.red_card_ban, c.yellow_card_limit, c.yellow_card_ban, c.max_age, c.age_cutoff_date
+		FROM seasons s
+		JOIN competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+		WHERE s.competition_id = $1 AND s.deleted_at IS NULL
+		ORDER BY s.start_date DESC
+		LIMIT 1
+	`
+
+	queryIsTeamRegisteredInSeason = `
+		SELECT EXISTS (
+			SELECT 1 FROM season_teams
+			WHERE season_id = $1 AND team_id = $2
+		)
+	`
+
+	queryFindSeasonTeams = `
+		SELECT t.id, t.name, COALESCE(t.home_venue_id, '') AS home_venue_id, COALESCE(v.name, '') AS home_venue_name,
+			COALESCE(v.timezone, '') AS home_venue_timezone
+		FROM season_teams st
+		JOIN teams t ON t.id = st.team_id AND t.deleted_at IS NULL
+		LEFT JOIN venues v ON v.id = t.home_venue_id
+		WHERE st.season_id = $1
+		ORDER BY st.registered_at ASC, t.name ASC
+	`
+)
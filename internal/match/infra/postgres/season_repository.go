@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type seasonRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSeasonRepository(db *pgxpool.Pool) domain.SeasonRepository {
+	return &seasonRepository{db: db}
+}
+
+func (r *seasonRepository) FindByID(ctx context.Context, id string) (*domain.Season, error) {
+	return r.findOne(ctx, queryFindSeasonByID, id)
+}
+
+func (r *seasonRepository) FindLatestByCompetitionID(ctx context.Context, competitionID string) (*domain.Season, error) {
+	return r.findOne(ctx, queryFindLatestSeasonByCompetitionID, competitionID)
+}
+
+func (r *seasonRepository) findOne(ctx context.Context, query string, arg string) (*domain.Season, error) {
+	var season domain.Season
+	var maxAge *int
+	var cutoffDate *time.Time
+	err := r.db.QueryRow(ctx, query, arg).Scan(
+		&season.ID,
+		&season.CompetitionID,
+		&season.Name,
+		&season.StartDate,
+		&season.EndDate,
+		&season.Format,
+		&season.Rules.TwoLegged,
+		&season.Rules.AwayGoalsRule,
+		&season.Discipline.RedCardBan,
+		&season.Discipline.YellowCardLimit,
+		&season.Discipline.YellowCardBan,
+		&maxAge,
+		&cutoffDate,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrSeasonNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrSeasonNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find season")
+	}
+	if maxAge != nil && cutoffDate != nil {
+		season.AgeLimit = &domain.AgeLimit{MaxAge: *maxAge, CutoffDate: *cutoffDate}
+	}
+	return &season, nil
+}
+
+func (r *seasonRepository) IsTeamRegistered(ctx context.Context, seasonID, teamID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, queryIsTeamRegisteredInSeason, seasonID, teamID).Scan(&exists)
+	if err != nil {
+		return false, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to check season team registration")
+	}
+	return exists, nil
+}
+
+func (r *seasonRepository) FindTeams(ctx context.Context, seasonID string) ([]domain.SeasonTeam, error) {
+	rows, err := r.db.Query(ctx, queryFindSeasonTeams, seasonID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query season teams")
+	}
+	defer rows.Close()
+
+	var teams []domain.SeasonTeam
+	for rows.Next() {
+		var team domain.SeasonTeam
+		if err := rows.Scan(&team.TeamID, &team.TeamName, &team.HomeVenueID, &team.HomeVenueName, &team.HomeVenueTimezone); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan season team row")
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,14 @@
+package postgres
+
+const (
+	// A player is in the squad of a team from the day they joined it until the day they left,
+	// whether they left by transfer or by being removed
+	queryFindSquadPlayers = `
+		SELECT p.id, p.name, s.team_id, p.position, p.jersey_number, p.date_of_birth
+		FROM players p
+		JOIN player_spells s ON s.player_id = p.id
+		WHERE p.id = ANY($1)
+		AND s.joined_on <= $2::date
+		AND (s.left_on IS NULL OR s.left_on > $2::date)
+	`
+)
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type squadRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSquadRepository(db *pgxpool.Pool) domain.SquadRepository {
+	return &squadRepository{db: db}
+}
+
+func (r *squadRepository) FindPlayers(ctx context.Context, playerIDs []string, asOf time.Time) ([]domain.SquadPlayer, error) {
+	rows, err := r.db.Query(ctx, queryFindSquadPlayers, playerIDs, asOf)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query squad players")
+	}
+	defer rows.Close()
+
+	var players []domain.SquadPlayer
+	for rows.Next() {
+		var player domain.SquadPlayer
+		if err := rows.Scan(&player.ID, &player.Name, &player.TeamID, &player.Position, &player.JerseyNumber, &player.DateOfBirth); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan squad player row")
+		}
+		players = append(players, player)
+	}
+
+	return players, nil
+}
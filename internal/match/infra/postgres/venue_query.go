@@ -0,0 +1,17 @@
+package postgres
+
+const (
+	queryFindVenueByID = `SELECT id, name, timezone FROM venues WHERE id = $1 AND deleted_at IS NULL`
+
+	queryFindHomeVenueID = `
+		SELECT COALESCE(t.home_venue_id, '')
+		FROM teams t
+		WHERE t.id = $1 AND t.deleted_at IS NULL
+	`
+
+	queryMoveMatchesToVenue = `UPDATE matches SET venue_id = $2 WHERE venue_id = $1`
+
+	queryMovePreviousReschedulesToVenue = `UPDATE match_reschedules SET previous_venue_id = $2 WHERE previous_venue_id = $1`
+
+	queryMoveNewReschedulesToVenue = `UPDATE match_reschedules SET new_venue_id = $2 WHERE new_venue_id = $1`
+)
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/derrors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type venueRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewVenueRepository(db *pgxpool.Pool) domain.VenueRepository {
+	return &venueRepository{db: db}
+}
+
+func (r *venueRepository) FindByID(ctx context.Context, id string) (*domain.Venue, error) {
+	var venue domain.Venue
+	err := r.db.QueryRow(ctx, queryFindVenueByID, id).Scan(&venue.ID, &venue.Name, &venue.Timezone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, derrors.WrapErrorf(domain.ErrVenueNotFound, derrors.ErrorCodeNotFound, "%s", domain.ErrVenueNotFound.Error())
+		}
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find venue")
+	}
+	return &venue, nil
+}
+
+func (r *venueRepository) FindHomeVenueID(ctx context.Context, teamID string) (string, error) {
+	var venueID string
+	err := r.db.QueryRow(ctx, queryFindHomeVenueID, teamID).Scan(&venueID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// An unknown team is reported when its season registration is checked
+			return "", nil
+		}
+		return "", derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to find home venue")
+	}
+	return venueID, nil
+}
+
+func (r *venueRepository) MoveMatches(ctx context.Context, fromID, toID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	for _, query := range []string{
+		queryMoveMatchesToVenue,
+		queryMovePreviousReschedulesToVenue,
+		queryMoveNewReschedulesToVenue,
+	} {
+		if _, err := tx.Exec(ctx, query, fromID, toID); err != nil {
+			return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to move matches to venue")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to commit transaction")
+	}
+	return nil
+}
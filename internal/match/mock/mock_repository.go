@@ -1,38 +1,48 @@
-// Code generated manually following mockgen patterns.
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/match/domain/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/match/domain/repository.go -destination=internal/match/mock/mock_repository.go -package=mock
+//
 
+// Package mock is a generated GoMock package.
 package mock
 
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/ZyoGo/ayo-indonesia-footbal/internal/match/domain"
 	gomock "go.uber.org/mock/gomock"
 )
 
-// ---------------------------------------------------------------------------
-// MockMatchRepository
-// ---------------------------------------------------------------------------
-
+// MockMatchRepository is a mock of MatchRepository interface.
 type MockMatchRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockMatchRepositoryMockRecorder
+	isgomock struct{}
 }
 
+// MockMatchRepositoryMockRecorder is the mock recorder for MockMatchRepository.
 type MockMatchRepositoryMockRecorder struct {
 	mock *MockMatchRepository
 }
 
+// NewMockMatchRepository creates a new mock instance.
 func NewMockMatchRepository(ctrl *gomock.Controller) *MockMatchRepository {
 	mock := &MockMatchRepository{ctrl: ctrl}
 	mock.recorder = &MockMatchRepositoryMockRecorder{mock}
 	return mock
 }
 
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockMatchRepository) EXPECT() *MockMatchRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
 func (m *MockMatchRepository) Create(ctx context.Context, match *domain.Match) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, match)
@@ -40,11 +50,71 @@ func (m *MockMatchRepository) Create(ctx context.Context, match *domain.Match) e
 	return ret0
 }
 
+// Create indicates an expected call of Create.
 func (mr *MockMatchRepositoryMockRecorder) Create(ctx, match any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMatchRepository)(nil).Create), ctx, match)
 }
 
+// CreateBatch mocks base method.
+func (m *MockMatchRepository) CreateBatch(ctx context.Context, matches []*domain.Match) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, matches)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockMatchRepositoryMockRecorder) CreateBatch(ctx, matches any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockMatchRepository)(nil).CreateBatch), ctx, matches)
+}
+
+// Delete mocks base method.
+func (m *MockMatchRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMatchRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMatchRepository)(nil).Delete), ctx, id)
+}
+
+// ExistsBySeasonID mocks base method.
+func (m *MockMatchRepository) ExistsBySeasonID(ctx context.Context, seasonID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsBySeasonID", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsBySeasonID indicates an expected call of ExistsBySeasonID.
+func (mr *MockMatchRepositoryMockRecorder) ExistsBySeasonID(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsBySeasonID", reflect.TypeOf((*MockMatchRepository)(nil).ExistsBySeasonID), ctx, seasonID)
+}
+
+// FindAll mocks base method.
+func (m *MockMatchRepository) FindAll(ctx context.Context, filter domain.MatchFilter) ([]domain.Match, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.Match)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockMatchRepositoryMockRecorder) FindAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockMatchRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindByID mocks base method.
 func (m *MockMatchRepository) FindByID(ctx context.Context, id string) (*domain.Match, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByID", ctx, id)
@@ -53,83 +123,152 @@ func (m *MockMatchRepository) FindByID(ctx context.Context, id string) (*domain.
 	return ret0, ret1
 }
 
+// FindByID indicates an expected call of FindByID.
 func (mr *MockMatchRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMatchRepository)(nil).FindByID), ctx, id)
 }
 
-func (m *MockMatchRepository) FindAll(ctx context.Context) ([]domain.Match, error) {
+// FindNearby mocks base method.
+func (m *MockMatchRepository) FindNearby(ctx context.Context, match *domain.Match, from, to time.Time) ([]domain.Match, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindNearby", ctx, match, from, to)
 	ret0, _ := ret[0].([]domain.Match)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockMatchRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+// FindNearby indicates an expected call of FindNearby.
+func (mr *MockMatchRepositoryMockRecorder) FindNearby(ctx, match, from, to any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockMatchRepository)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearby", reflect.TypeOf((*MockMatchRepository)(nil).FindNearby), ctx, match, from, to)
 }
 
-func (m *MockMatchRepository) Update(ctx context.Context, match *domain.Match) error {
+// FindReschedules mocks base method.
+func (m *MockMatchRepository) FindReschedules(ctx context.Context, matchID string) ([]domain.MatchReschedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, match)
+	ret := m.ctrl.Call(m, "FindReschedules", ctx, matchID)
+	ret0, _ := ret[0].([]domain.MatchReschedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReschedules indicates an expected call of FindReschedules.
+func (mr *MockMatchRepositoryMockRecorder) FindReschedules(ctx, matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReschedules", reflect.TypeOf((*MockMatchRepository)(nil).FindReschedules), ctx, matchID)
+}
+
+// Reschedule mocks base method.
+func (m *MockMatchRepository) Reschedule(ctx context.Context, match *domain.Match, change *domain.MatchReschedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, match, change)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockMatchRepositoryMockRecorder) Update(ctx, match any) *gomock.Call {
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockMatchRepositoryMockRecorder) Reschedule(ctx, match, change any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMatchRepository)(nil).Update), ctx, match)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockMatchRepository)(nil).Reschedule), ctx, match, change)
 }
 
-func (m *MockMatchRepository) Delete(ctx context.Context, id string) error {
+// Restore mocks base method.
+func (m *MockMatchRepository) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockMatchRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+// Restore indicates an expected call of Restore.
+func (mr *MockMatchRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMatchRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMatchRepository)(nil).Restore), ctx, id)
 }
 
-// ---------------------------------------------------------------------------
-// MockMatchResultRepository
-// ---------------------------------------------------------------------------
+// Update mocks base method.
+func (m *MockMatchRepository) Update(ctx context.Context, match *domain.Match) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, match)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
 
+// Update indicates an expected call of Update.
+func (mr *MockMatchRepositoryMockRecorder) Update(ctx, match any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMatchRepository)(nil).Update), ctx, match)
+}
+
+// MockMatchResultRepository is a mock of MatchResultRepository interface.
 type MockMatchResultRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockMatchResultRepositoryMockRecorder
+	isgomock struct{}
 }
 
+// MockMatchResultRepositoryMockRecorder is the mock recorder for MockMatchResultRepository.
 type MockMatchResultRepositoryMockRecorder struct {
 	mock *MockMatchResultRepository
 }
 
+// NewMockMatchResultRepository creates a new mock instance.
 func NewMockMatchResultRepository(ctrl *gomock.Controller) *MockMatchResultRepository {
 	mock := &MockMatchResultRepository{ctrl: ctrl}
 	mock.recorder = &MockMatchResultRepositoryMockRecorder{mock}
 	return mock
 }
 
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockMatchResultRepository) EXPECT() *MockMatchResultRepositoryMockRecorder {
 	return m.recorder
 }
 
-func (m *MockMatchResultRepository) Create(ctx context.Context, result *domain.MatchResult) error {
+// Amend mocks base method.
+func (m *MockMatchResultRepository) Amend(ctx context.Context, revision *domain.ResultRevision, result *domain.MatchResult, advancement *domain.Advancement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amend", ctx, revision, result, advancement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Amend indicates an expected call of Amend.
+func (mr *MockMatchResultRepositoryMockRecorder) Amend(ctx, revision, result, advancement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amend", reflect.TypeOf((*MockMatchResultRepository)(nil).Amend), ctx, revision, result, advancement)
+}
+
+// Create mocks base method.
+func (m *MockMatchResultRepository) Create(ctx context.Context, result *domain.MatchResult, advancement *domain.Advancement) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, result)
+	ret := m.ctrl.Call(m, "Create", ctx, result, advancement)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockMatchResultRepositoryMockRecorder) Create(ctx, result any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockMatchResultRepositoryMockRecorder) Create(ctx, result, advancement any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMatchResultRepository)(nil).Create), ctx, result)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMatchResultRepository)(nil).Create), ctx, result, advancement)
 }
 
+// ExistsByMatchID mocks base method.
+func (m *MockMatchResultRepository) ExistsByMatchID(ctx context.Context, matchID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByMatchID", ctx, matchID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByMatchID indicates an expected call of ExistsByMatchID.
+func (mr *MockMatchResultRepositoryMockRecorder) ExistsByMatchID(ctx, matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByMatchID", reflect.TypeOf((*MockMatchResultRepository)(nil).ExistsByMatchID), ctx, matchID)
+}
+
+// FindByMatchID mocks base method.
 func (m *MockMatchResultRepository) FindByMatchID(ctx context.Context, matchID string) (*domain.MatchResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByMatchID", ctx, matchID)
@@ -138,69 +277,789 @@ func (m *MockMatchResultRepository) FindByMatchID(ctx context.Context, matchID s
 	return ret0, ret1
 }
 
+// FindByMatchID indicates an expected call of FindByMatchID.
 func (mr *MockMatchResultRepositoryMockRecorder) FindByMatchID(ctx, matchID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMatchID", reflect.TypeOf((*MockMatchResultRepository)(nil).FindByMatchID), ctx, matchID)
 }
 
-func (m *MockMatchResultRepository) ExistsByMatchID(ctx context.Context, matchID string) (bool, error) {
+// FindCards mocks base method.
+func (m *MockMatchResultRepository) FindCards(ctx context.Context, matchID string) ([]domain.Card, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExistsByMatchID", ctx, matchID)
+	ret := m.ctrl.Call(m, "FindCards", ctx, matchID)
+	ret0, _ := ret[0].([]domain.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCards indicates an expected call of FindCards.
+func (mr *MockMatchResultRepositoryMockRecorder) FindCards(ctx, matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCards", reflect.TypeOf((*MockMatchResultRepository)(nil).FindCards), ctx, matchID)
+}
+
+// FindRevisions mocks base method.
+func (m *MockMatchResultRepository) FindRevisions(ctx context.Context, matchID string) ([]domain.ResultRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRevisions", ctx, matchID)
+	ret0, _ := ret[0].([]domain.ResultRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRevisions indicates an expected call of FindRevisions.
+func (mr *MockMatchResultRepositoryMockRecorder) FindRevisions(ctx, matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRevisions", reflect.TypeOf((*MockMatchResultRepository)(nil).FindRevisions), ctx, matchID)
+}
+
+// Void mocks base method.
+func (m *MockMatchResultRepository) Void(ctx context.Context, revision *domain.ResultRevision, match *domain.Match) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, revision, match)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockMatchResultRepositoryMockRecorder) Void(ctx, revision, match any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockMatchResultRepository)(nil).Void), ctx, revision, match)
+}
+
+// MockSquadRepository is a mock of SquadRepository interface.
+type MockSquadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSquadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSquadRepositoryMockRecorder is the mock recorder for MockSquadRepository.
+type MockSquadRepositoryMockRecorder struct {
+	mock *MockSquadRepository
+}
+
+// NewMockSquadRepository creates a new mock instance.
+func NewMockSquadRepository(ctrl *gomock.Controller) *MockSquadRepository {
+	mock := &MockSquadRepository{ctrl: ctrl}
+	mock.recorder = &MockSquadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSquadRepository) EXPECT() *MockSquadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPlayers mocks base method.
+func (m *MockSquadRepository) FindPlayers(ctx context.Context, playerIDs []string, asOf time.Time) ([]domain.SquadPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPlayers", ctx, playerIDs, asOf)
+	ret0, _ := ret[0].([]domain.SquadPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPlayers indicates an expected call of FindPlayers.
+func (mr *MockSquadRepositoryMockRecorder) FindPlayers(ctx, playerIDs, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlayers", reflect.TypeOf((*MockSquadRepository)(nil).FindPlayers), ctx, playerIDs, asOf)
+}
+
+// MockVenueRepository is a mock of VenueRepository interface.
+type MockVenueRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVenueRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVenueRepositoryMockRecorder is the mock recorder for MockVenueRepository.
+type MockVenueRepositoryMockRecorder struct {
+	mock *MockVenueRepository
+}
+
+// NewMockVenueRepository creates a new mock instance.
+func NewMockVenueRepository(ctrl *gomock.Controller) *MockVenueRepository {
+	mock := &MockVenueRepository{ctrl: ctrl}
+	mock.recorder = &MockVenueRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVenueRepository) EXPECT() *MockVenueRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockVenueRepository) FindByID(ctx context.Context, id string) (*domain.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockVenueRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockVenueRepository)(nil).FindByID), ctx, id)
+}
+
+// FindHomeVenueID mocks base method.
+func (m *MockVenueRepository) FindHomeVenueID(ctx context.Context, teamID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHomeVenueID", ctx, teamID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHomeVenueID indicates an expected call of FindHomeVenueID.
+func (mr *MockVenueRepositoryMockRecorder) FindHomeVenueID(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHomeVenueID", reflect.TypeOf((*MockVenueRepository)(nil).FindHomeVenueID), ctx, teamID)
+}
+
+// MoveMatches mocks base method.
+func (m *MockVenueRepository) MoveMatches(ctx context.Context, fromID, toID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMatches", ctx, fromID, toID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMatches indicates an expected call of MoveMatches.
+func (mr *MockVenueRepositoryMockRecorder) MoveMatches(ctx, fromID, toID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMatches", reflect.TypeOf((*MockVenueRepository)(nil).MoveMatches), ctx, fromID, toID)
+}
+
+// MockOfficialRepository is a mock of OfficialRepository interface.
+type MockOfficialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOfficialRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOfficialRepositoryMockRecorder is the mock recorder for MockOfficialRepository.
+type MockOfficialRepositoryMockRecorder struct {
+	mock *MockOfficialRepository
+}
+
+// NewMockOfficialRepository creates a new mock instance.
+func NewMockOfficialRepository(ctrl *gomock.Controller) *MockOfficialRepository {
+	mock := &MockOfficialRepository{ctrl: ctrl}
+	mock.recorder = &MockOfficialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOfficialRepository) EXPECT() *MockOfficialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockOfficialRepository) Assign(ctx context.Context, assignment *domain.MatchOfficial) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, assignment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockOfficialRepositoryMockRecorder) Assign(ctx, assignment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockOfficialRepository)(nil).Assign), ctx, assignment)
+}
+
+// Create mocks base method.
+func (m *MockOfficialRepository) Create(ctx context.Context, official *domain.Official) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, official)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOfficialRepositoryMockRecorder) Create(ctx, official any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOfficialRepository)(nil).Create), ctx, official)
+}
+
+// Delete mocks base method.
+func (m *MockOfficialRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOfficialRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOfficialRepository)(nil).Delete), ctx, id)
+}
+
+// ExistsByEmail mocks base method.
+func (m *MockOfficialRepository) ExistsByEmail(ctx context.Context, email, excludeID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByEmail", ctx, email, excludeID)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockMatchResultRepositoryMockRecorder) ExistsByMatchID(ctx, matchID any) *gomock.Call {
+// ExistsByEmail indicates an expected call of ExistsByEmail.
+func (mr *MockOfficialRepositoryMockRecorder) ExistsByEmail(ctx, email, excludeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByMatchID", reflect.TypeOf((*MockMatchResultRepository)(nil).ExistsByMatchID), ctx, matchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByEmail", reflect.TypeOf((*MockOfficialRepository)(nil).ExistsByEmail), ctx, email, excludeID)
+}
+
+// FindAll mocks base method.
+func (m *MockOfficialRepository) FindAll(ctx context.Context) ([]domain.Official, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.Official)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockOfficialRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOfficialRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockOfficialRepository) FindByID(ctx context.Context, id string) (*domain.Official, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Official)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockOfficialRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOfficialRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByMatchID mocks base method.
+func (m *MockOfficialRepository) FindByMatchID(ctx context.Context, matchID string) ([]domain.MatchOfficial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMatchID", ctx, matchID)
+	ret0, _ := ret[0].([]domain.MatchOfficial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMatchID indicates an expected call of FindByMatchID.
+func (mr *MockOfficialRepositoryMockRecorder) FindByMatchID(ctx, matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMatchID", reflect.TypeOf((*MockOfficialRepository)(nil).FindByMatchID), ctx, matchID)
+}
+
+// FindMatches mocks base method.
+func (m *MockOfficialRepository) FindMatches(ctx context.Context, officialID string, from, to time.Time) ([]domain.Match, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMatches", ctx, officialID, from, to)
+	ret0, _ := ret[0].([]domain.Match)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMatches indicates an expected call of FindMatches.
+func (mr *MockOfficialRepositoryMockRecorder) FindMatches(ctx, officialID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMatches", reflect.TypeOf((*MockOfficialRepository)(nil).FindMatches), ctx, officialID, from, to)
+}
+
+// HasUpcomingMatches mocks base method.
+func (m *MockOfficialRepository) HasUpcomingMatches(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasUpcomingMatches", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasUpcomingMatches indicates an expected call of HasUpcomingMatches.
+func (mr *MockOfficialRepositoryMockRecorder) HasUpcomingMatches(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUpcomingMatches", reflect.TypeOf((*MockOfficialRepository)(nil).HasUpcomingMatches), ctx, id)
+}
+
+// Unassign mocks base method.
+func (m *MockOfficialRepository) Unassign(ctx context.Context, matchID, officialID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, matchID, officialID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockOfficialRepositoryMockRecorder) Unassign(ctx, matchID, officialID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockOfficialRepository)(nil).Unassign), ctx, matchID, officialID)
+}
+
+// Update mocks base method.
+func (m *MockOfficialRepository) Update(ctx context.Context, official *domain.Official) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, official)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOfficialRepositoryMockRecorder) Update(ctx, official any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOfficialRepository)(nil).Update), ctx, official)
+}
+
+// MockLineupRepository is a mock of LineupRepository interface.
+type MockLineupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLineupRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLineupRepositoryMockRecorder is the mock recorder for MockLineupRepository.
+type MockLineupRepositoryMockRecorder struct {
+	mock *MockLineupRepository
+}
+
+// NewMockLineupRepository creates a new mock instance.
+func NewMockLineupRepository(ctrl *gomock.Controller) *MockLineupRepository {
+	mock := &MockLineupRepository{ctrl: ctrl}
+	mock.recorder = &MockLineupRepositoryMockRecorder{mock}
+	return mock
 }
 
-// ---------------------------------------------------------------------------
-// MockReportRepository
-// ---------------------------------------------------------------------------
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLineupRepository) EXPECT() *MockLineupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSubstitution mocks base method.
+func (m *MockLineupRepository) AddSubstitution(ctx context.Context, sub *domain.Substitution, made int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSubstitution", ctx, sub, made)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubstitution indicates an expected call of AddSubstitution.
+func (mr *MockLineupRepositoryMockRecorder) AddSubstitution(ctx, sub, made any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubstitution", reflect.TypeOf((*MockLineupRepository)(nil).AddSubstitution), ctx, sub, made)
+}
+
+// FindByMatchID mocks base method.
+func (m *MockLineupRepository) FindByMatchID(ctx context.Context, matchID string) ([]domain.Lineup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMatchID", ctx, matchID)
+	ret0, _ := ret[0].([]domain.Lineup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
 
+// FindByMatchID indicates an expected call of FindByMatchID.
+func (mr *MockLineupRepositoryMockRecorder) FindByMatchID(ctx, matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMatchID", reflect.TypeOf((*MockLineupRepository)(nil).FindByMatchID), ctx, matchID)
+}
+
+// Save mocks base method.
+func (m *MockLineupRepository) Save(ctx context.Context, lineup *domain.Lineup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, lineup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockLineupRepositoryMockRecorder) Save(ctx, lineup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockLineupRepository)(nil).Save), ctx, lineup)
+}
+
+// MockLiveEventRepository is a mock of LiveEventRepository interface.
+type MockLiveEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveEventRepositoryMockRecorder is the mock recorder for MockLiveEventRepository.
+type MockLiveEventRepositoryMockRecorder struct {
+	mock *MockLiveEventRepository
+}
+
+// NewMockLiveEventRepository creates a new mock instance.
+func NewMockLiveEventRepository(ctrl *gomock.Controller) *MockLiveEventRepository {
+	mock := &MockLiveEventRepository{ctrl: ctrl}
+	mock.recorder = &MockLiveEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveEventRepository) EXPECT() *MockLiveEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLiveEventRepository) Create(ctx context.Context, event *domain.LiveEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLiveEventRepositoryMockRecorder) Create(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLiveEventRepository)(nil).Create), ctx, event)
+}
+
+// FindByMatchID mocks base method.
+func (m *MockLiveEventRepository) FindByMatchID(ctx context.Context, matchID string, afterSequence int64) ([]domain.LiveEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMatchID", ctx, matchID, afterSequence)
+	ret0, _ := ret[0].([]domain.LiveEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMatchID indicates an expected call of FindByMatchID.
+func (mr *MockLiveEventRepositoryMockRecorder) FindByMatchID(ctx, matchID, afterSequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMatchID", reflect.TypeOf((*MockLiveEventRepository)(nil).FindByMatchID), ctx, matchID, afterSequence)
+}
+
+// MockLiveEventBroker is a mock of LiveEventBroker interface.
+type MockLiveEventBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveEventBrokerMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveEventBrokerMockRecorder is the mock recorder for MockLiveEventBroker.
+type MockLiveEventBrokerMockRecorder struct {
+	mock *MockLiveEventBroker
+}
+
+// NewMockLiveEventBroker creates a new mock instance.
+func NewMockLiveEventBroker(ctrl *gomock.Controller) *MockLiveEventBroker {
+	mock := &MockLiveEventBroker{ctrl: ctrl}
+	mock.recorder = &MockLiveEventBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveEventBroker) EXPECT() *MockLiveEventBrokerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockLiveEventBroker) Publish(event domain.LiveEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockLiveEventBrokerMockRecorder) Publish(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockLiveEventBroker)(nil).Publish), event)
+}
+
+// Subscribe mocks base method.
+func (m *MockLiveEventBroker) Subscribe(matchID string) (<-chan domain.LiveEvent, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", matchID)
+	ret0, _ := ret[0].(<-chan domain.LiveEvent)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockLiveEventBrokerMockRecorder) Subscribe(matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockLiveEventBroker)(nil).Subscribe), matchID)
+}
+
+// MockDisciplineRepository is a mock of DisciplineRepository interface.
+type MockDisciplineRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisciplineRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDisciplineRepositoryMockRecorder is the mock recorder for MockDisciplineRepository.
+type MockDisciplineRepositoryMockRecorder struct {
+	mock *MockDisciplineRepository
+}
+
+// NewMockDisciplineRepository creates a new mock instance.
+func NewMockDisciplineRepository(ctrl *gomock.Controller) *MockDisciplineRepository {
+	mock := &MockDisciplineRepository{ctrl: ctrl}
+	mock.recorder = &MockDisciplineRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisciplineRepository) EXPECT() *MockDisciplineRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindCardsByPlayer mocks base method.
+func (m *MockDisciplineRepository) FindCardsByPlayer(ctx context.Context, playerID string) ([]domain.BookedCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCardsByPlayer", ctx, playerID)
+	ret0, _ := ret[0].([]domain.BookedCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCardsByPlayer indicates an expected call of FindCardsByPlayer.
+func (mr *MockDisciplineRepositoryMockRecorder) FindCardsByPlayer(ctx, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCardsByPlayer", reflect.TypeOf((*MockDisciplineRepository)(nil).FindCardsByPlayer), ctx, playerID)
+}
+
+// FindCardsByTeam mocks base method.
+func (m *MockDisciplineRepository) FindCardsByTeam(ctx context.Context, teamID, seasonID string) ([]domain.BookedCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCardsByTeam", ctx, teamID, seasonID)
+	ret0, _ := ret[0].([]domain.BookedCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCardsByTeam indicates an expected call of FindCardsByTeam.
+func (mr *MockDisciplineRepositoryMockRecorder) FindCardsByTeam(ctx, teamID, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCardsByTeam", reflect.TypeOf((*MockDisciplineRepository)(nil).FindCardsByTeam), ctx, teamID, seasonID)
+}
+
+// MockSeasonRepository is a mock of SeasonRepository interface.
+type MockSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSeasonRepositoryMockRecorder is the mock recorder for MockSeasonRepository.
+type MockSeasonRepositoryMockRecorder struct {
+	mock *MockSeasonRepository
+}
+
+// NewMockSeasonRepository creates a new mock instance.
+func NewMockSeasonRepository(ctrl *gomock.Controller) *MockSeasonRepository {
+	mock := &MockSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonRepository) EXPECT() *MockSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockSeasonRepository) FindByID(ctx context.Context, id string) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSeasonRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSeasonRepository)(nil).FindByID), ctx, id)
+}
+
+// FindLatestByCompetitionID mocks base method.
+func (m *MockSeasonRepository) FindLatestByCompetitionID(ctx context.Context, competitionID string) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestByCompetitionID", ctx, competitionID)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestByCompetitionID indicates an expected call of FindLatestByCompetitionID.
+func (mr *MockSeasonRepositoryMockRecorder) FindLatestByCompetitionID(ctx, competitionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestByCompetitionID", reflect.TypeOf((*MockSeasonRepository)(nil).FindLatestByCompetitionID), ctx, competitionID)
+}
+
+// FindTeams mocks base method.
+func (m *MockSeasonRepository) FindTeams(ctx context.Context, seasonID string) ([]domain.SeasonTeam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeams", ctx, seasonID)
+	ret0, _ := ret[0].([]domain.SeasonTeam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeams indicates an expected call of FindTeams.
+func (mr *MockSeasonRepositoryMockRecorder) FindTeams(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeams", reflect.TypeOf((*MockSeasonRepository)(nil).FindTeams), ctx, seasonID)
+}
+
+// IsTeamRegistered mocks base method.
+func (m *MockSeasonRepository) IsTeamRegistered(ctx context.Context, seasonID, teamID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTeamRegistered", ctx, seasonID, teamID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTeamRegistered indicates an expected call of IsTeamRegistered.
+func (mr *MockSeasonRepositoryMockRecorder) IsTeamRegistered(ctx, seasonID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTeamRegistered", reflect.TypeOf((*MockSeasonRepository)(nil).IsTeamRegistered), ctx, seasonID, teamID)
+}
+
+// MockBracketRepository is a mock of BracketRepository interface.
+type MockBracketRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBracketRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBracketRepositoryMockRecorder is the mock recorder for MockBracketRepository.
+type MockBracketRepositoryMockRecorder struct {
+	mock *MockBracketRepository
+}
+
+// NewMockBracketRepository creates a new mock instance.
+func NewMockBracketRepository(ctrl *gomock.Controller) *MockBracketRepository {
+	mock := &MockBracketRepository{ctrl: ctrl}
+	mock.recorder = &MockBracketRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBracketRepository) EXPECT() *MockBracketRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBracketRepository) Create(ctx context.Context, bracket *domain.Bracket, matches []*domain.Match) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, bracket, matches)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBracketRepositoryMockRecorder) Create(ctx, bracket, matches any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBracketRepository)(nil).Create), ctx, bracket, matches)
+}
+
+// ExistsBySeasonID mocks base method.
+func (m *MockBracketRepository) ExistsBySeasonID(ctx context.Context, seasonID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsBySeasonID", ctx, seasonID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsBySeasonID indicates an expected call of ExistsBySeasonID.
+func (mr *MockBracketRepositoryMockRecorder) ExistsBySeasonID(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsBySeasonID", reflect.TypeOf((*MockBracketRepository)(nil).ExistsBySeasonID), ctx, seasonID)
+}
+
+// FindBySeasonID mocks base method.
+func (m *MockBracketRepository) FindBySeasonID(ctx context.Context, seasonID string) (*domain.Bracket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeasonID", ctx, seasonID)
+	ret0, _ := ret[0].(*domain.Bracket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeasonID indicates an expected call of FindBySeasonID.
+func (mr *MockBracketRepositoryMockRecorder) FindBySeasonID(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeasonID", reflect.TypeOf((*MockBracketRepository)(nil).FindBySeasonID), ctx, seasonID)
+}
+
+// FindTieByMatchID mocks base method.
+func (m *MockBracketRepository) FindTieByMatchID(ctx context.Context, matchID string) (*domain.Tie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTieByMatchID", ctx, matchID)
+	ret0, _ := ret[0].(*domain.Tie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTieByMatchID indicates an expected call of FindTieByMatchID.
+func (mr *MockBracketRepositoryMockRecorder) FindTieByMatchID(ctx, matchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTieByMatchID", reflect.TypeOf((*MockBracketRepository)(nil).FindTieByMatchID), ctx, matchID)
+}
+
+// MockReportRepository is a mock of ReportRepository interface.
 type MockReportRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockReportRepositoryMockRecorder
+	isgomock struct{}
 }
 
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
 type MockReportRepositoryMockRecorder struct {
 	mock *MockReportRepository
 }
 
+// NewMockReportRepository creates a new mock instance.
 func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
 	mock := &MockReportRepository{ctrl: ctrl}
 	mock.recorder = &MockReportRepositoryMockRecorder{mock}
 	return mock
 }
 
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
 	return m.recorder
 }
 
-func (m *MockReportRepository) GetMatchReport(ctx context.Context, matchID string) (*domain.MatchReportView, error) {
+// GetAllMatchReports mocks base method.
+func (m *MockReportRepository) GetAllMatchReports(ctx context.Context) ([]domain.MatchReportView, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatchReport", ctx, matchID)
-	ret0, _ := ret[0].(*domain.MatchReportView)
+	ret := m.ctrl.Call(m, "GetAllMatchReports", ctx)
+	ret0, _ := ret[0].([]domain.MatchReportView)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockReportRepositoryMockRecorder) GetMatchReport(ctx, matchID any) *gomock.Call {
+// GetAllMatchReports indicates an expected call of GetAllMatchReports.
+func (mr *MockReportRepositoryMockRecorder) GetAllMatchReports(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchReport", reflect.TypeOf((*MockReportRepository)(nil).GetMatchReport), ctx, matchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMatchReports", reflect.TypeOf((*MockReportRepository)(nil).GetAllMatchReports), ctx)
 }
 
-func (m *MockReportRepository) GetAllMatchReports(ctx context.Context) ([]domain.MatchReportView, error) {
+// GetMatchReport mocks base method.
+func (m *MockReportRepository) GetMatchReport(ctx context.Context, matchID string) (*domain.MatchReportView, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllMatchReports", ctx)
-	ret0, _ := ret[0].([]domain.MatchReportView)
+	ret := m.ctrl.Call(m, "GetMatchReport", ctx, matchID)
+	ret0, _ := ret[0].(*domain.MatchReportView)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockReportRepositoryMockRecorder) GetAllMatchReports(ctx any) *gomock.Call {
+// GetMatchReport indicates an expected call of GetMatchReport.
+func (mr *MockReportRepositoryMockRecorder) GetMatchReport(ctx, matchID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMatchReports", reflect.TypeOf((*MockReportRepository)(nil).GetAllMatchReports), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchReport", reflect.TypeOf((*MockReportRepository)(nil).GetMatchReport), ctx, matchID)
 }
@@ -6,6 +6,11 @@ import (
 )
 
 type ReportingServicePort interface {
-	GetStandings(ctx context.Context) ([]domain.TeamStanding, error)
-	GetTopScorers(ctx context.Context) ([]domain.TopScorer, error)
+	GetStandings(ctx context.Context, seasonID string) ([]domain.TeamStanding, error)
+	GetTopScorers(ctx context.Context, seasonID string) ([]domain.TopScorer, error)
+	GetTopAssists(ctx context.Context, seasonID string) ([]domain.TopAssist, error)
+	GetPlayerStats(ctx context.Context, seasonID, playerID string) ([]domain.PlayerStats, error)
+	GetLiveScores(ctx context.Context) ([]domain.LiveScore, error)
+	GetLiveStandingDeltas(ctx context.Context, seasonID string) ([]domain.StandingDelta, error)
+	GetHeadCoachRecords(ctx context.Context, seasonID, teamID string) ([]domain.HeadCoachRecord, error)
 }
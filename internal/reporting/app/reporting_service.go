@@ -13,10 +13,47 @@ func NewReportingService(repo domain.ReportingRepository) ReportingServicePort {
 	return &ReportingService{repo: repo}
 }
 
-func (s *ReportingService) GetStandings(ctx context.Context) ([]domain.TeamStanding, error) {
-	return s.repo.GetStandings(ctx)
+func (s *ReportingService) GetStandings(ctx context.Context, seasonID string) ([]domain.TeamStanding, error) {
+	return s.repo.GetStandings(ctx, seasonID)
 }
 
-func (s *ReportingService) GetTopScorers(ctx context.Context) ([]domain.TopScorer, error) {
-	return s.repo.GetTopScorers(ctx)
+// GetTopScorers ranks the scorers of the season. A player who moved clubs is ranked once per club.
+func (s *ReportingService) GetTopScorers(ctx context.Context, seasonID string) ([]domain.TopScorer, error) {
+	scorers, err := s.repo.GetTopScorers(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RankTopScorers(scorers), nil
+}
+
+func (s *ReportingService) GetTopAssists(ctx context.Context, seasonID string) ([]domain.TopAssist, error) {
+	return s.repo.GetTopAssists(ctx, seasonID)
+}
+
+func (s *ReportingService) GetPlayerStats(ctx context.Context, seasonID, playerID string) ([]domain.PlayerStats, error) {
+	return s.repo.GetPlayerStats(ctx, seasonID, playerID)
+}
+
+func (s *ReportingService) GetLiveScores(ctx context.Context) ([]domain.LiveScore, error) {
+	return s.repo.GetLiveScores(ctx)
+}
+
+// GetHeadCoachRecords attributes the results of each team to the head coach in charge on the match date.
+func (s *ReportingService) GetHeadCoachRecords(ctx context.Context, seasonID, teamID string) ([]domain.HeadCoachRecord, error) {
+	return s.repo.GetHeadCoachRecords(ctx, seasonID, teamID)
+}
+
+// GetLiveStandingDeltas returns how the season's table would change if the scores of the matches being played held.
+func (s *ReportingService) GetLiveStandingDeltas(ctx context.Context, seasonID string) ([]domain.StandingDelta, error) {
+	current, err := s.repo.GetStandings(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	projected, err := s.repo.GetLiveStandings(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.StandingDeltas(current, projected), nil
 }
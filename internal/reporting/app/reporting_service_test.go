@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/domain"
+	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func setupReportingService(t *testing.T) (*ReportingService, *mockDomain.MockReportingRepository) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockRepo := mockDomain.NewMockReportingRepository(ctrl)
+	return &ReportingService{repo: mockRepo}, mockRepo
+}
+
+func standing(teamID string, points, gd int) domain.TeamStanding {
+	return domain.TeamStanding{TeamID: teamID, TeamName: "Team " + teamID, Points: points, GD: gd}
+}
+
+// ---------------------------------------------------------------------------
+// Live standings
+// ---------------------------------------------------------------------------
+
+func TestReportingService_GetLiveStandingDeltas_TeamsThatMove(t *testing.T) {
+	svc, mockRepo := setupReportingService(t)
+	ctx := context.Background()
+
+	// team-3 is beating team-1, team-2 is not playing
+	mockRepo.EXPECT().GetStandings(ctx, "season-1").Return([]domain.TeamStanding{
+		standing("team-1", 10, 5),
+		standing("team-2", 9, 4),
+		standing("team-3", 8, 1),
+	}, nil)
+	mockRepo.EXPECT().GetLiveStandings(ctx, "season-1").Return([]domain.TeamStanding{
+		standing("team-3", 11, 2),
+		standing("team-1", 10, 4),
+		standing("team-2", 9, 4),
+	}, nil)
+
+	deltas, err := svc.GetLiveStandingDeltas(ctx, "season-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want := []domain.StandingDelta{
+		{TeamID: "team-3", TeamName: "Team team-3", Position: 3, LivePosition: 1, Points: 8, LivePoints: 11, GD: 1, LiveGD: 2},
+		{TeamID: "team-1", TeamName: "Team team-1", Position: 1, LivePosition: 2, Points: 10, LivePoints: 10, GD: 5, LiveGD: 4},
+		{TeamID: "team-2", TeamName: "Team team-2", Position: 2, LivePosition: 3, Points: 9, LivePoints: 9, GD: 4, LiveGD: 4},
+	}
+	if len(deltas) != len(want) {
+		t.Fatalf("expected %d deltas, got %+v", len(want), deltas)
+	}
+	for i := range want {
+		if deltas[i] != want[i] {
+			t.Errorf("delta %d: expected %+v, got %+v", i, want[i], deltas[i])
+		}
+	}
+}
+
+func TestReportingService_GetLiveStandingDeltas_UnchangedTeamsLeftOut(t *testing.T) {
+	svc, mockRepo := setupReportingService(t)
+	ctx := context.Background()
+
+	// A goalless draw between team-2 and team-3 moves neither past anybody
+	mockRepo.EXPECT().GetStandings(ctx, "season-1").Return([]domain.TeamStanding{
+		standing("team-1", 10, 5),
+		standing("team-2", 9, 4),
+		standing("team-3", 8, 1),
+	}, nil)
+	mockRepo.EXPECT().GetLiveStandings(ctx, "season-1").Return([]domain.TeamStanding{
+		standing("team-1", 10, 5),
+		standing("team-2", 10, 4),
+		standing("team-3", 9, 1),
+	}, nil)
+
+	deltas, err := svc.GetLiveStandingDeltas(ctx, "season-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(deltas) != 2 || deltas[0].TeamID != "team-2" || deltas[1].TeamID != "team-3" {
+		t.Fatalf("expected only the teams playing, got %+v", deltas)
+	}
+	if deltas[0].Position != deltas[0].LivePosition {
+		t.Errorf("expected team-2 to stay second, got %+v", deltas[0])
+	}
+}
+
+func TestReportingService_GetLiveStandingDeltas_FirstMatch(t *testing.T) {
+	svc, mockRepo := setupReportingService(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetStandings(ctx, "season-1").Return(nil, nil)
+	mockRepo.EXPECT().GetLiveStandings(ctx, "season-1").Return([]domain.TeamStanding{
+		standing("team-1", 3, 1),
+		standing("team-2", 0, -1),
+	}, nil)
+
+	deltas, err := svc.GetLiveStandingDeltas(ctx, "season-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(deltas) != 2 {
+		t.Fatalf("expected both teams, got %+v", deltas)
+	}
+	for _, d := range deltas {
+		if d.Position != 0 {
+			t.Errorf("expected %s not to be in the table yet, got position %d", d.TeamID, d.Position)
+		}
+	}
+}
+
+func TestReportingService_GetLiveStandingDeltas_NothingLive(t *testing.T) {
+	svc, mockRepo := setupReportingService(t)
+	ctx := context.Background()
+	standings := []domain.TeamStanding{standing("team-1", 10, 5), standing("team-2", 9, 4)}
+
+	mockRepo.EXPECT().GetStandings(ctx, "season-1").Return(standings, nil)
+	mockRepo.EXPECT().GetLiveStandings(ctx, "season-1").Return(standings, nil)
+
+	deltas, err := svc.GetLiveStandingDeltas(ctx, "season-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if deltas == nil || len(deltas) != 0 {
+		t.Fatalf("expected an empty list, got %#v", deltas)
+	}
+}
+
+func TestReportingService_GetLiveStandingDeltas_RepositoryError(t *testing.T) {
+	svc, mockRepo := setupReportingService(t)
+	ctx := context.Background()
+	dbErr := errors.New("connection refused")
+
+	mockRepo.EXPECT().GetStandings(ctx, "season-1").Return(nil, dbErr)
+
+	_, err := svc.GetLiveStandingDeltas(ctx, "season-1")
+
+	if !errors.Is(err, dbErr) {
+		t.Fatalf("expected the repository error, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Top scorers
+// ---------------------------------------------------------------------------
+
+func TestReportingService_GetTopScorers_ScoredBeforeAndAfterTransfer(t *testing.T) {
+	svc, mockRepo := setupReportingService(t)
+	ctx := context.Background()
+
+	// player-1 scored 2 in 180 minutes for team-1, then moved and scored 1 in 45 minutes for team-2
+	mockRepo.EXPECT().GetTopScorers(ctx, "season-1").Return([]domain.TopScorer{
+		{PlayerID: "player-1", PlayerName: "Marselino", TeamName: "Team team-1", Goals: 2, MinutesPlayed: 180},
+		{PlayerID: "player-2", PlayerName: "Egy", TeamName: "Team team-3", Goals: 2, MinutesPlayed: 0},
+		{PlayerID: "player-1", PlayerName: "Marselino", TeamName: "Team team-2", Goals: 1, MinutesPlayed: 45},
+		{PlayerID: "player-3", PlayerName: "Witan", TeamName: "Team team-3", Goals: 2, MinutesPlayed: 90},
+	}, nil)
+
+	scorers, err := svc.GetTopScorers(ctx, "season-1")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want := []domain.TopScorer{
+		{PlayerID: "player-3", PlayerName: "Witan", TeamName: "Team team-3", Goals: 2, MinutesPlayed: 90, GoalsPer90: 2},
+		{PlayerID: "player-1", PlayerName: "Marselino", TeamName: "Team team-1", Goals: 2, MinutesPlayed: 180, GoalsPer90: 1},
+		{PlayerID: "player-2", PlayerName: "Egy", TeamName: "Team team-3", Goals: 2, MinutesPlayed: 0, GoalsPer90: 0},
+		{PlayerID: "player-1", PlayerName: "Marselino", TeamName: "Team team-2", Goals: 1, MinutesPlayed: 45, GoalsPer90: 2},
+	}
+	if len(scorers) != len(want) {
+		t.Fatalf("expected %d scorers, got %+v", len(want), scorers)
+	}
+	for i := range want {
+		if scorers[i] != want[i] {
+			t.Errorf("scorer %d: expected %+v, got %+v", i, want[i], scorers[i])
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
 
 type TeamStanding struct {
 	TeamID   string
@@ -16,13 +21,106 @@ type TeamStanding struct {
 }
 
 type TopScorer struct {
+	PlayerID      string
+	PlayerName    string
+	TeamName      string
+	Goals         int     // Own goals are not counted
+	MinutesPlayed int     // For the team, only counts matches with a submitted lineup
+	GoalsPer90    float64 // 0 when no minutes were recorded
+}
+
+// maxTopScorers is how many scorers the top scorer list holds.
+const maxTopScorers = 20
+
+// RankTopScorers works out the goals per 90 minutes of each scorer and returns the best of them. Ties on goals
+// go to the player who needed fewer minutes; players without recorded minutes come last.
+func RankTopScorers(scorers []TopScorer) []TopScorer {
+	for i := range scorers {
+		scorers[i].GoalsPer90 = 0
+		if scorers[i].MinutesPlayed > 0 {
+			scorers[i].GoalsPer90 = math.Round(float64(scorers[i].Goals)*90/float64(scorers[i].MinutesPlayed)*100) / 100
+		}
+	}
+
+	sort.SliceStable(scorers, func(i, j int) bool {
+		a, b := scorers[i], scorers[j]
+		if a.Goals != b.Goals {
+			return a.Goals > b.Goals
+		}
+		if (a.MinutesPlayed > 0) != (b.MinutesPlayed > 0) {
+			return a.MinutesPlayed > 0
+		}
+		if a.MinutesPlayed != b.MinutesPlayed {
+			return a.MinutesPlayed < b.MinutesPlayed
+		}
+		return a.PlayerName < b.PlayerName
+	})
+
+	if len(scorers) > maxTopScorers {
+		scorers = scorers[:maxTopScorers]
+	}
+	return scorers
+}
+
+type TopAssist struct {
 	PlayerID   string
 	PlayerName string
 	TeamName   string
-	Goals      int
+	Assists    int
+}
+
+// PlayerStats is a player's participation for one team in one season, taken from the appearances
+// recorded with reported results. Players only appear once they have taken the field.
+type PlayerStats struct {
+	PlayerID       string
+	PlayerName     string
+	TeamID         string
+	TeamName       string
+	SeasonID       string
+	SeasonName     string
+	Appearances    int
+	Starts         int
+	SubAppearances int
+	MinutesPlayed  int
+	Goals          int // Own goals are not counted
+	GoalsPer90     float64
+}
+
+// MatchTimezone is the zone whose calendar dates a match played without a venue, WIB.
+const MatchTimezone = "Asia/Jakarta"
+
+// HeadCoachRecord is the record of a team under one head coach, over the reported results of the matches
+// played from the day they took charge until the day they handed over.
+type HeadCoachRecord struct {
+	StaffID   string
+	StaffName string
+	TeamID    string
+	TeamName  string
+	StartedOn time.Time
+	EndedOn   *time.Time // Nil while they are still in charge
+	Played    int
+	Won       int
+	Drawn     int
+	Lost      int
+	GF        int // Goals For
+	GA        int // Goals Against
+	GD        int // Goal Difference
+	Points    int
 }
 
+// ReportingRepository aggregates match data. An empty seasonID aggregates across every season.
 type ReportingRepository interface {
-	GetStandings(ctx context.Context) ([]TeamStanding, error)
-	GetTopScorers(ctx context.Context) ([]TopScorer, error)
+	GetStandings(ctx context.Context, seasonID string) ([]TeamStanding, error)
+	// GetTopScorers returns the goals and minutes of every scorer per player and team, without goals per 90.
+	GetTopScorers(ctx context.Context, seasonID string) ([]TopScorer, error)
+	GetTopAssists(ctx context.Context, seasonID string) ([]TopAssist, error)
+	// GetPlayerStats returns participation per player, team and season. An empty playerID covers every player.
+	GetPlayerStats(ctx context.Context, seasonID, playerID string) ([]PlayerStats, error)
+	// GetLiveScores returns the matches being played with their latest live score.
+	GetLiveScores(ctx context.Context) ([]LiveScore, error)
+	// GetLiveStandings returns the table as it would stand if the scores of the matches being played held.
+	GetLiveStandings(ctx context.Context, seasonID string) ([]TeamStanding, error)
+	// GetHeadCoachRecords returns the record of every head coach tenure, by team and newest first.
+	// An empty teamID covers every team.
+	GetHeadCoachRecords(ctx context.Context, seasonID, teamID string) ([]HeadCoachRecord, error)
 }
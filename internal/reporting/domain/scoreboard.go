@@ -0,0 +1,79 @@
+package domain
+
+// LiveScore is the score of a match being played, as last posted to its live feed.
+type LiveScore struct {
+	MatchID       string
+	SeasonID      string
+	CompetitionID string
+	League        bool // Whether the match counts towards a league table
+	HomeTeamID    string
+	HomeTeamName  string
+	AwayTeamID    string
+	AwayTeamName  string
+	HomeScore     int
+	AwayScore     int
+	Minute        int
+	Phase         string // Type of the latest live event, empty before kickoff
+	Sequence      int64  // Sequence of the latest live event, 0 before kickoff
+}
+
+// ScoreboardTopic is what a scoreboard client follows. A topic with neither ID follows every live match;
+// one with both follows the team's matches in the competition.
+type ScoreboardTopic struct {
+	CompetitionID string
+	TeamID        string
+}
+
+// Covers reports whether the live match falls under the topic.
+func (t ScoreboardTopic) Covers(score LiveScore) bool {
+	if t.CompetitionID != "" && score.CompetitionID != t.CompetitionID {
+		return false
+	}
+	if t.TeamID != "" && score.HomeTeamID != t.TeamID && score.AwayTeamID != t.TeamID {
+		return false
+	}
+	return true
+}
+
+// StandingDelta is how a team's place in the table would change if the scores of the matches being played held.
+// A position of 0 means the team is not in the table yet.
+type StandingDelta struct {
+	TeamID       string
+	TeamName     string
+	Position     int
+	LivePosition int
+	Points       int
+	LivePoints   int
+	GD           int
+	LiveGD       int
+}
+
+// StandingDeltas compares the current table with the one projected from the live scores and returns
+// the teams whose position, points or goal difference would change, in projected order.
+func StandingDeltas(current, projected []TeamStanding) []StandingDelta {
+	now := make(map[string]int, len(current))
+	for i := range current {
+		now[current[i].TeamID] = i
+	}
+
+	deltas := make([]StandingDelta, 0)
+	for i, live := range projected {
+		delta := StandingDelta{
+			TeamID:       live.TeamID,
+			TeamName:     live.TeamName,
+			LivePosition: i + 1,
+			LivePoints:   live.Points,
+			LiveGD:       live.GD,
+		}
+		if j, ok := now[live.TeamID]; ok {
+			delta.Position = j + 1
+			delta.Points = current[j].Points
+			delta.GD = current[j].GD
+		}
+		if delta.Position != delta.LivePosition || delta.Points != delta.LivePoints || delta.GD != delta.LiveGD {
+			deltas = append(deltas, delta)
+		}
+	}
+
+	return deltas
+}
@@ -18,7 +18,7 @@ func NewReportingHandler(service app.ReportingServicePort) *ReportingHandler {
 }
 
 func (h *ReportingHandler) GetStandings(c *gin.Context) {
-	standings, err := h.service.GetStandings(c.Request.Context())
+	standings, err := h.service.GetStandings(c.Request.Context(), c.Query("season_id"))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -33,7 +33,7 @@ func (h *ReportingHandler) GetStandings(c *gin.Context) {
 }
 
 func (h *ReportingHandler) GetTopScorers(c *gin.Context) {
-	scorers, err := h.service.GetTopScorers(c.Request.Context())
+	scorers, err := h.service.GetTopScorers(c.Request.Context(), c.Query("season_id"))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -47,10 +47,60 @@ func (h *ReportingHandler) GetTopScorers(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(resp))
 }
 
-func RegisterRoutes(rg *gin.RouterGroup, h *ReportingHandler) {
+func (h *ReportingHandler) GetTopAssists(c *gin.Context) {
+	assists, err := h.service.GetTopAssists(c.Request.Context(), c.Query("season_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := make([]response.TopAssistResponse, 0, len(assists))
+	for _, a := range assists {
+		resp = append(resp, response.FromTopAssistDomain(a))
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(resp))
+}
+
+func (h *ReportingHandler) GetPlayerStats(c *gin.Context) {
+	stats, err := h.service.GetPlayerStats(c.Request.Context(), c.Query("season_id"), c.Query("player_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := make([]response.PlayerStatsResponse, 0, len(stats))
+	for _, s := range stats {
+		resp = append(resp, response.FromPlayerStatsDomain(s))
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(resp))
+}
+
+// GetHeadCoaches reports the record of each head coach of the teams, narrowed down by ?team_id= and ?season_id=.
+func (h *ReportingHandler) GetHeadCoaches(c *gin.Context) {
+	records, err := h.service.GetHeadCoachRecords(c.Request.Context(), c.Query("season_id"), c.Query("team_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := make([]response.HeadCoachRecordResponse, 0, len(records))
+	for _, r := range records {
+		resp = append(resp, response.FromHeadCoachRecordDomain(r))
+	}
+
+	c.JSON(http.StatusOK, common.NewSuccessResponseWithData(resp))
+}
+
+func RegisterRoutes(rg *gin.RouterGroup, h *ReportingHandler, scoreboardH *ScoreboardHandler) {
 	reporting := rg.Group("/reporting")
 	{
 		reporting.GET("/standings", h.GetStandings)
 		reporting.GET("/top-scorers", h.GetTopScorers)
+		reporting.GET("/top-assists", h.GetTopAssists)
+		reporting.GET("/player-stats", h.GetPlayerStats)
+		reporting.GET("/head-coaches", h.GetHeadCoaches)
+		reporting.GET("/scoreboard", scoreboardH.Follow) // WebSocket
 	}
 }
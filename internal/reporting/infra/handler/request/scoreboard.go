@@ -0,0 +1,26 @@
+package request
+
+import (
+	"strings"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/domain"
+)
+
+const (
+	ScoreboardSubscribe   = "subscribe"
+	ScoreboardUnsubscribe = "unsubscribe"
+)
+
+// ScoreboardRequest is a message sent by a scoreboard client. Leaving out both IDs follows every live match.
+type ScoreboardRequest struct {
+	Action        string `json:"action"` // subscribe or unsubscribe
+	CompetitionID string `json:"competition_id"`
+	TeamID        string `json:"team_id"`
+}
+
+func (r *ScoreboardRequest) ToTopic() domain.ScoreboardTopic {
+	return domain.ScoreboardTopic{
+		CompetitionID: strings.TrimSpace(r.CompetitionID),
+		TeamID:        strings.TrimSpace(r.TeamID),
+	}
+}
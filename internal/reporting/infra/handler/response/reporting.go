@@ -16,10 +16,34 @@ type StandingResponse struct {
 }
 
 type TopScorerResponse struct {
+	PlayerID      string  `json:"player_id"`
+	PlayerName    string  `json:"player_name"`
+	TeamName      string  `json:"team_name"`
+	Goals         int     `json:"goals"`
+	MinutesPlayed int     `json:"minutes_played"`
+	GoalsPer90    float64 `json:"goals_per_90"`
+}
+
+type TopAssistResponse struct {
 	PlayerID   string `json:"player_id"`
 	PlayerName string `json:"player_name"`
 	TeamName   string `json:"team_name"`
-	Goals      int    `json:"goals"`
+	Assists    int    `json:"assists"`
+}
+
+type PlayerStatsResponse struct {
+	PlayerID       string  `json:"player_id"`
+	PlayerName     string  `json:"player_name"`
+	TeamID         string  `json:"team_id"`
+	TeamName       string  `json:"team_name"`
+	SeasonID       string  `json:"season_id"`
+	SeasonName     string  `json:"season_name"`
+	Appearances    int     `json:"appearances"`
+	Starts         int     `json:"starts"`
+	SubAppearances int     `json:"sub_appearances"`
+	MinutesPlayed  int     `json:"minutes_played"`
+	Goals          int     `json:"goals"`
+	GoalsPer90     float64 `json:"goals_per_90"`
 }
 
 func FromStandingDomain(d domain.TeamStanding) StandingResponse {
@@ -37,11 +61,80 @@ func FromStandingDomain(d domain.TeamStanding) StandingResponse {
 	}
 }
 
+type HeadCoachRecordResponse struct {
+	StaffID   string  `json:"staff_id"`
+	StaffName string  `json:"staff_name"`
+	TeamID    string  `json:"team_id"`
+	TeamName  string  `json:"team_name"`
+	StartedOn string  `json:"started_on"`
+	EndedOn   *string `json:"ended_on"` // Null while they are still in charge
+	Played    int     `json:"played"`
+	Won       int     `json:"won"`
+	Drawn     int     `json:"drawn"`
+	Lost      int     `json:"lost"`
+	GF        int     `json:"gf"`
+	GA        int     `json:"ga"`
+	GD        int     `json:"gd"`
+	Points    int     `json:"points"`
+}
+
+func FromHeadCoachRecordDomain(d domain.HeadCoachRecord) HeadCoachRecordResponse {
+	var endedOn *string
+	if d.EndedOn != nil {
+		s := d.EndedOn.Format("2006-01-02")
+		endedOn = &s
+	}
+	return HeadCoachRecordResponse{
+		StaffID:   d.StaffID,
+		StaffName: d.StaffName,
+		TeamID:    d.TeamID,
+		TeamName:  d.TeamName,
+		StartedOn: d.StartedOn.Format("2006-01-02"),
+		EndedOn:   endedOn,
+		Played:    d.Played,
+		Won:       d.Won,
+		Drawn:     d.Drawn,
+		Lost:      d.Lost,
+		GF:        d.GF,
+		GA:        d.GA,
+		GD:        d.GD,
+		Points:    d.Points,
+	}
+}
+
 func FromTopScorerDomain(d domain.TopScorer) TopScorerResponse {
 	return TopScorerResponse{
+		PlayerID:      d.PlayerID,
+		PlayerName:    d.PlayerName,
+		TeamName:      d.TeamName,
+		Goals:         d.Goals,
+		MinutesPlayed: d.MinutesPlayed,
+		GoalsPer90:    d.GoalsPer90,
+	}
+}
+
+func FromTopAssistDomain(d domain.TopAssist) TopAssistResponse {
+	return TopAssistResponse{
 		PlayerID:   d.PlayerID,
 		PlayerName: d.PlayerName,
 		TeamName:   d.TeamName,
-		Goals:      d.Goals,
+		Assists:    d.Assists,
+	}
+}
+
+func FromPlayerStatsDomain(d domain.PlayerStats) PlayerStatsResponse {
+	return PlayerStatsResponse{
+		PlayerID:       d.PlayerID,
+		PlayerName:     d.PlayerName,
+		TeamID:         d.TeamID,
+		TeamName:       d.TeamName,
+		SeasonID:       d.SeasonID,
+		SeasonName:     d.SeasonName,
+		Appearances:    d.Appearances,
+		Starts:         d.Starts,
+		SubAppearances: d.SubAppearances,
+		MinutesPlayed:  d.MinutesPlayed,
+		Goals:          d.Goals,
+		GoalsPer90:     d.GoalsPer90,
 	}
 }
@@ -0,0 +1,90 @@
+package response
+
+import "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/domain"
+
+// ScoreboardMessage is a message sent to a scoreboard client. Type is one of score, ended, standings,
+// subscribed, unsubscribed or error.
+type ScoreboardMessage struct {
+	Type string `json:"type"`
+	Data any    `json:"data"`
+}
+
+type LiveScoreResponse struct {
+	MatchID       string `json:"match_id"`
+	SeasonID      string `json:"season_id"`
+	CompetitionID string `json:"competition_id"`
+	HomeTeamID    string `json:"home_team_id"`
+	HomeTeamName  string `json:"home_team_name"`
+	AwayTeamID    string `json:"away_team_id"`
+	AwayTeamName  string `json:"away_team_name"`
+	HomeScore     int    `json:"home_score"`
+	AwayScore     int    `json:"away_score"`
+	Minute        int    `json:"minute"`
+	Phase         string `json:"phase"`
+}
+
+type StandingDeltaResponse struct {
+	TeamID       string `json:"team_id"`
+	TeamName     string `json:"team_name"`
+	Position     int    `json:"position"`
+	LivePosition int    `json:"live_position"`
+	Points       int    `json:"points"`
+	LivePoints   int    `json:"live_points"`
+	GD           int    `json:"gd"`
+	LiveGD       int    `json:"live_gd"`
+}
+
+type LiveStandingsResponse struct {
+	SeasonID      string                  `json:"season_id"`
+	CompetitionID string                  `json:"competition_id"`
+	Deltas        []StandingDeltaResponse `json:"deltas"`
+}
+
+type ScoreboardTopicResponse struct {
+	CompetitionID string `json:"competition_id,omitempty"`
+	TeamID        string `json:"team_id,omitempty"`
+}
+
+func FromLiveScoreDomain(d domain.LiveScore) LiveScoreResponse {
+	return LiveScoreResponse{
+		MatchID:       d.MatchID,
+		SeasonID:      d.SeasonID,
+		CompetitionID: d.CompetitionID,
+		HomeTeamID:    d.HomeTeamID,
+		HomeTeamName:  d.HomeTeamName,
+		AwayTeamID:    d.AwayTeamID,
+		AwayTeamName:  d.AwayTeamName,
+		HomeScore:     d.HomeScore,
+		AwayScore:     d.AwayScore,
+		Minute:        d.Minute,
+		Phase:         d.Phase,
+	}
+}
+
+func FromLiveStandings(seasonID, competitionID string, deltas []domain.StandingDelta) LiveStandingsResponse {
+	resp := LiveStandingsResponse{
+		SeasonID:      seasonID,
+		CompetitionID: competitionID,
+		Deltas:        make([]StandingDeltaResponse, 0, len(deltas)),
+	}
+	for _, d := range deltas {
+		resp.Deltas = append(resp.Deltas, StandingDeltaResponse{
+			TeamID:       d.TeamID,
+			TeamName:     d.TeamName,
+			Position:     d.Position,
+			LivePosition: d.LivePosition,
+			Points:       d.Points,
+			LivePoints:   d.LivePoints,
+			GD:           d.GD,
+			LiveGD:       d.LiveGD,
+		})
+	}
+	return resp
+}
+
+func FromScoreboardTopic(d domain.ScoreboardTopic) ScoreboardTopicResponse {
+	return ScoreboardTopicResponse{
+		CompetitionID: d.CompetitionID,
+		TeamID:        d.TeamID,
+	}
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/infra/handler/request"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/infra/handler/response"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/infra/scoreboard"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	scoreboardWriteWait  = 10 * time.Second // Longest a single write may take before the client is dropped
+	scoreboardPongWait   = 60 * time.Second // Longest the client may stay silent, pongs included
+	scoreboardPingPeriod = scoreboardPongWait * 9 / 10
+	scoreboardMaxMessage = 512 // Subscription messages are small
+	scoreboardReplies    = 8   // Replies to subscription messages not yet written
+)
+
+var scoreboardUpgrader = websocket.Upgrader{
+	// The scoreboard is public and read-only like the other reporting routes, so stadium screens may
+	// connect from any origin
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type ScoreboardHandler struct {
+	hub *scoreboard.Hub
+}
+
+func NewScoreboardHandler(hub *scoreboard.Hub) *ScoreboardHandler {
+	return &ScoreboardHandler{hub: hub}
+}
+
+// Follow upgrades the request to a WebSocket that carries score and standings updates for the live matches
+// the client subscribes to.
+func (h *ScoreboardHandler) Follow(c *gin.Context) {
+	conn, err := scoreboardUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already replied with an HTTP error
+		return
+	}
+
+	client := h.hub.Join()
+	replies := make(chan response.ScoreboardMessage, scoreboardReplies)
+	done := make(chan struct{})
+
+	go h.write(conn, client, replies, done)
+
+	h.read(conn, client, replies)
+	close(done)
+	h.hub.Leave(client)
+}
+
+// read handles subscription messages until the connection fails or the client stops answering pings.
+func (h *ScoreboardHandler) read(conn *websocket.Conn, client *scoreboard.Client, replies chan<- response.ScoreboardMessage) {
+	conn.SetReadLimit(scoreboardMaxMessage)
+	_ = conn.SetReadDeadline(time.Now().Add(scoreboardPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(scoreboardPongWait))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(scoreboardPongWait))
+
+		var req request.ScoreboardRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			if !reply(replies, response.ScoreboardMessage{Type: "error", Data: gin.H{"message": "message must be a JSON object"}}) {
+				return
+			}
+			continue
+		}
+
+		topic := req.ToTopic()
+		var msg response.ScoreboardMessage
+		switch req.Action {
+		case request.ScoreboardSubscribe:
+			h.hub.Subscribe(client, topic)
+			msg = response.ScoreboardMessage{Type: "subscribed", Data: response.FromScoreboardTopic(topic)}
+		case request.ScoreboardUnsubscribe:
+			h.hub.Unsubscribe(client, topic)
+			msg = response.ScoreboardMessage{Type: "unsubscribed", Data: response.FromScoreboardTopic(topic)}
+		default:
+			msg = response.ScoreboardMessage{Type: "error", Data: gin.H{"message": "action must be subscribe or unsubscribe"}}
+		}
+		if !reply(replies, msg) {
+			return
+		}
+	}
+}
+
+// reply queues a reply without blocking. It reports false when the client is not reading its replies.
+func reply(replies chan<- response.ScoreboardMessage, msg response.ScoreboardMessage) bool {
+	select {
+	case replies <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// write is the only writer of the connection. It sends replies, updates and pings until read returns,
+// a write fails, or the hub drops the client for falling behind.
+func (h *ScoreboardHandler) write(conn *websocket.Conn, client *scoreboard.Client, replies <-chan response.ScoreboardMessage, done <-chan struct{}) {
+	ping := time.NewTicker(scoreboardPingPeriod)
+	defer func() {
+		ping.Stop()
+		conn.Close()
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		case msg := <-replies:
+			_ = conn.SetWriteDeadline(time.Now().Add(scoreboardWriteWait))
+			if err := conn.WriteJSON(msg); err != nil {
+				return
+			}
+		case update, ok := <-client.Updates():
+			_ = conn.SetWriteDeadline(time.Now().Add(scoreboardWriteWait))
+			if !ok {
+				// Dropped for falling behind, the client reconnects and subscribes again
+				_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "client too slow"))
+				return
+			}
+			if err := conn.WriteJSON(scoreboardMessage(update)); err != nil {
+				return
+			}
+		case <-ping.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(scoreboardWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func scoreboardMessage(update scoreboard.Update) response.ScoreboardMessage {
+	switch {
+	case update.Score != nil:
+		return response.ScoreboardMessage{Type: "score", Data: response.FromLiveScoreDomain(*update.Score)}
+	case update.Ended != nil:
+		return response.ScoreboardMessage{Type: "ended", Data: response.FromLiveScoreDomain(*update.Ended)}
+	default:
+		standings := update.Standings
+		return response.ScoreboardMessage{Type: "standings", Data: response.FromLiveStandings(standings.SeasonID, standings.CompetitionID, standings.Deltas)}
+	}
+}
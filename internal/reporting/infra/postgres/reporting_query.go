@@ -2,36 +2,67 @@ package postgres
 
 const (
 	queryStandings = `
-		WITH team_stats AS (
-			-- Stats when playing as home team
-			SELECT 
-				m.home_team_id AS team_id,
-				COUNT(*) AS played,
-				SUM(CASE WHEN mr.home_score > mr.away_score THEN 1 ELSE 0 END) AS won,
-				SUM(CASE WHEN mr.home_score = mr.away_score THEN 1 ELSE 0 END) AS drawn,
-				SUM(CASE WHEN mr.home_score < mr.away_score THEN 1 ELSE 0 END) AS lost,
-				SUM(mr.home_score) AS gf,
-				SUM(mr.away_score) AS ga
+		WITH scores AS (
+			SELECT m.home_team_id, m.away_team_id, mr.home_score, mr.away_score
+			FROM matches m
+			JOIN match_results mr ON m.id = mr.match_id
+			WHERE m.deleted_at IS NULL AND mr.deleted_at IS NULL
+				AND ($1 = '' OR m.season_id = $1)
+		),` + standingsTable
+
+	// The live matches count as if they ended with the score of their latest live event.
+	// Matches that have not kicked off yet are left out.
+	queryLiveStandings = `
+		WITH scores AS (
+			SELECT m.home_team_id, m.away_team_id, mr.home_score, mr.away_score
 			FROM matches m
 			JOIN match_results mr ON m.id = mr.match_id
 			WHERE m.deleted_at IS NULL AND mr.deleted_at IS NULL
-			GROUP BY m.home_team_id
+				AND ($1 = '' OR m.season_id = $1)
+
+			UNION ALL
+
+			SELECT m.home_team_id, m.away_team_id, e.home_score, e.away_score
+			FROM matches m
+			JOIN LATERAL (
+				SELECT home_score, away_score
+				FROM match_live_events
+				WHERE match_id = m.id
+				ORDER BY sequence DESC
+				LIMIT 1
+			) e ON TRUE
+			WHERE m.deleted_at IS NULL AND m.status = 'live'
+				AND ($1 = '' OR m.season_id = $1)
+		),` + standingsTable
+
+	// standingsTable aggregates the scores CTE of the standings queries into a league table.
+	standingsTable = `
+		team_stats AS (
+			-- Stats when playing as home team
+			SELECT 
+				home_team_id AS team_id,
+				COUNT(*) AS played,
+				SUM(CASE WHEN home_score > away_score THEN 1 ELSE 0 END) AS won,
+				SUM(CASE WHEN home_score = away_score THEN 1 ELSE 0 END) AS drawn,
+				SUM(CASE WHEN home_score < away_score THEN 1 ELSE 0 END) AS lost,
+				SUM(home_score) AS gf,
+				SUM(away_score) AS ga
+			FROM scores
+			GROUP BY home_team_id
 
 			UNION ALL
 
 			-- Stats when playing as away team
 			SELECT 
-				m.away_team_id AS team_id,
+				away_team_id AS team_id,
 				COUNT(*) AS played,
-				SUM(CASE WHEN mr.away_score > mr.home_score THEN 1 ELSE 0 END) AS won,
-				SUM(CASE WHEN mr.away_score = mr.home_score THEN 1 ELSE 0 END) AS drawn,
-				SUM(CASE WHEN mr.away_score < mr.home_score THEN 1 ELSE 0 END) AS lost,
-				SUM(mr.away_score) AS gf,
-				SUM(mr.home_score) AS ga
-			FROM matches m
-			JOIN match_results mr ON m.id = mr.match_id
-			WHERE m.deleted_at IS NULL AND mr.deleted_at IS NULL
-			GROUP BY m.away_team_id
+				SUM(CASE WHEN away_score > home_score THEN 1 ELSE 0 END) AS won,
+				SUM(CASE WHEN away_score = home_score THEN 1 ELSE 0 END) AS drawn,
+				SUM(CASE WHEN away_score < home_score THEN 1 ELSE 0 END) AS lost,
+				SUM(away_score) AS gf,
+				SUM(home_score) AS ga
+			FROM scores
+			GROUP BY away_team_id
 		),
 		aggregated_stats AS (
 			SELECT 
@@ -63,18 +94,182 @@ const (
 		ORDER BY a.points DESC, a.gd DESC, a.gf DESC, t.name ASC
 	`
 
+	// Matches that have not kicked off yet are shown at 0-0
+	queryLiveScores = `
+		SELECT 
+			m.id,
+			COALESCE(m.season_id, '') AS season_id,
+			COALESCE(s.competition_id, '') AS competition_id,
+			COALESCE(c.format, '') = 'league' AS league,
+			m.home_team_id,
+			ht.name AS home_team_name,
+			m.away_team_id,
+			at.name AS away_team_name,
+			COALESCE(e.home_score, 0) AS home_score,
+			COALESCE(e.away_score, 0) AS away_score,
+			COALESCE(e.event_minute, 0) AS minute,
+			COALESCE(e.event_type, '') AS phase,
+			COALESCE(e.sequence, 0) AS sequence
+		FROM matches m
+		JOIN teams ht ON ht.id = m.home_team_id
+		JOIN teams at ON at.id = m.away_team_id
+		LEFT JOIN seasons s ON s.id = m.season_id
+		LEFT JOIN competitions c ON c.id = s.competition_id
+		LEFT JOIN LATERAL (
+			SELECT home_score, away_score, event_minute, event_type, sequence
+			FROM match_live_events
+			WHERE match_id = m.id
+			ORDER BY sequence DESC
+			LIMIT 1
+		) e ON TRUE
+		WHERE m.deleted_at IS NULL AND m.status = 'live'
+		ORDER BY m.kickoff_at ASC, m.id ASC
+	`
+
+	// A player who moved clubs during the season has a row per club, with the minutes played for that club
 	queryTopScorers = `
+		WITH minutes AS (
+			SELECT a.player_id, a.team_id, SUM(a.minute_off - a.minute_on) AS minutes_played
+			FROM match_appearances a
+			JOIN match_results mr ON mr.id = a.result_id AND mr.deleted_at IS NULL
+			JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
+			WHERE a.deleted_at IS NULL
+				AND ($1 = '' OR m.season_id = $1)
+			GROUP BY a.player_id, a.team_id
+		)
 		SELECT 
 			g.player_id,
 			p.name AS player_name,
 			t.name AS team_name,
-			COUNT(*) AS goals
+			COUNT(*) AS goals,
+			COALESCE(mi.minutes_played, 0) AS minutes_played
 		FROM goals g
+		JOIN match_results mr ON mr.id = g.result_id AND mr.deleted_at IS NULL
+		JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
 		JOIN players p ON p.id = g.player_id AND p.deleted_at IS NULL
 		JOIN teams t ON t.id = g.team_id AND t.deleted_at IS NULL
+		LEFT JOIN minutes mi ON mi.player_id = g.player_id AND mi.team_id = g.team_id
 		WHERE g.deleted_at IS NULL
-		GROUP BY g.player_id, p.name, t.name
-		ORDER BY goals DESC, p.name ASC
+			AND g.goal_type <> 'own_goal'
+			AND ($1 = '' OR m.season_id = $1)
+		GROUP BY g.player_id, g.team_id, p.name, t.name, mi.minutes_played
+	`
+
+	// The assisting player is always on the team the goal counts for
+	queryTopAssists = `
+		SELECT 
+			g.assist_player_id,
+			p.name AS player_name,
+			t.name AS team_name,
+			COUNT(*) AS assists
+		FROM goals g
+		JOIN match_results mr ON mr.id = g.result_id AND mr.deleted_at IS NULL
+		JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
+		JOIN players p ON p.id = g.assist_player_id AND p.deleted_at IS NULL
+		JOIN teams t ON t.id = g.team_id AND t.deleted_at IS NULL
+		WHERE g.deleted_at IS NULL
+			AND ($1 = '' OR m.season_id = $1)
+		GROUP BY g.assist_player_id, p.name, t.name
+		ORDER BY assists DESC, p.name ASC
 		LIMIT 20
 	`
+
+	// A match counts for the head coach in charge on its local date: from the day they took charge up to,
+	// but not including, the day they handed over. A head coach whose contract ran out handed over the
+	// day after it ended.
+	queryHeadCoachRecords = `
+		WITH tenures AS (
+			SELECT h.id, h.team_id, h.staff_id, s.name AS staff_name, h.started_on,
+				LEAST(h.ended_on, s.contract_end + 1) AS ended_on
+			FROM head_coach_tenures h
+			JOIN team_staff s ON s.id = h.staff_id
+			WHERE ($2 = '' OR h.team_id = $2)
+		),
+		scores AS (
+			SELECT m.home_team_id, m.away_team_id, mr.home_score, mr.away_score,
+				(m.kickoff_at AT TIME ZONE COALESCE(v.timezone, $3))::date AS played_on
+			FROM matches m
+			JOIN match_results mr ON m.id = mr.match_id
+			LEFT JOIN venues v ON v.id = m.venue_id
+			WHERE m.deleted_at IS NULL AND mr.deleted_at IS NULL
+				AND ($1 = '' OR m.season_id = $1)
+		),
+		team_scores AS (
+			SELECT home_team_id AS team_id, home_score AS gf, away_score AS ga, played_on FROM scores
+			UNION ALL
+			SELECT away_team_id AS team_id, away_score AS gf, home_score AS ga, played_on FROM scores
+		)
+		SELECT 
+			h.staff_id,
+			h.staff_name,
+			h.team_id,
+			t.name AS team_name,
+			h.started_on,
+			h.ended_on,
+			COUNT(ts.team_id) AS played,
+			COUNT(*) FILTER (WHERE ts.gf > ts.ga) AS won,
+			COUNT(*) FILTER (WHERE ts.gf = ts.ga) AS drawn,
+			COUNT(*) FILTER (WHERE ts.gf < ts.ga) AS lost,
+			COALESCE(SUM(ts.gf), 0) AS gf,
+			COALESCE(SUM(ts.ga), 0) AS ga,
+			COALESCE(SUM(ts.gf) - SUM(ts.ga), 0) AS gd,
+			(COUNT(*) FILTER (WHERE ts.gf > ts.ga) * 3) + COUNT(*) FILTER (WHERE ts.gf = ts.ga) AS points
+		FROM tenures h
+		JOIN teams t ON t.id = h.team_id AND t.deleted_at IS NULL
+		LEFT JOIN team_scores ts ON ts.team_id = h.team_id
+			AND ts.played_on >= h.started_on
+			AND (h.ended_on IS NULL OR ts.played_on < h.ended_on)
+		GROUP BY h.id, h.staff_id, h.staff_name, h.team_id, t.name, h.started_on, h.ended_on
+		ORDER BY t.name ASC, h.started_on DESC
+	`
+
+	queryPlayerStats = `
+		WITH appearances AS (
+			SELECT 
+				a.player_id,
+				a.team_id,
+				COALESCE(m.season_id, '') AS season_id,
+				COUNT(*) AS appearances,
+				COUNT(*) FILTER (WHERE a.started) AS starts,
+				COUNT(*) FILTER (WHERE NOT a.started) AS sub_appearances,
+				SUM(a.minute_off - a.minute_on) AS minutes_played
+			FROM match_appearances a
+			JOIN match_results mr ON mr.id = a.result_id AND mr.deleted_at IS NULL
+			JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
+			WHERE a.deleted_at IS NULL
+				AND ($1 = '' OR m.season_id = $1)
+				AND ($2 = '' OR a.player_id = $2)
+			GROUP BY a.player_id, a.team_id, COALESCE(m.season_id, '')
+		),
+		goals_scored AS (
+			SELECT g.player_id, g.team_id, COALESCE(m.season_id, '') AS season_id, COUNT(*) AS goals
+			FROM goals g
+			JOIN match_results mr ON mr.id = g.result_id AND mr.deleted_at IS NULL
+			JOIN matches m ON m.id = mr.match_id AND m.deleted_at IS NULL
+			WHERE g.deleted_at IS NULL
+				AND g.goal_type <> 'own_goal'
+				AND ($1 = '' OR m.season_id = $1)
+				AND ($2 = '' OR g.player_id = $2)
+			GROUP BY g.player_id, g.team_id, COALESCE(m.season_id, '')
+		)
+		SELECT 
+			a.player_id,
+			p.name AS player_name,
+			a.team_id,
+			t.name AS team_name,
+			a.season_id,
+			COALESCE(s.name, '') AS season_name,
+			a.appearances,
+			a.starts,
+			a.sub_appearances,
+			a.minutes_played,
+			COALESCE(gs.goals, 0) AS goals,
+			COALESCE(ROUND(gs.goals * 90.0 / NULLIF(a.minutes_played, 0), 2), 0)::float8 AS goals_per_90
+		FROM appearances a
+		JOIN players p ON p.id = a.player_id AND p.deleted_at IS NULL
+		JOIN teams t ON t.id = a.team_id AND t.deleted_at IS NULL
+		LEFT JOIN seasons s ON s.id = a.season_id
+		LEFT JOIN goals_scored gs ON gs.player_id = a.player_id AND gs.team_id = a.team_id AND gs.season_id = a.season_id
+		ORDER BY a.minutes_played DESC, p.name ASC
+	`
 )
@@ -15,8 +15,16 @@ func NewReportingRepository(db *pgxpool.Pool) domain.ReportingRepository {
 	return &reportingRepository{db: db}
 }
 
-func (r *reportingRepository) GetStandings(ctx context.Context) ([]domain.TeamStanding, error) {
-	rows, err := r.db.Query(ctx, queryStandings)
+func (r *reportingRepository) GetStandings(ctx context.Context, seasonID string) ([]domain.TeamStanding, error) {
+	return r.standings(ctx, queryStandings, seasonID)
+}
+
+func (r *reportingRepository) GetLiveStandings(ctx context.Context, seasonID string) ([]domain.TeamStanding, error) {
+	return r.standings(ctx, queryLiveStandings, seasonID)
+}
+
+func (r *reportingRepository) standings(ctx context.Context, query, seasonID string) ([]domain.TeamStanding, error) {
+	rows, err := r.db.Query(ctx, query, seasonID)
 	if err != nil {
 		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query standings")
 	}
@@ -45,8 +53,8 @@ func (r *reportingRepository) GetStandings(ctx context.Context) ([]domain.TeamSt
 	return standings, nil
 }
 
-func (r *reportingRepository) GetTopScorers(ctx context.Context) ([]domain.TopScorer, error) {
-	rows, err := r.db.Query(ctx, queryTopScorers)
+func (r *reportingRepository) GetTopScorers(ctx context.Context, seasonID string) ([]domain.TopScorer, error) {
+	rows, err := r.db.Query(ctx, queryTopScorers, seasonID)
 	if err != nil {
 		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query top scorers")
 	}
@@ -60,6 +68,7 @@ func (r *reportingRepository) GetTopScorers(ctx context.Context) ([]domain.TopSc
 			&s.PlayerName,
 			&s.TeamName,
 			&s.Goals,
+			&s.MinutesPlayed,
 		); err != nil {
 			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan top scorer row")
 		}
@@ -68,3 +77,126 @@ func (r *reportingRepository) GetTopScorers(ctx context.Context) ([]domain.TopSc
 
 	return scorers, nil
 }
+
+func (r *reportingRepository) GetTopAssists(ctx context.Context, seasonID string) ([]domain.TopAssist, error) {
+	rows, err := r.db.Query(ctx, queryTopAssists, seasonID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query top assists")
+	}
+	defer rows.Close()
+
+	var assists []domain.TopAssist
+	for rows.Next() {
+		var a domain.TopAssist
+		if err := rows.Scan(
+			&a.PlayerID,
+			&a.PlayerName,
+			&a.TeamName,
+			&a.Assists,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan top assist row")
+		}
+		assists = append(assists, a)
+	}
+
+	return assists, nil
+}
+
+func (r *reportingRepository) GetPlayerStats(ctx context.Context, seasonID, playerID string) ([]domain.PlayerStats, error) {
+	rows, err := r.db.Query(ctx, queryPlayerStats, seasonID, playerID)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query player stats")
+	}
+	defer rows.Close()
+
+	var stats []domain.PlayerStats
+	for rows.Next() {
+		var s domain.PlayerStats
+		if err := rows.Scan(
+			&s.PlayerID,
+			&s.PlayerName,
+			&s.TeamID,
+			&s.TeamName,
+			&s.SeasonID,
+			&s.SeasonName,
+			&s.Appearances,
+			&s.Starts,
+			&s.SubAppearances,
+			&s.MinutesPlayed,
+			&s.Goals,
+			&s.GoalsPer90,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan player stats row")
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+func (r *reportingRepository) GetHeadCoachRecords(ctx context.Context, seasonID, teamID string) ([]domain.HeadCoachRecord, error) {
+	rows, err := r.db.Query(ctx, queryHeadCoachRecords, seasonID, teamID, domain.MatchTimezone)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query head coach records")
+	}
+	defer rows.Close()
+
+	var records []domain.HeadCoachRecord
+	for rows.Next() {
+		var h domain.HeadCoachRecord
+		if err := rows.Scan(
+			&h.StaffID,
+			&h.StaffName,
+			&h.TeamID,
+			&h.TeamName,
+			&h.StartedOn,
+			&h.EndedOn,
+			&h.Played,
+			&h.Won,
+			&h.Drawn,
+			&h.Lost,
+			&h.GF,
+			&h.GA,
+			&h.GD,
+			&h.Points,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan head coach record row")
+		}
+		records = append(records, h)
+	}
+
+	return records, nil
+}
+
+func (r *reportingRepository) GetLiveScores(ctx context.Context) ([]domain.LiveScore, error) {
+	rows, err := r.db.Query(ctx, queryLiveScores)
+	if err != nil {
+		return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to query live scores")
+	}
+	defer rows.Close()
+
+	var scores []domain.LiveScore
+	for rows.Next() {
+		var s domain.LiveScore
+		if err := rows.Scan(
+			&s.MatchID,
+			&s.SeasonID,
+			&s.CompetitionID,
+			&s.League,
+			&s.HomeTeamID,
+			&s.HomeTeamName,
+			&s.AwayTeamID,
+			&s.AwayTeamName,
+			&s.HomeScore,
+			&s.AwayScore,
+			&s.Minute,
+			&s.Phase,
+			&s.Sequence,
+		); err != nil {
+			return nil, derrors.WrapErrorf(err, derrors.ErrorCodeInternal, "failed to scan live score row")
+		}
+		scores = append(scores, s)
+	}
+
+	return scores, nil
+}
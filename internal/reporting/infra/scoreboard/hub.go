@@ -0,0 +1,272 @@
+package scoreboard
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/domain"
+	"github.com/ZyoGo/ayo-indonesia-footbal/pkg/logger"
+)
+
+const (
+	// pollInterval is how often the live scores are read. One hub polls for every connected client.
+	pollInterval = 2 * time.Second
+	// clientBuffer is how many updates a client may fall behind before it is dropped.
+	clientBuffer = 64
+)
+
+// Update is something a scoreboard client is told about. Exactly one field is set.
+type Update struct {
+	Score     *domain.LiveScore // A live match changed, or started being played
+	Ended     *domain.LiveScore // A match is no longer live, with its last score
+	Standings *Standings
+}
+
+// Standings are the standing deltas of a league season with matches being played.
+type Standings struct {
+	SeasonID      string
+	CompetitionID string
+	Deltas        []domain.StandingDelta
+}
+
+// Client is a connection following the scoreboard. It receives nothing until it subscribes to a topic.
+type Client struct {
+	updates chan Update
+	topics  map[domain.ScoreboardTopic]bool
+	dropped bool
+}
+
+// Updates returns the updates for the client. The channel is closed when the client falls too far behind.
+func (c *Client) Updates() <-chan Update {
+	return c.updates
+}
+
+func (c *Client) covers(score domain.LiveScore) bool {
+	for t := range c.topics {
+		if t.Covers(score) {
+			return true
+		}
+	}
+	return false
+}
+
+// Hub polls the live scores and fans the changes out to the clients subscribed to them.
+// Sending never blocks, so a slow client cannot hold up the others.
+type Hub struct {
+	service app.ReportingServicePort
+
+	mu        sync.Mutex
+	clients   map[*Client]struct{}
+	scores    map[string]domain.LiveScore // By match ID, as last sent
+	standings map[string]Standings        // By season ID, as last sent
+}
+
+func NewHub(service app.ReportingServicePort) *Hub {
+	return &Hub{
+		service:   service,
+		clients:   make(map[*Client]struct{}),
+		scores:    make(map[string]domain.LiveScore),
+		standings: make(map[string]Standings),
+	}
+}
+
+// Run polls until the context is cancelled.
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := h.refresh(ctx); err != nil {
+				logger.Get().With().ErrorContext(ctx, "Failed to refresh scoreboard", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+func (h *Hub) Join() *Client {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	c := &Client{
+		updates: make(chan Update, clientBuffer),
+		topics:  make(map[domain.ScoreboardTopic]bool),
+	}
+	h.clients[c] = struct{}{}
+	return c
+}
+
+func (h *Hub) Leave(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.clients, c)
+}
+
+// Subscribe adds a topic and sends the client the scores and standings it can now see.
+func (h *Hub) Subscribe(c *Client, topic domain.ScoreboardTopic) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if c.topics[topic] {
+		return
+	}
+
+	var newScores []domain.LiveScore
+	for _, score := range h.scores {
+		if topic.Covers(score) && !c.covers(score) {
+			newScores = append(newScores, score)
+		}
+	}
+	seasons := make(map[string]bool)
+	for _, score := range h.scores {
+		if c.covers(score) {
+			seasons[score.SeasonID] = true
+		}
+	}
+	c.topics[topic] = true
+
+	for i := range newScores {
+		h.send(c, Update{Score: &newScores[i]})
+	}
+	for _, score := range newScores {
+		if standings, ok := h.standings[score.SeasonID]; ok && !seasons[score.SeasonID] {
+			seasons[score.SeasonID] = true
+			h.send(c, Update{Standings: &standings})
+		}
+	}
+}
+
+func (h *Hub) Unsubscribe(c *Client, topic domain.ScoreboardTopic) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(c.topics, topic)
+}
+
+// refresh reads the live scores and sends what changed since the last poll. Standings are only worked out
+// again for seasons whose scores changed. Only refresh writes h.scores and h.standings.
+func (h *Hub) refresh(ctx context.Context) error {
+	h.mu.Lock()
+	idle := len(h.clients) == 0
+	if idle {
+		// Nobody is watching, start afresh when somebody is
+		h.scores = make(map[string]domain.LiveScore)
+		h.standings = make(map[string]Standings)
+	}
+	h.mu.Unlock()
+	if idle {
+		return nil
+	}
+
+	scores, err := h.service.GetLiveScores(ctx)
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]domain.LiveScore, len(scores))
+	var changed, ended []domain.LiveScore
+	seasons := make(map[string]string) // Seasons to work out again, to their competition
+	for _, score := range scores {
+		live[score.MatchID] = score
+		if last, ok := h.scores[score.MatchID]; !ok || last != score {
+			changed = append(changed, score)
+			if score.League && score.SeasonID != "" {
+				seasons[score.SeasonID] = score.CompetitionID
+			}
+		}
+	}
+	for id, last := range h.scores {
+		if _, ok := live[id]; !ok {
+			ended = append(ended, last)
+			if last.League && last.SeasonID != "" {
+				seasons[last.SeasonID] = last.CompetitionID
+			}
+		}
+	}
+
+	standings := make([]Standings, 0, len(seasons))
+	for seasonID, competitionID := range seasons {
+		deltas, err := h.service.GetLiveStandingDeltas(ctx, seasonID)
+		if err != nil {
+			return err
+		}
+		standings = append(standings, Standings{SeasonID: seasonID, CompetitionID: competitionID, Deltas: deltas})
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.scores = live
+	for i := range changed {
+		h.broadcast(changed[i], Update{Score: &changed[i]})
+	}
+	for i := range ended {
+		h.broadcast(ended[i], Update{Ended: &ended[i]})
+	}
+	// A season's standings go to the clients that see any of its matches, including those that just ended
+	seasonScores := append(scores[:len(scores):len(scores)], ended...)
+	for i := range standings {
+		seen := make(map[*Client]bool)
+		for _, score := range seasonScores {
+			if score.SeasonID != standings[i].SeasonID {
+				continue
+			}
+			for c := range h.clients {
+				if !seen[c] && c.covers(score) {
+					seen[c] = true
+					h.send(c, Update{Standings: &standings[i]})
+				}
+			}
+		}
+
+		if h.playing(standings[i].SeasonID) {
+			h.standings[standings[i].SeasonID] = standings[i]
+		} else {
+			delete(h.standings, standings[i].SeasonID)
+		}
+	}
+
+	return nil
+}
+
+// broadcast sends the update to every client that sees the match. h.mu must be held.
+func (h *Hub) broadcast(score domain.LiveScore, update Update) {
+	for c := range h.clients {
+		if c.covers(score) {
+			h.send(c, update)
+		}
+	}
+}
+
+// send queues the update without blocking. A client whose queue is full is dropped and has its channel
+// closed, it reconnects and subscribes again to get a fresh snapshot. h.mu must be held.
+func (h *Hub) send(c *Client, update Update) {
+	if c.dropped {
+		return
+	}
+
+	select {
+	case c.updates <- update:
+	default:
+		c.dropped = true
+		close(c.updates)
+		delete(h.clients, c)
+	}
+}
+
+// playing reports whether the season has matches being played. h.mu must be held.
+func (h *Hub) playing(seasonID string) bool {
+	for _, score := range h.scores {
+		if score.SeasonID == seasonID {
+			return true
+		}
+	}
+	return false
+}
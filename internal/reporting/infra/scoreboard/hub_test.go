@@ -0,0 +1,235 @@
+package scoreboard
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/app"
+	"github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/domain"
+	mockDomain "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func setupHub(t *testing.T) (*Hub, *mockDomain.MockReportingRepository) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockRepo := mockDomain.NewMockReportingRepository(ctrl)
+	return NewHub(app.NewReportingService(mockRepo)), mockRepo
+}
+
+// liveScore is a cup match being played, so no standings are worked out for it.
+func liveScore(matchID, competitionID, homeTeamID string, homeScore int) domain.LiveScore {
+	return domain.LiveScore{
+		MatchID:       matchID,
+		SeasonID:      "season-" + competitionID,
+		CompetitionID: competitionID,
+		HomeTeamID:    homeTeamID,
+		AwayTeamID:    "away-" + matchID,
+		HomeScore:     homeScore,
+	}
+}
+
+// received drains the updates waiting for the client.
+func received(c *Client) []Update {
+	var updates []Update
+	for {
+		select {
+		case u, ok := <-c.Updates():
+			if !ok {
+				return updates
+			}
+			updates = append(updates, u)
+		default:
+			return updates
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Subscribe
+// ---------------------------------------------------------------------------
+
+func TestHub_Subscribe_SendsScoresOfTheTopic(t *testing.T) {
+	// Given
+	hub, mockRepo := setupHub(t)
+	ctx := context.Background()
+	watcher := hub.Join()
+	hub.Subscribe(watcher, domain.ScoreboardTopic{})
+
+	mockRepo.EXPECT().GetLiveScores(ctx).Return([]domain.LiveScore{
+		liveScore("match-1", "cup-1", "team-1", 0),
+		liveScore("match-2", "cup-2", "team-2", 1),
+	}, nil)
+	if err := hub.refresh(ctx); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	received(watcher)
+
+	// When
+	c := hub.Join()
+	hub.Subscribe(c, domain.ScoreboardTopic{CompetitionID: "cup-2", TeamID: "team-2"})
+
+	// Then
+	updates := received(c)
+	if len(updates) != 1 || updates[0].Score == nil || updates[0].Score.MatchID != "match-2" {
+		t.Fatalf("expected the score of match-2 only, got %+v", updates)
+	}
+}
+
+func TestHub_Subscribe_NothingBeforeSubscribing(t *testing.T) {
+	// Given
+	hub, mockRepo := setupHub(t)
+	ctx := context.Background()
+	c := hub.Join()
+
+	mockRepo.EXPECT().GetLiveScores(ctx).Return([]domain.LiveScore{liveScore("match-1", "cup-1", "team-1", 0)}, nil)
+
+	// When
+	if err := hub.refresh(ctx); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Then
+	if updates := received(c); len(updates) != 0 {
+		t.Fatalf("expected no updates, got %+v", updates)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Broadcast
+// ---------------------------------------------------------------------------
+
+func TestHub_Refresh_BroadcastsChanges(t *testing.T) {
+	// Given
+	hub, mockRepo := setupHub(t)
+	ctx := context.Background()
+	c := hub.Join()
+	hub.Subscribe(c, domain.ScoreboardTopic{CompetitionID: "cup-1"})
+	other := hub.Join()
+	hub.Subscribe(other, domain.ScoreboardTopic{CompetitionID: "cup-2"})
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetLiveScores(ctx).Return([]domain.LiveScore{
+			liveScore("match-1", "cup-1", "team-1", 0),
+			liveScore("match-2", "cup-1", "team-2", 0),
+		}, nil),
+		// match-1 goes on unchanged, match-2 has a goal and match-3 kicks off
+		mockRepo.EXPECT().GetLiveScores(ctx).Return([]domain.LiveScore{
+			liveScore("match-1", "cup-1", "team-1", 0),
+			liveScore("match-2", "cup-1", "team-2", 1),
+			liveScore("match-3", "cup-1", "team-3", 0),
+		}, nil),
+		// match-1 is over
+		mockRepo.EXPECT().GetLiveScores(ctx).Return([]domain.LiveScore{
+			liveScore("match-2", "cup-1", "team-2", 1),
+			liveScore("match-3", "cup-1", "team-3", 0),
+		}, nil),
+	)
+	if err := hub.refresh(ctx); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if updates := received(c); len(updates) != 2 {
+		t.Fatalf("expected both scores, got %+v", updates)
+	}
+
+	// When
+	if err := hub.refresh(ctx); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Then
+	sent := make(map[string]int)
+	for _, u := range received(c) {
+		if u.Score == nil {
+			t.Fatalf("expected only scores, got %+v", u)
+		}
+		sent[u.Score.MatchID] = u.Score.HomeScore
+	}
+	if len(sent) != 2 || sent["match-2"] != 1 || sent["match-3"] != 0 {
+		t.Fatalf("expected the scores of match-2 and match-3, got %v", sent)
+	}
+
+	// When
+	if err := hub.refresh(ctx); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Then
+	updates := received(c)
+	if len(updates) != 1 || updates[0].Ended == nil || updates[0].Ended.MatchID != "match-1" {
+		t.Fatalf("expected match-1 to end, got %+v", updates)
+	}
+	if updates := received(other); len(updates) != 0 {
+		t.Fatalf("expected nothing for another competition, got %+v", updates)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Slow clients
+// ---------------------------------------------------------------------------
+
+func TestHub_Refresh_DropsSlowClient(t *testing.T) {
+	// Given
+	hub, mockRepo := setupHub(t)
+	ctx := context.Background()
+	slow := hub.Join()
+	hub.Subscribe(slow, domain.ScoreboardTopic{CompetitionID: "cup-1"})
+	fast := hub.Join()
+	hub.Subscribe(fast, domain.ScoreboardTopic{CompetitionID: "cup-2"})
+
+	// One more match than the slow client has room for
+	scores := []domain.LiveScore{liveScore("match-0", "cup-2", "team-0", 0)}
+	for i := 1; i <= clientBuffer+1; i++ {
+		scores = append(scores, liveScore(fmt.Sprintf("match-%d", i), "cup-1", fmt.Sprintf("team-%d", i), 0))
+	}
+	mockRepo.EXPECT().GetLiveScores(ctx).Return(scores, nil)
+
+	// When
+	if err := hub.refresh(ctx); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Then
+	if updates := received(slow); len(updates) != clientBuffer {
+		t.Fatalf("expected %d updates before the slow client was dropped, got %d", clientBuffer, len(updates))
+	}
+	if _, ok := <-slow.Updates(); ok {
+		t.Fatal("expected the updates of the slow client to be closed")
+	}
+	if updates := received(fast); len(updates) != 1 {
+		t.Fatalf("expected the fast client to keep receiving, got %+v", updates)
+	}
+	hub.mu.Lock()
+	_, joined := hub.clients[slow]
+	hub.mu.Unlock()
+	if joined {
+		t.Fatal("expected the slow client to have left the hub")
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Run
+// ---------------------------------------------------------------------------
+
+func TestHub_Run_StopsWhenCancelled(t *testing.T) {
+	// Given
+	hub, _ := setupHub(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(done)
+	}()
+
+	// When
+	cancel()
+
+	// Then
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Run to return once its context was cancelled")
+	}
+}
@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reporting/domain/reporting.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/reporting/domain/reporting.go -destination=internal/reporting/mock/repository_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/ZyoGo/ayo-indonesia-footbal/internal/reporting/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportingRepository is a mock of ReportingRepository interface.
+type MockReportingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReportingRepositoryMockRecorder is the mock recorder for MockReportingRepository.
+type MockReportingRepositoryMockRecorder struct {
+	mock *MockReportingRepository
+}
+
+// NewMockReportingRepository creates a new mock instance.
+func NewMockReportingRepository(ctrl *gomock.Controller) *MockReportingRepository {
+	mock := &MockReportingRepository{ctrl: ctrl}
+	mock.recorder = &MockReportingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportingRepository) EXPECT() *MockReportingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetHeadCoachRecords mocks base method.
+func (m *MockReportingRepository) GetHeadCoachRecords(ctx context.Context, seasonID, teamID string) ([]domain.HeadCoachRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeadCoachRecords", ctx, seasonID, teamID)
+	ret0, _ := ret[0].([]domain.HeadCoachRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeadCoachRecords indicates an expected call of GetHeadCoachRecords.
+func (mr *MockReportingRepositoryMockRecorder) GetHeadCoachRecords(ctx, seasonID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeadCoachRecords", reflect.TypeOf((*MockReportingRepository)(nil).GetHeadCoachRecords), ctx, seasonID, teamID)
+}
+
+// GetLiveScores mocks base method.
+func (m *MockReportingRepository) GetLiveScores(ctx context.Context) ([]domain.LiveScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLiveScores", ctx)
+	ret0, _ := ret[0].([]domain.LiveScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLiveScores indicates an expected call of GetLiveScores.
+func (mr *MockReportingRepositoryMockRecorder) GetLiveScores(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiveScores", reflect.TypeOf((*MockReportingRepository)(nil).GetLiveScores), ctx)
+}
+
+// GetLiveStandings mocks base method.
+func (m *MockReportingRepository) GetLiveStandings(ctx context.Context, seasonID string) ([]domain.TeamStanding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLiveStandings", ctx, seasonID)
+	ret0, _ := ret[0].([]domain.TeamStanding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLiveStandings indicates an expected call of GetLiveStandings.
+func (mr *MockReportingRepositoryMockRecorder) GetLiveStandings(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiveStandings", reflect.TypeOf((*MockReportingRepository)(nil).GetLiveStandings), ctx, seasonID)
+}
+
+// GetPlayerStats mocks base method.
+func (m *MockReportingRepository) GetPlayerStats(ctx context.Context, seasonID, playerID string) ([]domain.PlayerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerStats", ctx, seasonID, playerID)
+	ret0, _ := ret[0].([]domain.PlayerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerStats indicates an expected call of GetPlayerStats.
+func (mr *MockReportingRepositoryMockRecorder) GetPlayerStats(ctx, seasonID, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerStats", reflect.TypeOf((*MockReportingRepository)(nil).GetPlayerStats), ctx, seasonID, playerID)
+}
+
+// GetStandings mocks base method.
+func (m *MockReportingRepository) GetStandings(ctx context.Context, seasonID string) ([]domain.TeamStanding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandings", ctx, seasonID)
+	ret0, _ := ret[0].([]domain.TeamStanding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandings indicates an expected call of GetStandings.
+func (mr *MockReportingRepositoryMockRecorder) GetStandings(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandings", reflect.TypeOf((*MockReportingRepository)(nil).GetStandings), ctx, seasonID)
+}
+
+// GetTopAssists mocks base method.
+func (m *MockReportingRepository) GetTopAssists(ctx context.Context, seasonID string) ([]domain.TopAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopAssists", ctx, seasonID)
+	ret0, _ := ret[0].([]domain.TopAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopAssists indicates an expected call of GetTopAssists.
+func (mr *MockReportingRepositoryMockRecorder) GetTopAssists(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopAssists", reflect.TypeOf((*MockReportingRepository)(nil).GetTopAssists), ctx, seasonID)
+}
+
+// GetTopScorers mocks base method.
+func (m *MockReportingRepository) GetTopScorers(ctx context.Context, seasonID string) ([]domain.TopScorer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopScorers", ctx, seasonID)
+	ret0, _ := ret[0].([]domain.TopScorer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopScorers indicates an expected call of GetTopScorers.
+func (mr *MockReportingRepositoryMockRecorder) GetTopScorers(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopScorers", reflect.TypeOf((*MockReportingRepository)(nil).GetTopScorers), ctx, seasonID)
+}
@@ -35,7 +35,7 @@ func (h *UploadHandler) Upload(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"success": false,
 			"message": "invalid upload type",
-			"data":    "type must be one of: team-logo, player-photo, document",
+			"data":    "type must be one of: team-logo, player-photo, staff-photo, document",
 		})
 		return
 	}
@@ -0,0 +1,9 @@
+-- Rollback: Drop Competition Context tables
+
+DROP INDEX IF EXISTS idx_matches_season_id;
+DROP INDEX IF EXISTS idx_seasons_competition_id;
+DROP INDEX IF EXISTS idx_unique_competition_name;
+ALTER TABLE matches DROP COLUMN IF EXISTS season_id;
+DROP TABLE IF EXISTS season_teams;
+DROP TABLE IF EXISTS seasons;
+DROP TABLE IF EXISTS competitions;
@@ -0,0 +1,40 @@
+-- Migration: Create tables for Competition Context
+-- Description: Creates competitions, seasons and season_teams tables and scopes matches to a season
+
+CREATE TABLE IF NOT EXISTS competitions (
+    id          VARCHAR(26) PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    description TEXT DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id              VARCHAR(26) PRIMARY KEY,
+    competition_id  VARCHAR(26) NOT NULL REFERENCES competitions(id),
+    name            VARCHAR(100) NOT NULL,
+    start_date      DATE NOT NULL,
+    end_date        DATE NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at      TIMESTAMPTZ,
+    CONSTRAINT chk_season_dates CHECK (end_date > start_date)
+);
+
+CREATE TABLE IF NOT EXISTS season_teams (
+    season_id       VARCHAR(26) NOT NULL REFERENCES seasons(id),
+    team_id         VARCHAR(26) NOT NULL REFERENCES teams(id),
+    registered_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (season_id, team_id)
+);
+
+-- Existing matches predate seasons, so the column stays nullable at the database level
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS season_id VARCHAR(26) REFERENCES seasons(id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_competition_name
+    ON competitions (name)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_seasons_competition_id ON seasons (competition_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_matches_season_id ON matches (season_id) WHERE deleted_at IS NULL;
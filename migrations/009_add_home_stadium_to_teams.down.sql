@@ -0,0 +1,2 @@
+-- Migration: Remove home stadium from teams
+ALTER TABLE teams DROP COLUMN IF EXISTS home_stadium;
@@ -0,0 +1,2 @@
+-- Migration: Add home stadium to teams
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS home_stadium VARCHAR(255);
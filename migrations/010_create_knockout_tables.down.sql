@@ -0,0 +1,10 @@
+-- Rollback: Drop knockout competition tables
+
+DROP INDEX IF EXISTS idx_knockout_ties_second_leg;
+DROP INDEX IF EXISTS idx_knockout_ties_first_leg;
+DROP TABLE IF EXISTS knockout_ties;
+DROP TABLE IF EXISTS knockout_brackets;
+ALTER TABLE competitions DROP CONSTRAINT IF EXISTS chk_competition_format;
+ALTER TABLE competitions DROP COLUMN IF EXISTS away_goals_rule;
+ALTER TABLE competitions DROP COLUMN IF EXISTS two_legged;
+ALTER TABLE competitions DROP COLUMN IF EXISTS format;
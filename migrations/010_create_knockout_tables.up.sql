@@ -0,0 +1,36 @@
+-- Migration: Knockout competitions
+-- Description: Adds competition format and knockout rules, plus the brackets and ties that make up a cup draw
+
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS format VARCHAR(20) NOT NULL DEFAULT 'league';
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS two_legged BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS away_goals_rule BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE competitions ADD CONSTRAINT chk_competition_format CHECK (format IN ('league', 'knockout'));
+
+CREATE TABLE IF NOT EXISTS knockout_brackets (
+    season_id           VARCHAR(26) PRIMARY KEY REFERENCES seasons(id),
+    draw_method         VARCHAR(10) NOT NULL CHECK (draw_method IN ('seeded', 'random')),
+    start_date          DATE NOT NULL,
+    round_interval_days INTEGER NOT NULL CHECK (round_interval_days > 0),
+    leg_interval_days   INTEGER NOT NULL DEFAULT 0 CHECK (leg_interval_days >= 0),
+    match_time          VARCHAR(5) NOT NULL,
+    rounds              INTEGER NOT NULL CHECK (rounds > 0),
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS knockout_ties (
+    id                  VARCHAR(26) PRIMARY KEY,
+    season_id           VARCHAR(26) NOT NULL REFERENCES knockout_brackets(season_id),
+    round               INTEGER NOT NULL CHECK (round > 0),
+    slot                INTEGER NOT NULL CHECK (slot >= 0),
+    home_team_id        VARCHAR(26) REFERENCES teams(id),
+    away_team_id        VARCHAR(26) REFERENCES teams(id),
+    first_leg_match_id  VARCHAR(26) REFERENCES matches(id),
+    second_leg_match_id VARCHAR(26) REFERENCES matches(id),
+    winner_team_id      VARCHAR(26) REFERENCES teams(id),
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_knockout_tie_slot UNIQUE (season_id, round, slot)
+);
+
+CREATE INDEX IF NOT EXISTS idx_knockout_ties_first_leg ON knockout_ties (first_leg_match_id);
+CREATE INDEX IF NOT EXISTS idx_knockout_ties_second_leg ON knockout_ties (second_leg_match_id);
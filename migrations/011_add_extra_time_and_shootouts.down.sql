@@ -0,0 +1,8 @@
+-- Rollback: Drop extra time and penalty shootout columns
+
+DROP INDEX IF EXISTS idx_shootout_kicks_result_id;
+DROP TABLE IF EXISTS shootout_kicks;
+ALTER TABLE match_results DROP CONSTRAINT IF EXISTS chk_result_decided_by;
+ALTER TABLE match_results DROP COLUMN IF EXISTS away_penalties;
+ALTER TABLE match_results DROP COLUMN IF EXISTS home_penalties;
+ALTER TABLE match_results DROP COLUMN IF EXISTS decided_by;
@@ -0,0 +1,20 @@
+-- Migration: Extra time and penalty shootouts
+-- Description: Records the phase a match was decided in and the individual kicks of a penalty shootout
+
+ALTER TABLE match_results ADD COLUMN IF NOT EXISTS decided_by VARCHAR(20) NOT NULL DEFAULT 'regulation';
+ALTER TABLE match_results ADD COLUMN IF NOT EXISTS home_penalties INTEGER NOT NULL DEFAULT 0 CHECK (home_penalties >= 0);
+ALTER TABLE match_results ADD COLUMN IF NOT EXISTS away_penalties INTEGER NOT NULL DEFAULT 0 CHECK (away_penalties >= 0);
+ALTER TABLE match_results ADD CONSTRAINT chk_result_decided_by CHECK (decided_by IN ('regulation', 'extra_time', 'penalties'));
+
+CREATE TABLE IF NOT EXISTS shootout_kicks (
+    id              VARCHAR(26) PRIMARY KEY,
+    result_id       VARCHAR(26) NOT NULL REFERENCES match_results(id),
+    kick_order      INTEGER NOT NULL CHECK (kick_order > 0),
+    player_id       VARCHAR(26) NOT NULL REFERENCES players(id),
+    team_id         VARCHAR(26) NOT NULL REFERENCES teams(id),
+    scored          BOOLEAN NOT NULL,
+    deleted_at      TIMESTAMPTZ,
+    CONSTRAINT uq_shootout_kick_order UNIQUE (result_id, kick_order)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shootout_kicks_result_id ON shootout_kicks (result_id);
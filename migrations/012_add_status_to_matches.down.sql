@@ -0,0 +1,6 @@
+-- Rollback: Drop match status
+
+DROP INDEX IF EXISTS idx_matches_status;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS chk_match_status;
+ALTER TABLE matches DROP COLUMN IF EXISTS status_reason;
+ALTER TABLE matches DROP COLUMN IF EXISTS status;
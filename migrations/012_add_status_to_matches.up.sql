@@ -0,0 +1,11 @@
+-- Migration: Match lifecycle
+-- Description: Adds an explicit status to matches, backfilling finished for matches that already have a result
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'scheduled';
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS status_reason TEXT;
+ALTER TABLE matches ADD CONSTRAINT chk_match_status CHECK (status IN ('scheduled', 'live', 'finished', 'postponed', 'cancelled', 'abandoned'));
+
+UPDATE matches SET status = 'finished'
+WHERE id IN (SELECT match_id FROM match_results WHERE deleted_at IS NULL);
+
+CREATE INDEX IF NOT EXISTS idx_matches_status ON matches (status) WHERE deleted_at IS NULL;
@@ -0,0 +1,4 @@
+-- Rollback: Drop match reschedule history
+
+DROP INDEX IF EXISTS idx_match_reschedules_match_id;
+DROP TABLE IF EXISTS match_reschedules;
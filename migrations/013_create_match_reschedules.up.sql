@@ -0,0 +1,17 @@
+-- Migration: Match reschedule history
+-- Description: Keeps every change to a match's date, kickoff time or stadium along with the reason for it
+
+CREATE TABLE IF NOT EXISTS match_reschedules (
+    id                  VARCHAR(26) PRIMARY KEY,
+    match_id            VARCHAR(26) NOT NULL REFERENCES matches(id),
+    previous_date       DATE NOT NULL,
+    previous_time       VARCHAR(5) NOT NULL,
+    previous_stadium    VARCHAR(255) NOT NULL,
+    new_date            DATE NOT NULL,
+    new_time            VARCHAR(5) NOT NULL,
+    new_stadium         VARCHAR(255) NOT NULL,
+    reason              TEXT NOT NULL DEFAULT '',
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_reschedules_match_id ON match_reschedules (match_id, created_at DESC);
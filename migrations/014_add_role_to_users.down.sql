@@ -0,0 +1,4 @@
+-- Rollback: Drop user roles
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_user_role;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
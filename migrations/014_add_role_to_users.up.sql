@@ -0,0 +1,7 @@
+-- Migration: User roles
+-- Description: Adds a role to users so that destructive operations can be limited to admins.
+-- Existing users keep full access as admins, new users default to editor.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'admin';
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'editor';
+ALTER TABLE users ADD CONSTRAINT chk_user_role CHECK (role IN ('admin', 'editor'));
@@ -0,0 +1,6 @@
+-- Rollback: Drop result revisions
+-- Superseded results must be removed first, otherwise the unique constraint on match_id cannot be restored.
+
+DROP TABLE IF EXISTS result_revisions;
+DROP INDEX IF EXISTS uq_match_results_active_match;
+ALTER TABLE match_results ADD CONSTRAINT match_results_match_id_key UNIQUE (match_id);
@@ -0,0 +1,18 @@
+-- Migration: Result revisions
+-- Description: Lets a reported result be amended or voided. The superseded result stays in match_results,
+-- soft-deleted together with its goals and shootout kicks, and a revision records who replaced it and why.
+
+ALTER TABLE match_results DROP CONSTRAINT IF EXISTS match_results_match_id_key;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_match_results_active_match ON match_results (match_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS result_revisions (
+    id              VARCHAR(26) PRIMARY KEY,
+    match_id        VARCHAR(26) NOT NULL REFERENCES matches(id),
+    result_id       VARCHAR(26) NOT NULL UNIQUE REFERENCES match_results(id),
+    action          VARCHAR(10) NOT NULL CHECK (action IN ('amended', 'voided')),
+    changed_by      VARCHAR(255) NOT NULL,
+    reason          TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_result_revisions_match_id ON result_revisions (match_id, created_at DESC);
@@ -0,0 +1,6 @@
+-- Rollback: Drop goal types and assists
+
+DROP INDEX IF EXISTS idx_goals_assist_player_id;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS chk_goals_own_goal_assist;
+ALTER TABLE goals DROP COLUMN IF EXISTS assist_player_id;
+ALTER TABLE goals DROP COLUMN IF EXISTS goal_type;
@@ -0,0 +1,10 @@
+-- Migration: Goal types and assists
+-- Description: Records how each goal was scored and who assisted it. For an own goal, team_id is the team
+-- the goal counts for while player_id belongs to the opposing side.
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS goal_type VARCHAR(20) NOT NULL DEFAULT 'open_play'
+    CHECK (goal_type IN ('open_play', 'penalty', 'own_goal', 'free_kick', 'header'));
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS assist_player_id VARCHAR(26) REFERENCES players(id);
+ALTER TABLE goals ADD CONSTRAINT chk_goals_own_goal_assist CHECK (goal_type <> 'own_goal' OR assist_player_id IS NULL);
+
+CREATE INDEX IF NOT EXISTS idx_goals_assist_player_id ON goals (assist_player_id) WHERE assist_player_id IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Rollback: Drop match cards
+
+DROP TABLE IF EXISTS match_cards;
@@ -0,0 +1,16 @@
+-- Migration: Match cards
+-- Description: Yellow and red cards shown during a match, stored with the result they belong to
+
+CREATE TABLE IF NOT EXISTS match_cards (
+    id              VARCHAR(26) PRIMARY KEY,
+    result_id       VARCHAR(26) NOT NULL REFERENCES match_results(id),
+    player_id       VARCHAR(26) NOT NULL REFERENCES players(id),
+    team_id         VARCHAR(26) NOT NULL REFERENCES teams(id),
+    card_type       VARCHAR(20) NOT NULL CHECK (card_type IN ('yellow', 'second_yellow', 'red')),
+    card_minute     INTEGER NOT NULL CHECK (card_minute > 0),
+    reason          TEXT NOT NULL DEFAULT '',
+    deleted_at      TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_cards_result_id ON match_cards (result_id);
+CREATE INDEX IF NOT EXISTS idx_match_cards_player_id ON match_cards (player_id);
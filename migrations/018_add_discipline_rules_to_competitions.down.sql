@@ -0,0 +1,5 @@
+-- Rollback: Drop disciplinary rules from competitions
+
+ALTER TABLE competitions DROP COLUMN IF EXISTS yellow_card_ban;
+ALTER TABLE competitions DROP COLUMN IF EXISTS yellow_card_limit;
+ALTER TABLE competitions DROP COLUMN IF EXISTS red_card_ban;
@@ -0,0 +1,6 @@
+-- Migration: Disciplinary rules per competition
+-- Description: How many matches a sending off costs, and after how many yellow cards in a season a player is banned
+
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS red_card_ban INTEGER NOT NULL DEFAULT 1 CHECK (red_card_ban >= 0);
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS yellow_card_limit INTEGER NOT NULL DEFAULT 5 CHECK (yellow_card_limit > 0);
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS yellow_card_ban INTEGER NOT NULL DEFAULT 1 CHECK (yellow_card_ban >= 0);
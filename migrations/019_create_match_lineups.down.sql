@@ -0,0 +1,5 @@
+-- Rollback: Drop match lineups and substitutions
+
+DROP TABLE IF EXISTS match_substitutions;
+DROP TABLE IF EXISTS lineup_players;
+DROP TABLE IF EXISTS match_lineups;
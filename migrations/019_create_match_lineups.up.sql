@@ -0,0 +1,37 @@
+-- Migration: Match lineups
+-- Description: The starting XI and bench each team names before kickoff, and the substitutions made during the match.
+-- Resubmitting a lineup soft-deletes the previous one, so only one lineup per team and match is active.
+
+CREATE TABLE IF NOT EXISTS match_lineups (
+    id              VARCHAR(26) PRIMARY KEY,
+    match_id        VARCHAR(26) NOT NULL REFERENCES matches(id),
+    team_id         VARCHAR(26) NOT NULL REFERENCES teams(id),
+    submitted_by    VARCHAR(255) NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at      TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_match_lineups_active_team ON match_lineups (match_id, team_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS lineup_players (
+    lineup_id       VARCHAR(26) NOT NULL REFERENCES match_lineups(id),
+    player_id       VARCHAR(26) NOT NULL REFERENCES players(id),
+    position        VARCHAR(20) NOT NULL,
+    jersey_number   INTEGER NOT NULL CHECK (jersey_number BETWEEN 1 AND 99),
+    starter         BOOLEAN NOT NULL,
+    sort_order      INTEGER NOT NULL,
+    PRIMARY KEY (lineup_id, player_id),
+    UNIQUE (lineup_id, jersey_number)
+);
+
+CREATE TABLE IF NOT EXISTS match_substitutions (
+    id              VARCHAR(26) PRIMARY KEY,
+    lineup_id       VARCHAR(26) NOT NULL REFERENCES match_lineups(id),
+    minute          INTEGER NOT NULL CHECK (minute > 0),
+    player_off_id   VARCHAR(26) NOT NULL REFERENCES players(id),
+    player_on_id    VARCHAR(26) NOT NULL REFERENCES players(id),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (player_off_id <> player_on_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_substitutions_lineup_id ON match_substitutions (lineup_id);
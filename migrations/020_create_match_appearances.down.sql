@@ -0,0 +1,3 @@
+-- Rollback: Drop match appearances
+
+DROP TABLE IF EXISTS match_appearances;
@@ -0,0 +1,18 @@
+-- Migration: Match appearances
+-- Description: Who took the field in a match and between which minutes, worked out from the lineups,
+-- substitutions and sendings-off when the result is reported. Stored with the result they were reported with.
+
+CREATE TABLE IF NOT EXISTS match_appearances (
+    id              VARCHAR(26) PRIMARY KEY,
+    result_id       VARCHAR(26) NOT NULL REFERENCES match_results(id),
+    player_id       VARCHAR(26) NOT NULL REFERENCES players(id),
+    team_id         VARCHAR(26) NOT NULL REFERENCES teams(id),
+    started         BOOLEAN NOT NULL,
+    minute_on       INTEGER NOT NULL CHECK (minute_on >= 0),
+    minute_off      INTEGER NOT NULL,
+    deleted_at      TIMESTAMPTZ,
+    CHECK (minute_off >= minute_on)
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_appearances_result_id ON match_appearances (result_id);
+CREATE INDEX IF NOT EXISTS idx_match_appearances_player_id ON match_appearances (player_id);
@@ -0,0 +1,3 @@
+-- Rollback: Drop live match events
+
+DROP TABLE IF EXISTS match_live_events;
@@ -0,0 +1,20 @@
+-- Migration: Live match events
+-- Description: The live feed of a match. Each event is numbered within its match so that clients
+-- following the feed can resume after the last event they saw, and carries the running score.
+
+CREATE TABLE IF NOT EXISTS match_live_events (
+    id              VARCHAR(26) PRIMARY KEY,
+    match_id        VARCHAR(26) NOT NULL REFERENCES matches(id),
+    sequence        BIGINT NOT NULL CHECK (sequence > 0),
+    event_type      VARCHAR(20) NOT NULL CHECK (event_type IN ('kickoff', 'goal', 'card', 'substitution', 'half_time', 'full_time')),
+    event_minute    INTEGER NOT NULL CHECK (event_minute >= 0),
+    team_id         VARCHAR(26) REFERENCES teams(id),
+    player_id       VARCHAR(26) REFERENCES players(id),
+    player_in_id    VARCHAR(26) REFERENCES players(id),
+    card_type       VARCHAR(20) CHECK (card_type IN ('yellow', 'second_yellow', 'red')),
+    note            TEXT NOT NULL DEFAULT '',
+    home_score      INTEGER NOT NULL CHECK (home_score >= 0),
+    away_score      INTEGER NOT NULL CHECK (away_score >= 0),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (match_id, sequence)
+);
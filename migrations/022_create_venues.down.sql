@@ -0,0 +1,26 @@
+-- Rollback: Put the stadium names back as free text and drop venues
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS stadium VARCHAR(255);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS home_stadium VARCHAR(255);
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS previous_stadium VARCHAR(255);
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS new_stadium VARCHAR(255);
+
+UPDATE matches SET stadium = v.name FROM venues v WHERE v.id = matches.venue_id;
+UPDATE teams SET home_stadium = v.name FROM venues v WHERE v.id = teams.home_venue_id;
+UPDATE match_reschedules SET previous_stadium = COALESCE(
+    (SELECT name FROM venues WHERE id = match_reschedules.previous_venue_id), '');
+UPDATE match_reschedules SET new_stadium = COALESCE(
+    (SELECT name FROM venues WHERE id = match_reschedules.new_venue_id), '');
+
+ALTER TABLE match_reschedules ALTER COLUMN previous_stadium SET NOT NULL;
+ALTER TABLE match_reschedules ALTER COLUMN new_stadium SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_teams_home_venue_id;
+DROP INDEX IF EXISTS idx_matches_venue_id;
+
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS new_venue_id;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS previous_venue_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS home_venue_id;
+ALTER TABLE matches DROP COLUMN IF EXISTS venue_id;
+
+DROP TABLE IF EXISTS venues;
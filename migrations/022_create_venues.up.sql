@@ -0,0 +1,108 @@
+-- Migration: Venue registry
+-- Description: Replaces the free-text stadium of matches, teams and reschedules with a reference to a venue.
+-- Existing stadium names become one venue each, matched ignoring case and extra spaces. Known spellings of
+-- the same ground, such as "GBK" and "Gelora Bung Karno", are mapped to one venue through venue_aliases.
+-- Spellings not listed there can still be folded together afterwards through POST /venues/:id/merge.
+
+CREATE TABLE IF NOT EXISTS venues (
+    id          VARCHAR(26) PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    city        VARCHAR(100) NOT NULL DEFAULT '',
+    address     TEXT NOT NULL DEFAULT '',
+    capacity    INTEGER CHECK (capacity > 0),
+    surface     VARCHAR(20) CHECK (surface IN ('grass', 'artificial', 'hybrid')),
+    latitude    NUMERIC(9,6) CHECK (latitude BETWEEN -90 AND 90),
+    longitude   NUMERIC(9,6) CHECK (longitude BETWEEN -180 AND 180),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMPTZ,
+    CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_venue_name
+    ON venues (LOWER(name))
+    WHERE deleted_at IS NULL;
+
+-- Known spellings of the same ground, ignoring case, and the name its venue is registered under
+CREATE TEMPORARY TABLE venue_aliases (
+    alias  TEXT PRIMARY KEY,
+    name   TEXT NOT NULL
+);
+
+INSERT INTO venue_aliases (alias, name) VALUES
+    ('gbk', 'Gelora Bung Karno'),
+    ('sugbk', 'Gelora Bung Karno'),
+    ('stadion gbk', 'Gelora Bung Karno'),
+    ('stadion gelora bung karno', 'Gelora Bung Karno'),
+    ('stadion utama gelora bung karno', 'Gelora Bung Karno'),
+    ('gelora bung karno', 'Gelora Bung Karno'),
+    ('jis', 'Jakarta International Stadium'),
+    ('stadion jis', 'Jakarta International Stadium'),
+    ('jakarta international stadium', 'Jakarta International Stadium'),
+    ('gbla', 'Gelora Bandung Lautan Api'),
+    ('stadion gbla', 'Gelora Bandung Lautan Api'),
+    ('stadion gelora bandung lautan api', 'Gelora Bandung Lautan Api'),
+    ('gelora bandung lautan api', 'Gelora Bandung Lautan Api'),
+    ('gbt', 'Gelora Bung Tomo'),
+    ('stadion gbt', 'Gelora Bung Tomo'),
+    ('stadion gelora bung tomo', 'Gelora Bung Tomo'),
+    ('gelora bung tomo', 'Gelora Bung Tomo'),
+    ('sjh', 'Si Jalak Harupat'),
+    ('stadion sjh', 'Si Jalak Harupat'),
+    ('stadion si jalak harupat', 'Si Jalak Harupat'),
+    ('si jalak harupat', 'Si Jalak Harupat');
+
+-- The name a free-text stadium is registered under: its alias if it has one, otherwise the name itself
+-- with extra spaces removed
+CREATE FUNCTION pg_temp.venue_name(stadium TEXT) RETURNS TEXT AS $$
+    SELECT COALESCE(
+        (SELECT name FROM venue_aliases WHERE alias = LOWER(REGEXP_REPLACE(TRIM(stadium), '\s+', ' ', 'g'))),
+        REGEXP_REPLACE(TRIM(stadium), '\s+', ' ', 'g'))
+$$ LANGUAGE SQL STABLE;
+
+-- One venue per distinct stadium name. The ID is derived from the name so the mapping below can find it.
+INSERT INTO venues (id, name, city)
+SELECT '0' || UPPER(SUBSTRING(MD5(LOWER(name)) FROM 1 FOR 25)), MIN(name), MIN(city)
+FROM (
+    SELECT pg_temp.venue_name(stadium) AS name, '' AS city FROM matches
+    UNION ALL
+    SELECT pg_temp.venue_name(home_stadium), city FROM teams
+    UNION ALL
+    SELECT pg_temp.venue_name(previous_stadium), '' FROM match_reschedules
+    UNION ALL
+    SELECT pg_temp.venue_name(new_stadium), '' FROM match_reschedules
+) stadiums
+WHERE name <> ''
+GROUP BY LOWER(name);
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS venue_id VARCHAR(26) REFERENCES venues(id);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS home_venue_id VARCHAR(26) REFERENCES venues(id);
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS previous_venue_id VARCHAR(26) REFERENCES venues(id);
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS new_venue_id VARCHAR(26) REFERENCES venues(id);
+
+UPDATE matches SET venue_id = v.id
+FROM venues v
+WHERE LOWER(v.name) = LOWER(pg_temp.venue_name(matches.stadium));
+
+UPDATE teams SET home_venue_id = v.id
+FROM venues v
+WHERE LOWER(v.name) = LOWER(pg_temp.venue_name(teams.home_stadium));
+
+UPDATE match_reschedules SET previous_venue_id = v.id
+FROM venues v
+WHERE LOWER(v.name) = LOWER(pg_temp.venue_name(match_reschedules.previous_stadium));
+
+UPDATE match_reschedules SET new_venue_id = v.id
+FROM venues v
+WHERE LOWER(v.name) = LOWER(pg_temp.venue_name(match_reschedules.new_stadium));
+
+DROP FUNCTION pg_temp.venue_name(TEXT);
+DROP TABLE venue_aliases;
+
+ALTER TABLE matches DROP COLUMN IF EXISTS stadium;
+ALTER TABLE teams DROP COLUMN IF EXISTS home_stadium;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS previous_stadium;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS new_stadium;
+
+CREATE INDEX IF NOT EXISTS idx_matches_venue_id ON matches (venue_id);
+CREATE INDEX IF NOT EXISTS idx_teams_home_venue_id ON teams (home_venue_id);
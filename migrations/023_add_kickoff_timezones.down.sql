@@ -0,0 +1,49 @@
+-- Rollback: Split kickoffs back into a date and an "HH:MM" time on the clock at the venue
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS match_date DATE;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS match_time VARCHAR(5);
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS previous_date DATE;
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS previous_time VARCHAR(5);
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS new_date DATE;
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS new_time VARCHAR(5);
+
+UPDATE matches SET
+    match_date = (local_kickoff)::date,
+    match_time = TO_CHAR(local_kickoff, 'HH24:MI')
+FROM (
+    SELECT m.id, m.kickoff_at AT TIME ZONE COALESCE(v.timezone, 'Asia/Jakarta') AS local_kickoff
+    FROM matches m
+    LEFT JOIN venues v ON v.id = m.venue_id
+) k
+WHERE k.id = matches.id;
+
+UPDATE match_reschedules SET
+    previous_date = (previous_local)::date,
+    previous_time = TO_CHAR(previous_local, 'HH24:MI'),
+    new_date = (new_local)::date,
+    new_time = TO_CHAR(new_local, 'HH24:MI')
+FROM (
+    SELECT r.id,
+        r.previous_kickoff_at AT TIME ZONE COALESCE(pv.timezone, 'Asia/Jakarta') AS previous_local,
+        r.new_kickoff_at AT TIME ZONE COALESCE(nv.timezone, 'Asia/Jakarta') AS new_local
+    FROM match_reschedules r
+    LEFT JOIN venues pv ON pv.id = r.previous_venue_id
+    LEFT JOIN venues nv ON nv.id = r.new_venue_id
+) k
+WHERE k.id = match_reschedules.id;
+
+ALTER TABLE matches ALTER COLUMN match_date SET NOT NULL;
+ALTER TABLE matches ALTER COLUMN match_time SET NOT NULL;
+ALTER TABLE match_reschedules ALTER COLUMN previous_date SET NOT NULL;
+ALTER TABLE match_reschedules ALTER COLUMN previous_time SET NOT NULL;
+ALTER TABLE match_reschedules ALTER COLUMN new_date SET NOT NULL;
+ALTER TABLE match_reschedules ALTER COLUMN new_time SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_matches_kickoff_at;
+
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS new_kickoff_at;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS previous_kickoff_at;
+ALTER TABLE matches DROP COLUMN IF EXISTS kickoff_at;
+ALTER TABLE venues DROP COLUMN IF EXISTS timezone;
+
+CREATE INDEX IF NOT EXISTS idx_matches_date ON matches (match_date DESC);
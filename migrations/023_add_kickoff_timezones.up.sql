@@ -0,0 +1,34 @@
+-- Migration: Time-zone-aware kickoffs
+-- Description: Gives every venue an IANA time zone and stores each kickoff as a single instant instead of a
+-- date and an "HH:MM" string. Existing kickoffs are read as the clock time at their venue; venues are
+-- assumed to be on WIB (Asia/Jakarta) until their zone is corrected, and so are matches without a venue.
+
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS kickoff_at TIMESTAMPTZ;
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS previous_kickoff_at TIMESTAMPTZ;
+ALTER TABLE match_reschedules ADD COLUMN IF NOT EXISTS new_kickoff_at TIMESTAMPTZ;
+
+UPDATE matches SET kickoff_at = (match_date + match_time::time) AT TIME ZONE COALESCE(
+    (SELECT timezone FROM venues WHERE id = matches.venue_id), 'Asia/Jakarta');
+
+UPDATE match_reschedules SET
+    previous_kickoff_at = (previous_date + previous_time::time) AT TIME ZONE COALESCE(
+        (SELECT timezone FROM venues WHERE id = match_reschedules.previous_venue_id), 'Asia/Jakarta'),
+    new_kickoff_at = (new_date + new_time::time) AT TIME ZONE COALESCE(
+        (SELECT timezone FROM venues WHERE id = match_reschedules.new_venue_id), 'Asia/Jakarta');
+
+ALTER TABLE matches ALTER COLUMN kickoff_at SET NOT NULL;
+ALTER TABLE match_reschedules ALTER COLUMN previous_kickoff_at SET NOT NULL;
+ALTER TABLE match_reschedules ALTER COLUMN new_kickoff_at SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_matches_date;
+
+ALTER TABLE matches DROP COLUMN IF EXISTS match_date;
+ALTER TABLE matches DROP COLUMN IF EXISTS match_time;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS previous_date;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS previous_time;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS new_date;
+ALTER TABLE match_reschedules DROP COLUMN IF EXISTS new_time;
+
+CREATE INDEX IF NOT EXISTS idx_matches_kickoff_at ON matches (kickoff_at DESC);
@@ -0,0 +1,4 @@
+-- Rollback: Drop match officials
+
+DROP TABLE IF EXISTS match_officials;
+DROP TABLE IF EXISTS officials;
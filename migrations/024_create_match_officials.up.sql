@@ -0,0 +1,26 @@
+-- Migration: Match officials
+-- Description: A registry of referees, assistant referees, fourth officials and video assistant referees,
+-- and the officials assigned to each match
+
+CREATE TABLE IF NOT EXISTS officials (
+    id          VARCHAR(26) PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    email       VARCHAR(255) NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_official_email
+    ON officials (LOWER(email))
+    WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS match_officials (
+    match_id     VARCHAR(26) NOT NULL REFERENCES matches(id),
+    official_id  VARCHAR(26) NOT NULL REFERENCES officials(id),
+    role         VARCHAR(20) NOT NULL CHECK (role IN ('referee', 'assistant_referee', 'fourth_official', 'var')),
+    assigned_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (match_id, official_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_officials_official_id ON match_officials (official_id);
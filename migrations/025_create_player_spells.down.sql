@@ -0,0 +1,3 @@
+-- Rollback: Drop player spells
+
+DROP TABLE IF EXISTS player_spells;
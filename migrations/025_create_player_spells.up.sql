@@ -0,0 +1,29 @@
+-- Migration: Player transfers
+-- Description: Keeps the history of the teams a player was registered with, one spell per team, so a
+-- player can move to another team without being deleted and re-created. Every existing player gets a
+-- spell at their team from the day they were registered until the day they were deleted.
+
+CREATE TABLE IF NOT EXISTS player_spells (
+    id            VARCHAR(26) PRIMARY KEY,
+    player_id     VARCHAR(26) NOT NULL REFERENCES players(id),
+    team_id       VARCHAR(26) NOT NULL REFERENCES teams(id),
+    joined_on     DATE NOT NULL,
+    left_on       DATE,
+    fee_type      VARCHAR(20) CHECK (fee_type IN ('permanent', 'loan', 'free')),
+    loan_ends_on  DATE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (left_on IS NULL OR left_on >= joined_on),
+    CHECK (loan_ends_on IS NULL OR (fee_type = 'loan' AND loan_ends_on > joined_on))
+);
+
+-- A player is with one team at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_player_current_spell
+    ON player_spells (player_id)
+    WHERE left_on IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_player_spells_team_id ON player_spells (team_id);
+
+INSERT INTO player_spells (id, player_id, team_id, joined_on, left_on, created_at)
+SELECT '0' || UPPER(SUBSTRING(MD5(id) FROM 1 FOR 25)), id, team_id, created_at::date,
+    CASE WHEN deleted_at IS NOT NULL THEN GREATEST(deleted_at::date, created_at::date) END, created_at
+FROM players;
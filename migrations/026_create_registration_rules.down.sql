@@ -0,0 +1,10 @@
+-- Rollback: Drop squad registration rules
+
+DROP TABLE IF EXISTS registration_overrides;
+DROP TABLE IF EXISTS season_registration_windows;
+
+ALTER TABLE seasons DROP COLUMN IF EXISTS home_nationality;
+ALTER TABLE seasons DROP COLUMN IF EXISTS max_foreign_players;
+ALTER TABLE seasons DROP COLUMN IF EXISTS max_squad_size;
+
+ALTER TABLE players DROP COLUMN IF EXISTS nationality;
@@ -0,0 +1,33 @@
+-- Migration: Squad registration rules
+-- Description: Lets each season limit player registrations to windows, cap the size of squads and set a
+-- quota of foreign players. The quota counts players by a bare nationality column, Indonesian for those
+-- already registered; the profile migration validates it. Admins can register players against the rules;
+-- every rule they override is kept in registration_overrides.
+
+ALTER TABLE players ADD COLUMN IF NOT EXISTS nationality CHAR(2) NOT NULL DEFAULT 'ID';
+
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS max_squad_size INTEGER CHECK (max_squad_size >= 1);
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS max_foreign_players INTEGER CHECK (max_foreign_players >= 0);
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS home_nationality CHAR(2) NOT NULL DEFAULT 'ID';
+
+CREATE TABLE IF NOT EXISTS season_registration_windows (
+    season_id  VARCHAR(26) NOT NULL REFERENCES seasons(id),
+    opens_on   DATE NOT NULL,
+    closes_on  DATE NOT NULL,
+    PRIMARY KEY (season_id, opens_on),
+    CHECK (closes_on >= opens_on)
+);
+
+CREATE TABLE IF NOT EXISTS registration_overrides (
+    id             VARCHAR(26) PRIMARY KEY,
+    player_id      VARCHAR(26) NOT NULL REFERENCES players(id),
+    team_id        VARCHAR(26) NOT NULL REFERENCES teams(id),
+    season_id      VARCHAR(26) NOT NULL REFERENCES seasons(id),
+    action         VARCHAR(20) NOT NULL CHECK (action IN ('registration', 'transfer')),
+    rule           VARCHAR(20) NOT NULL CHECK (rule IN ('window', 'squad_size', 'foreign_quota')),
+    message        TEXT NOT NULL,
+    overridden_by  VARCHAR(255) NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_registration_overrides_team_id ON registration_overrides (team_id, created_at);
@@ -0,0 +1,11 @@
+-- Rollback: Drop player profiles
+
+DROP INDEX IF EXISTS idx_players_date_of_birth;
+DROP INDEX IF EXISTS idx_players_nationality;
+
+ALTER TABLE players DROP CONSTRAINT IF EXISTS chk_player_nationality;
+
+ALTER TABLE players DROP COLUMN IF EXISTS secondary_positions;
+ALTER TABLE players DROP COLUMN IF EXISTS preferred_foot;
+ALTER TABLE players DROP COLUMN IF EXISTS photo_url;
+ALTER TABLE players DROP COLUMN IF EXISTS date_of_birth;
@@ -0,0 +1,14 @@
+-- Migration: Player profiles
+-- Description: Adds date of birth, photo, preferred foot and secondary positions to players. All are optional,
+-- so players registered before stay as they are. The nationality the foreign player quota counts by becomes
+-- part of the profile: it must be an ISO 3166-1 alpha-2 code and players can be filtered by it.
+
+ALTER TABLE players ADD CONSTRAINT chk_player_nationality CHECK (nationality ~ '^[A-Z]{2}$');
+
+ALTER TABLE players ADD COLUMN IF NOT EXISTS date_of_birth DATE;
+ALTER TABLE players ADD COLUMN IF NOT EXISTS photo_url VARCHAR(500) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN IF NOT EXISTS preferred_foot VARCHAR(5) CHECK (preferred_foot IN ('left', 'right', 'both'));
+ALTER TABLE players ADD COLUMN IF NOT EXISTS secondary_positions TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_players_nationality ON players (nationality) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_players_date_of_birth ON players (date_of_birth) WHERE deleted_at IS NULL;
@@ -0,0 +1,6 @@
+-- Rollback: Drop age limits from competitions
+
+ALTER TABLE competitions DROP CONSTRAINT IF EXISTS chk_competitions_age_limit;
+
+ALTER TABLE competitions DROP COLUMN IF EXISTS age_cutoff_date;
+ALTER TABLE competitions DROP COLUMN IF EXISTS max_age;
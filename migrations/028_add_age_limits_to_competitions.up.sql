@@ -0,0 +1,8 @@
+-- Migration: Age-group competitions
+-- Description: A competition may admit only players no older than max_age on age_cutoff_date, as in U-17
+-- and U-20 leagues. Both are NULL for competitions open to all ages.
+
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS max_age INTEGER CHECK (max_age > 0);
+ALTER TABLE competitions ADD COLUMN IF NOT EXISTS age_cutoff_date DATE;
+
+ALTER TABLE competitions ADD CONSTRAINT chk_competitions_age_limit CHECK ((max_age IS NULL) = (age_cutoff_date IS NULL));
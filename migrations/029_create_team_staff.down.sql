@@ -0,0 +1,4 @@
+-- Rollback: Drop team staff
+
+DROP TABLE IF EXISTS head_coach_tenures;
+DROP TABLE IF EXISTS team_staff;
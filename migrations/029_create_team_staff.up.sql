@@ -0,0 +1,36 @@
+-- Migration: Team staff
+-- Description: Keeps the coaching and backroom staff of each team, with their coaching licence and
+-- contract. A team has one head coach at a time; head_coach_tenures records who was in charge from the
+-- day they took over until the day they handed over, so results can be attributed to a head coach.
+
+CREATE TABLE IF NOT EXISTS team_staff (
+    id              VARCHAR(26) PRIMARY KEY,
+    team_id         VARCHAR(26) NOT NULL REFERENCES teams(id),
+    name            VARCHAR(255) NOT NULL,
+    role            VARCHAR(20) NOT NULL CHECK (role IN ('head_coach', 'assistant_coach', 'goalkeeper_coach', 'physio', 'team_manager')),
+    license         VARCHAR(10) CHECK (license IN ('pro', 'a', 'b', 'c', 'd')),
+    contract_start  DATE NOT NULL,
+    contract_end    DATE,
+    photo_url       VARCHAR(500) NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at      TIMESTAMPTZ,
+    CHECK (contract_end IS NULL OR contract_end > contract_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_staff_team_id ON team_staff (team_id);
+
+CREATE TABLE IF NOT EXISTS head_coach_tenures (
+    id          VARCHAR(26) PRIMARY KEY,
+    team_id     VARCHAR(26) NOT NULL REFERENCES teams(id),
+    staff_id    VARCHAR(26) NOT NULL REFERENCES team_staff(id),
+    started_on  DATE NOT NULL,
+    ended_on    DATE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (ended_on IS NULL OR ended_on >= started_on)
+);
+
+-- A team has one head coach at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_team_current_head_coach
+    ON head_coach_tenures (team_id)
+    WHERE ended_on IS NULL;
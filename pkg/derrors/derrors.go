@@ -5,9 +5,17 @@ import "fmt"
 // Error represents an error that could be wrapping another error, it includes a code for determining what
 // triggered the error.
 type Error struct {
-	orig error
-	msg  string
-	code ErrorCode
+	orig    error
+	msg     string
+	code    ErrorCode
+	details []Detail
+}
+
+// Detail describes a single problem with one field of a request, so that every problem
+// can be reported at once instead of only the first.
+type Detail struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 // ErrorCode defines supported error codes.
@@ -40,6 +48,16 @@ func NewErrorf(code ErrorCode, format string, a ...interface{}) error {
 	return WrapErrorf(nil, code, format, a...)
 }
 
+// WithDetails returns a wrapped error that lists every problem found.
+func WithDetails(orig error, code ErrorCode, details []Detail, format string, a ...interface{}) error {
+	return &Error{
+		code:    code,
+		orig:    orig,
+		msg:     fmt.Sprintf(format, a...),
+		details: details,
+	}
+}
+
 // Error returns the message, when wrapping errors the wrapped error is returned.
 func (e *Error) Error() string {
 	if e.orig != nil {
@@ -59,6 +77,11 @@ func (e *Error) Unwrap() error {
 	return e.orig
 }
 
+// Details returns the problems listed with the error, if any.
+func (e *Error) Details() []Detail {
+	return e.details
+}
+
 // Code returns the code representing this error.
 func (e *Error) Code() ErrorCode {
 	return e.code
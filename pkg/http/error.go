@@ -25,10 +25,11 @@ type CreatedSuccessResponse struct {
 
 // ErrorResponse error response
 type ErrorResponse struct {
-	Code     int    `json:"code"`
-	Status   string `json:"status,omitempty"`
-	Message  string `json:"message"`
-	Internal error  `json:"-"`
+	Code     int              `json:"code"`
+	Status   string           `json:"status,omitempty"`
+	Message  string           `json:"message"`
+	Details  []derrors.Detail `json:"details,omitempty"`
+	Internal error            `json:"-"`
 }
 
 // NewBadRequestResponse default not found error response
@@ -95,7 +96,7 @@ func RenderErrorResponse(err error) (resp ErrorResponse) {
 		logger.Get().Error("error response", "error", ierr.Error())
 		switch ierr.Code() {
 		case derrors.ErrorCodeBadRequest:
-			return ErrorResponse{Status: BadRequestStatus, Code: http.StatusBadRequest, Message: ierr.Message(), Internal: ierr}
+			return ErrorResponse{Status: BadRequestStatus, Code: http.StatusBadRequest, Message: ierr.Message(), Details: ierr.Details(), Internal: ierr}
 		case derrors.ErrorCodeUnauthorized:
 			return ErrorResponse{Status: UnauthorizedStatus, Code: http.StatusUnauthorized, Message: ierr.Message(), Internal: ierr}
 		case derrors.ErrorCodeForbidden:
@@ -109,7 +110,7 @@ func RenderErrorResponse(err error) (resp ErrorResponse) {
 		case derrors.ErrorCodeInvalidArgument:
 			return ErrorResponse{Status: BadRequestStatus, Code: http.StatusBadRequest, Message: "Bad Request", Internal: ierr}
 		case derrors.ErrorCodeCustomBadRequest:
-			return ErrorResponse{Status: BadRequestStatus, Code: http.StatusBadRequest, Message: ierr.Message(), Internal: ierr}
+			return ErrorResponse{Status: BadRequestStatus, Code: http.StatusBadRequest, Message: ierr.Message(), Details: ierr.Details(), Internal: ierr}
 		}
 	}
 
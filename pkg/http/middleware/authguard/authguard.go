@@ -24,19 +24,34 @@ func NewAuthGuard(j *jwt.Service) *AuthGuard {
 }
 
 // Guard is the middleware function to verify JWT token.
+// Scoped tokens are only accepted by the routes guarded with GuardScope.
 func (g *AuthGuard) Guard() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-
-		if !strings.HasPrefix(authHeader, PrefixHeader) {
-			c.JSON(http.StatusUnauthorized, common.NewUnauthorizedResponse("Authorization header missing/invalid"))
+		attr, ok := g.verify(c)
+		if !ok {
+			return
+		}
+		if attr.Scope != "" {
+			c.JSON(http.StatusForbidden, common.NewForbiddenResponse())
 			c.Abort()
 			return
 		}
 
-		attr, err := g.j.ParseAndVerify(strings.TrimPrefix(authHeader, PrefixHeader))
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, common.NewUnauthorizedResponse(InvalidToken))
+		c.Set(UserAttr, attr)
+		c.Next()
+	}
+}
+
+// GuardScope verifies the JWT token like Guard, but also accepts tokens scoped to
+// the scope the route resolves to for the request.
+func (g *AuthGuard) GuardScope(scope func(c *gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attr, ok := g.verify(c)
+		if !ok {
+			return
+		}
+		if attr.Scope != "" && attr.Scope != scope(c) {
+			c.JSON(http.StatusForbidden, common.NewForbiddenResponse())
 			c.Abort()
 			return
 		}
@@ -45,3 +60,48 @@ func (g *AuthGuard) Guard() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// verify parses the bearer token of the request, aborting it when the token is missing or invalid.
+func (g *AuthGuard) verify(c *gin.Context) (jwt.JwtAttr, bool) {
+	authHeader := c.GetHeader("Authorization")
+
+	if !strings.HasPrefix(authHeader, PrefixHeader) {
+		c.JSON(http.StatusUnauthorized, common.NewUnauthorizedResponse("Authorization header missing/invalid"))
+		c.Abort()
+		return jwt.JwtAttr{}, false
+	}
+
+	attr, err := g.j.ParseAndVerify(strings.TrimPrefix(authHeader, PrefixHeader))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, common.NewUnauthorizedResponse(InvalidToken))
+		c.Abort()
+		return jwt.JwtAttr{}, false
+	}
+
+	return attr, true
+}
+
+// RequireRole only lets through users whose token carries one of the given roles.
+// It must run after Guard.
+func (g *AuthGuard) RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attr := CurrentUser(c)
+		for _, role := range roles {
+			if attr.Role == role {
+				c.Next()
+				return
+			}
+		}
+
+		c.JSON(http.StatusForbidden, common.NewForbiddenResponse())
+		c.Abort()
+	}
+}
+
+// CurrentUser returns the attributes of the user authenticated by Guard,
+// or empty attributes when the route is not guarded.
+func CurrentUser(c *gin.Context) jwt.JwtAttr {
+	value, _ := c.Get(UserAttr)
+	attr, _ := value.(jwt.JwtAttr)
+	return attr
+}
@@ -11,6 +11,8 @@ import (
 // JwtAttr represents JWT attributes
 type JwtAttr struct {
 	Email string
+	Role  string
+	Scope string // Limits the token to one action, empty for a user session
 }
 
 // Service handles JWT signing and verification using RSA
@@ -33,6 +35,8 @@ func NewService(privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey, issuer, su
 
 type Claims struct {
 	Email string `json:"email"`
+	Role  string `json:"role"`
+	Scope string `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -42,6 +46,8 @@ func (s *Service) GenerateToken(attr JwtAttr, expiry time.Duration) (string, err
 
 	claims := Claims{
 		Email: attr.Email,
+		Role:  attr.Role,
+		Scope: attr.Scope,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -71,6 +77,8 @@ func (s *Service) ParseAndVerify(tokenString string) (JwtAttr, error) {
 	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
 		return JwtAttr{
 			Email: claims.Email,
+			Role:  claims.Role,
+			Scope: claims.Scope,
 		}, nil
 	}
 
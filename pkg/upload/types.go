@@ -18,6 +18,7 @@ type UploadType string
 const (
 	TypeTeamLogo    UploadType = "team-logo"
 	TypePlayerPhoto UploadType = "player-photo"
+	TypeStaffPhoto  UploadType = "staff-photo"
 	TypeDocument    UploadType = "document"
 )
 
@@ -42,6 +43,16 @@ var uploadTypeConfigs = map[UploadType]TypeConfig{
 		AllowedExtensions: []string{".jpg", ".jpeg", ".png", ".gif", ".webp"},
 		MaxSize:           10 * 1024 * 1024, // 10MB
 	},
+	TypeStaffPhoto: {
+		AllowedMIME: []string{
+			"image/jpeg",
+			"image/png",
+			"image/gif",
+			"image/webp",
+		},
+		AllowedExtensions: []string{".jpg", ".jpeg", ".png", ".gif", ".webp"},
+		MaxSize:           10 * 1024 * 1024, // 10MB
+	},
 	TypeDocument: {
 		AllowedMIME: []string{
 			"application/pdf",